@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meter
+
+import (
+	"log"
+	"strings"
+
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+)
+
+// ExemplarFilter returns the exemplar filter of the OTEL_METRICS_EXEMPLAR_FILTER
+// value. Supported values are trace_based (default), always_on and always_off.
+func ExemplarFilter(value string) exemplar.Filter {
+	filter := strings.ToLower(strings.TrimSpace(value))
+
+	switch filter {
+	case "always_on":
+		return exemplar.AlwaysOnFilter
+	case "always_off":
+		return exemplar.AlwaysOffFilter
+	case "trace_based":
+		return exemplar.TraceBasedFilter
+	default:
+		if filter != "" {
+			log.Printf("Warning: Invalid OTEL_METRICS_EXEMPLAR_FILTER value '%s', using default 'trace_based'", filter)
+		}
+		return exemplar.TraceBasedFilter
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meter
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExemplarFilter(t *testing.T) {
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	unsampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x02},
+		SpanID:  trace.SpanID{0x02},
+	}))
+	cases := []struct {
+		value     string
+		sampled   bool
+		unsampled bool
+	}{
+		{"always_on", true, true},
+		{"always_off", false, false},
+		{"trace_based", true, false},
+		{" Always_On ", true, true},
+		{"", true, false},
+		{"invalid", true, false},
+	}
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			filter := ExemplarFilter(c.value)
+			if actual := filter(sampled); actual != c.sampled {
+				t.Fatalf("expected %v for a sampled span, got %v", c.sampled, actual)
+			}
+			if actual := filter(unsampled); actual != c.unsampled {
+				t.Fatalf("expected %v for an unsampled span, got %v", c.unsampled, actual)
+			}
+			if actual := filter(context.Background()); actual != (c.sampled && c.unsampled) {
+				t.Fatalf("expected %v without span, got %v", c.sampled && c.unsampled, actual)
+			}
+		})
+	}
+}
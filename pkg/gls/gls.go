@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gls carries the trace context and baggage kept in the goroutine
+// local storage over to the goroutines running the tasks of a pool.
+//
+// A goroutine started by the go statement inherits the context of the
+// goroutine starting it, but the workers of a pool are started long before the
+// tasks they run. Capture the context when the task is submitted and run the
+// task with it in the worker:
+//
+//	tasks <- gls.Wrap(task)
+//
+// Without the instrumentation, the functions of the package run the tasks as
+// they are.
+package gls
+
+import (
+	_ "unsafe"
+)
+
+//go:linkname otel_get_trace_context_from_gls otel_get_trace_context_from_gls
+var otel_get_trace_context_from_gls func() interface{}
+
+//go:linkname otel_set_trace_context_to_gls otel_set_trace_context_to_gls
+var otel_set_trace_context_to_gls func(interface{})
+
+//go:linkname otel_get_baggage_container_from_gls otel_get_baggage_container_from_gls
+var otel_get_baggage_container_from_gls func() interface{}
+
+//go:linkname otel_set_baggage_container_to_gls otel_set_baggage_container_to_gls
+var otel_set_baggage_container_to_gls func(interface{})
+
+type contextSnapshoter interface {
+	TakeSnapShot() interface{}
+}
+
+// Snapshot is the trace context and baggage of a goroutine at the time it was
+// captured.
+type Snapshot struct {
+	traceContext     interface{}
+	baggageContainer interface{}
+}
+
+func enabled() bool {
+	return otel_get_trace_context_from_gls != nil && otel_set_trace_context_to_gls != nil &&
+		otel_get_baggage_container_from_gls != nil && otel_set_baggage_container_to_gls != nil
+}
+
+// takeSnapShot copies the context like the runtime does for a new goroutine,
+// so that the goroutines do not share the spans they add.
+func takeSnapShot(tls interface{}) interface{} {
+	if taker, ok := tls.(contextSnapshoter); ok {
+		return taker.TakeSnapShot()
+	}
+	return tls
+}
+
+// Capture takes a snapshot of the context of the current goroutine, typically
+// when a task is submitted to a pool.
+func Capture() Snapshot {
+	if !enabled() {
+		return Snapshot{}
+	}
+	return Snapshot{
+		traceContext:     takeSnapShot(otel_get_trace_context_from_gls()),
+		baggageContainer: takeSnapShot(otel_get_baggage_container_from_gls()),
+	}
+}
+
+// Run runs f with the context of the snapshot, and gives the current goroutine
+// its own context back afterwards. The context left by the previous task of a
+// worker is never seen by f, even if the snapshot is empty.
+func (s Snapshot) Run(f func()) {
+	if !enabled() {
+		f()
+		return
+	}
+	traceContext := otel_get_trace_context_from_gls()
+	baggageContainer := otel_get_baggage_container_from_gls()
+	defer func() {
+		otel_set_trace_context_to_gls(traceContext)
+		otel_set_baggage_container_to_gls(baggageContainer)
+	}()
+	otel_set_trace_context_to_gls(takeSnapShot(s.traceContext))
+	otel_set_baggage_container_to_gls(takeSnapShot(s.baggageContainer))
+	f()
+}
+
+// Wrap captures the context of the current goroutine and returns a function
+// running f with it, wherever it is called.
+func Wrap(f func()) func() {
+	if f == nil || !enabled() {
+		return f
+	}
+	snapshot := Capture()
+	return func() {
+		snapshot.Run(f)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gls
+
+import (
+	"testing"
+)
+
+type testContext struct {
+	name string
+}
+
+func (c *testContext) TakeSnapShot() interface{} {
+	return &testContext{c.name}
+}
+
+// useTestGLS replaces the storage of the goroutine with variables, the tests
+// switch between the submitting and the worker goroutine by setting them.
+func useTestGLS(t *testing.T) (traceContext, baggageContainer *interface{}) {
+	traceContext, baggageContainer = new(interface{}), new(interface{})
+	otel_get_trace_context_from_gls = func() interface{} { return *traceContext }
+	otel_set_trace_context_to_gls = func(v interface{}) { *traceContext = v }
+	otel_get_baggage_container_from_gls = func() interface{} { return *baggageContainer }
+	otel_set_baggage_container_to_gls = func(v interface{}) { *baggageContainer = v }
+	t.Cleanup(func() {
+		otel_get_trace_context_from_gls = nil
+		otel_set_trace_context_to_gls = nil
+		otel_get_baggage_container_from_gls = nil
+		otel_set_baggage_container_to_gls = nil
+	})
+	return traceContext, baggageContainer
+}
+
+func nameOf(v interface{}) string {
+	if c, ok := v.(*testContext); ok {
+		return c.name
+	}
+	return ""
+}
+
+func TestWrapWithoutInstrumentation(t *testing.T) {
+	ran := false
+	Wrap(func() { ran = true })()
+	if !ran {
+		t.Fatal("expected the task to run")
+	}
+	if Wrap(nil) != nil {
+		t.Fatal("expected no task")
+	}
+}
+
+func TestWrapRunsWithSubmitterContext(t *testing.T) {
+	traceContext, baggageContainer := useTestGLS(t)
+	submitted := &testContext{"request"}
+	*traceContext, *baggageContainer = submitted, &testContext{"request-baggage"}
+	task := Wrap(func() {
+		if nameOf(*traceContext) != "request" || *traceContext == submitted {
+			t.Fatalf("expected a copy of the submitter context, got %v", *traceContext)
+		}
+		if nameOf(*baggageContainer) != "request-baggage" {
+			t.Fatalf("expected the submitter baggage, got %v", *baggageContainer)
+		}
+	})
+
+	// the worker runs the task with the context of its previous task
+	*traceContext, *baggageContainer = &testContext{"stale"}, &testContext{"stale-baggage"}
+	task()
+	if nameOf(*traceContext) != "stale" || nameOf(*baggageContainer) != "stale-baggage" {
+		t.Fatalf("expected the worker context to be restored, got %v %v", *traceContext, *baggageContainer)
+	}
+}
+
+func TestRunEmptySnapshot(t *testing.T) {
+	traceContext, baggageContainer := useTestGLS(t)
+	snapshot := Capture()
+	*traceContext, *baggageContainer = &testContext{"stale"}, &testContext{"stale-baggage"}
+	snapshot.Run(func() {
+		if *traceContext != nil || *baggageContainer != nil {
+			t.Fatalf("expected no context, got %v %v", *traceContext, *baggageContainer)
+		}
+	})
+	if nameOf(*traceContext) != "stale" {
+		t.Fatalf("expected the worker context to be restored, got %v", *traceContext)
+	}
+}
+
+func TestRunRestoresOnPanic(t *testing.T) {
+	traceContext, _ := useTestGLS(t)
+	*traceContext = &testContext{"request"}
+	snapshot := Capture()
+	*traceContext = &testContext{"worker"}
+	func() {
+		defer func() { _ = recover() }()
+		snapshot.Run(func() { panic("boom") })
+	}()
+	if nameOf(*traceContext) != "worker" {
+		t.Fatalf("expected the worker context to be restored, got %v", *traceContext)
+	}
+}
@@ -112,12 +112,7 @@ func (h *AILLMAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnStart(attri
 			Value: attribute.Int64Value(seed),
 		})
 	}
-	if input := h.LLMGetter.GetAIInput(request); input != "" {
-		attributes = append(attributes, attribute.KeyValue{
-			Key:   semconv7.GenAIInputMessagesKey,
-			Value: attribute.StringValue(input),
-		})
-	}
+	attributes = h.appendInputContent(attributes, request)
 
 	if serverAddress := h.LLMGetter.GetAIServerAddress(request); serverAddress != "" {
 		attributes = append(attributes, attribute.KeyValue{
@@ -159,12 +154,7 @@ func (h *AILLMAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnEnd(attribu
 			Value: attribute.Int64Value(outputTokens),
 		})
 	}
-	if output := h.LLMGetter.GetAIOutput(response); output != "" {
-		attributes = append(attributes, attribute.KeyValue{
-			Key:   semconv7.GenAIOutputMessagesKey,
-			Value: attribute.StringValue(output),
-		})
-	}
+	attributes = h.appendOutputContent(attributes, context, request, response)
 
 	// Only add response id if it's not empty
 	if responseID := h.LLMGetter.GetAIResponseID(request, response); responseID != "" {
@@ -176,3 +166,63 @@ func (h *AILLMAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnEnd(attribu
 
 	return attributes, context
 }
+
+// appendInputContent records the structured input messages and system
+// instructions when they are captured and reported by the LLMGetter, the
+// flattened input otherwise.
+func (h *AILLMAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) appendInputContent(attributes []attribute.KeyValue, request REQUEST) []attribute.KeyValue {
+	if !RecordMessageContent() {
+		return attributes
+	}
+	if getter, ok := any(h.LLMGetter).(MessagesAttrsGetter[REQUEST, RESPONSE]); ok && CaptureMessageContent() {
+		if instructions := MarshalSystemInstructions(getter.GetAISystemInstructions(request)); instructions != "" {
+			attributes = append(attributes, attribute.KeyValue{
+				Key:   semconv7.GenAISystemInstructionsKey,
+				Value: attribute.StringValue(instructions),
+			})
+		}
+		if messages := MarshalMessages(getter.GetAIInputMessages(request)); messages != "" {
+			return append(attributes, attribute.KeyValue{
+				Key:   semconv7.GenAIInputMessagesKey,
+				Value: attribute.StringValue(messages),
+			})
+		}
+	}
+	if input := h.LLMGetter.GetAIInput(request); input != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   semconv7.GenAIInputMessagesKey,
+			Value: attribute.StringValue(RedactContent(input)),
+		})
+	}
+	return attributes
+}
+
+// appendOutputContent records the structured output messages when they are
+// captured and reported by the LLMGetter, the flattened output otherwise. The
+// tool calls of the output messages are remembered for the execute_tool spans.
+func (h *AILLMAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) appendOutputContent(attributes []attribute.KeyValue, ctx context.Context, request REQUEST, response RESPONSE) []attribute.KeyValue {
+	getter, structured := any(h.LLMGetter).(MessagesAttrsGetter[REQUEST, RESPONSE])
+	var outputMessages []ChatMessage
+	if structured {
+		outputMessages = getter.GetAIOutputMessages(request, response)
+		RecordToolCalls(ctx, outputMessages)
+	}
+	if !RecordMessageContent() {
+		return attributes
+	}
+	if structured && CaptureMessageContent() {
+		if messages := MarshalMessages(outputMessages); messages != "" {
+			return append(attributes, attribute.KeyValue{
+				Key:   semconv7.GenAIOutputMessagesKey,
+				Value: attribute.StringValue(messages),
+			})
+		}
+	}
+	if output := h.LLMGetter.GetAIOutput(response); output != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   semconv7.GenAIOutputMessagesKey,
+			Value: attribute.StringValue(RedactContent(output)),
+		})
+	}
+	return attributes
+}
@@ -0,0 +1,410 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT set to true records
+// structured gen_ai.input.messages, gen_ai.output.messages and
+// gen_ai.system_instructions, set to false no message content at all. When it
+// is unset, the flattened input and output are recorded.
+const OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT = "OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT"
+
+// OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION lists the comma separated PII
+// detectors applied to message content: email, phone, credit_card,
+// ip_address, or pii for all of them.
+const OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION = "OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION"
+
+// OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION_PATTERN is a regular
+// expression whose matches in message content are redacted.
+const OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION_PATTERN = "OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION_PATTERN"
+
+// OTEL_INSTRUMENTATION_GENAI_MESSAGE_MAX_BYTES limits the content recorded
+// for each message.
+const OTEL_INSTRUMENTATION_GENAI_MESSAGE_MAX_BYTES = "OTEL_INSTRUMENTATION_GENAI_MESSAGE_MAX_BYTES"
+
+// OTEL_INSTRUMENTATION_GENAI_MESSAGES_MAX_TOTAL_BYTES limits the size of each
+// message content attribute, the latest messages being kept.
+const OTEL_INSTRUMENTATION_GENAI_MESSAGES_MAX_TOTAL_BYTES = "OTEL_INSTRUMENTATION_GENAI_MESSAGES_MAX_TOTAL_BYTES"
+
+// RedactedContent replaces the content matched by a redactor.
+const RedactedContent = "[REDACTED]"
+
+type contentCaptureMode int
+
+const (
+	contentCaptureDefault contentCaptureMode = iota
+	contentCaptureEnabled
+	contentCaptureDisabled
+)
+
+var (
+	captureMode     = parseContentCaptureMode(os.Getenv(OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT))
+	messageMaxBytes = nonNegativeIntFromEnv(OTEL_INSTRUMENTATION_GENAI_MESSAGE_MAX_BYTES)
+	totalMaxBytes   = nonNegativeIntFromEnv(OTEL_INSTRUMENTATION_GENAI_MESSAGES_MAX_TOTAL_BYTES)
+	redactor        atomic.Value
+)
+
+func init() {
+	redactor.Store(redactorHolder{r: redactorFromEnv(os.Getenv(OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION),
+		os.Getenv(OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION_PATTERN))})
+}
+
+func parseContentCaptureMode(value string) contentCaptureMode {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true":
+		return contentCaptureEnabled
+	case "false":
+		return contentCaptureDisabled
+	default:
+		return contentCaptureDefault
+	}
+}
+
+func nonNegativeIntFromEnv(key string) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return 0
+	}
+	return value
+}
+
+// CaptureMessageContent reports whether structured message content is
+// recorded.
+func CaptureMessageContent() bool {
+	return captureMode == contentCaptureEnabled
+}
+
+// RecordMessageContent reports whether any message content is recorded.
+func RecordMessageContent() bool {
+	return captureMode != contentCaptureDisabled
+}
+
+// Redactor removes sensitive data from message content.
+type Redactor interface {
+	Redact(content string) string
+}
+
+// RedactorFunc adapts a function to a Redactor.
+type RedactorFunc func(content string) string
+
+func (f RedactorFunc) Redact(content string) string {
+	return f(content)
+}
+
+type regexRedactor struct {
+	pattern *regexp.Regexp
+}
+
+func (r regexRedactor) Redact(content string) string {
+	return r.pattern.ReplaceAllString(content, RedactedContent)
+}
+
+// NewRegexRedactor returns a Redactor replacing the matches of pattern.
+func NewRegexRedactor(pattern *regexp.Regexp) Redactor {
+	return regexRedactor{pattern: pattern}
+}
+
+type chainRedactor []Redactor
+
+func (c chainRedactor) Redact(content string) string {
+	for _, r := range c {
+		content = r.Redact(content)
+	}
+	return content
+}
+
+// ChainRedactors returns a Redactor applying redactors in order.
+func ChainRedactors(redactors ...Redactor) Redactor {
+	return chainRedactor(redactors)
+}
+
+var piiPatterns = map[string]*regexp.Regexp{
+	"email":       regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+	"credit_card": regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`),
+	"phone":       regexp.MustCompile(`(?:\+\d{1,3}[ \-.]?)?(?:\(\d{2,4}\)[ \-.]?|\b\d{2,4}[ \-.])\d{3,4}[ \-.]\d{3,4}\b`),
+	"ip_address":  regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`),
+}
+
+// credit cards are matched before phone numbers which would match their
+// groups of digits.
+var piiDetectorOrder = []string{"email", "credit_card", "phone", "ip_address"}
+
+// PIIRedactor returns a Redactor for the given PII detectors, all of them
+// when no detector is given.
+func PIIRedactor(detectors ...string) Redactor {
+	if len(detectors) == 0 {
+		detectors = piiDetectorOrder
+	}
+	selected := make(map[string]bool, len(detectors))
+	for _, detector := range detectors {
+		selected[detector] = true
+	}
+	var redactors chainRedactor
+	for _, detector := range piiDetectorOrder {
+		if selected[detector] {
+			redactors = append(redactors, NewRegexRedactor(piiPatterns[detector]))
+		}
+	}
+	return redactors
+}
+
+func redactorFromEnv(detectors string, pattern string) Redactor {
+	var redactors chainRedactor
+	var names []string
+	for _, name := range strings.Split(detectors, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "pii" {
+			names = piiDetectorOrder
+			break
+		}
+		if _, ok := piiPatterns[name]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		redactors = append(redactors, PIIRedactor(names...))
+	}
+	if pattern != "" {
+		if re, err := regexp.Compile(pattern); err == nil {
+			redactors = append(redactors, NewRegexRedactor(re))
+		}
+	}
+	if len(redactors) == 0 {
+		return nil
+	}
+	return redactors
+}
+
+// atomic.Value requires values of a consistent concrete type.
+type redactorHolder struct {
+	r Redactor
+}
+
+// SetContentRedactor replaces the Redactor configured by
+// OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION and
+// OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION_PATTERN, nil disables
+// redaction.
+func SetContentRedactor(r Redactor) {
+	redactor.Store(redactorHolder{r: r})
+}
+
+func redact(content string) string {
+	r := redactor.Load().(redactorHolder).r
+	if r == nil || content == "" {
+		return content
+	}
+	return r.Redact(content)
+}
+
+// RedactContent redacts free-form content and truncates it to
+// OTEL_INSTRUMENTATION_GENAI_MESSAGES_MAX_TOTAL_BYTES.
+func RedactContent(content string) string {
+	content = redact(content)
+	if totalMaxBytes > 0 {
+		content = truncateUTF8(content, totalMaxBytes)
+	}
+	return content
+}
+
+// MarshalContent redacts the strings of a JSON value such as tool call
+// arguments or results, and returns it encoded truncated to
+// OTEL_INSTRUMENTATION_GENAI_MESSAGES_MAX_TOTAL_BYTES.
+func MarshalContent(value any) string {
+	if value == nil {
+		return ""
+	}
+	data, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return ""
+	}
+	content := string(data)
+	if totalMaxBytes > 0 {
+		content = truncateUTF8(content, totalMaxBytes)
+	}
+	return content
+}
+
+// MarshalMessages redacts messages, truncates them to the configured limits
+// and returns them as the value of gen_ai.input.messages or
+// gen_ai.output.messages.
+func MarshalMessages(messages []ChatMessage) string {
+	encoded := make([][]byte, 0, len(messages))
+	for _, message := range messages {
+		message.Parts = limitParts(redactParts(message.Parts), messageMaxBytes)
+		data, err := json.Marshal(message)
+		if err != nil {
+			continue
+		}
+		encoded = append(encoded, data)
+	}
+	if len(encoded) == 0 {
+		return ""
+	}
+	first := keptFrom(encoded, totalMaxBytes)
+	if first == len(encoded) {
+		// Not even the latest message fits, its content is truncated instead
+		last := messages[len(messages)-1]
+		last.Parts = limitParts(redactParts(last.Parts), totalMaxBytes)
+		data, err := json.Marshal([]ChatMessage{last})
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, data := range encoded[first:] {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.Write(data)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// MarshalSystemInstructions redacts instructions, truncates them to the
+// configured limits and returns them as the value of
+// gen_ai.system_instructions.
+func MarshalSystemInstructions(parts []MessagePart) string {
+	if len(parts) == 0 {
+		return ""
+	}
+	limit := messageMaxBytes
+	if totalMaxBytes > 0 && (limit == 0 || totalMaxBytes < limit) {
+		limit = totalMaxBytes
+	}
+	data, err := json.Marshal(limitParts(redactParts(parts), limit))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// keptFrom returns the index of the first of the latest encoded messages
+// fitting in limit bytes once joined in a JSON array.
+func keptFrom(encoded [][]byte, limit int) int {
+	if limit == 0 {
+		return 0
+	}
+	size := 1
+	for i := len(encoded) - 1; i >= 0; i-- {
+		size += len(encoded[i]) + 1
+		if size > limit {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func redactParts(parts []MessagePart) []MessagePart {
+	redacted := make([]MessagePart, len(parts))
+	for i, part := range parts {
+		part.Content = redact(part.Content)
+		part.Arguments = redactValue(part.Arguments)
+		part.Response = redactValue(part.Response)
+		redacted[i] = part
+	}
+	return redacted
+}
+
+// redactValue redacts the strings of a JSON value, so that the redaction
+// keeps it valid JSON. The value is copied rather than redacted in place, it
+// is often the live arguments of the call being instrumented.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return redact(v)
+	case map[string]any:
+		redacted := make(map[string]any, len(v))
+		for key, item := range v {
+			redacted[key] = redactValue(item)
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, item := range v {
+			redacted[i] = redactValue(item)
+		}
+		return redacted
+	case bool, float64:
+		return v
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return value
+	}
+	return redactValue(decoded)
+}
+
+// limitParts truncates the content of parts to limit bytes in total, the
+// arguments and responses exceeding the remaining budget being recorded as
+// truncated JSON strings.
+func limitParts(parts []MessagePart, limit int) []MessagePart {
+	if limit == 0 {
+		return parts
+	}
+	remaining := limit
+	for i := range parts {
+		part := &parts[i]
+		part.Content = truncateUTF8(part.Content, remaining)
+		remaining -= len(part.Content)
+		part.Arguments, remaining = limitValue(part.Arguments, remaining)
+		part.Response, remaining = limitValue(part.Response, remaining)
+	}
+	return parts
+}
+
+func limitValue(value any, remaining int) (any, int) {
+	if value == nil {
+		return nil, remaining
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, remaining
+	}
+	if len(data) <= remaining {
+		return value, remaining - len(data)
+	}
+	if remaining == 0 {
+		return nil, 0
+	}
+	return truncateUTF8(string(data), remaining), 0
+}
+
+func truncateUTF8(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
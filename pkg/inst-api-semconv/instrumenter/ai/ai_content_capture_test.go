@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	semconv7 "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+func withContentCapture(t *testing.T, mode contentCaptureMode, messageMax int, totalMax int) {
+	oldMode, oldMessageMax, oldTotalMax := captureMode, messageMaxBytes, totalMaxBytes
+	oldRedactor := redactor.Load()
+	captureMode, messageMaxBytes, totalMaxBytes = mode, messageMax, totalMax
+	t.Cleanup(func() {
+		captureMode, messageMaxBytes, totalMaxBytes = oldMode, oldMessageMax, oldTotalMax
+		redactor.Store(oldRedactor)
+	})
+}
+
+func TestParseContentCaptureMode(t *testing.T) {
+	assert.Equal(t, contentCaptureEnabled, parseContentCaptureMode("true"))
+	assert.Equal(t, contentCaptureEnabled, parseContentCaptureMode(" TRUE "))
+	assert.Equal(t, contentCaptureDisabled, parseContentCaptureMode("false"))
+	assert.Equal(t, contentCaptureDefault, parseContentCaptureMode(""))
+	assert.Equal(t, contentCaptureDefault, parseContentCaptureMode("yes"))
+}
+
+func TestPIIRedactor(t *testing.T) {
+	r := PIIRedactor()
+	assert.Equal(t, "mail [REDACTED] now", r.Redact("mail john.doe@example.com now"))
+	assert.Equal(t, "card [REDACTED]", r.Redact("card 4111 1111 1111 1111"))
+	assert.Equal(t, "call [REDACTED]", r.Redact("call +1 415-555-0100"))
+	assert.Equal(t, "host [REDACTED]", r.Redact("host 192.168.0.1"))
+	assert.Equal(t, "nothing to hide", r.Redact("nothing to hide"))
+
+	emailOnly := PIIRedactor("email")
+	assert.Equal(t, "[REDACTED] 192.168.0.1", emailOnly.Redact("a@b.io 192.168.0.1"))
+}
+
+func TestRedactorFromEnv(t *testing.T) {
+	assert.Nil(t, redactorFromEnv("", ""))
+	assert.Nil(t, redactorFromEnv("unknown", "("))
+	r := redactorFromEnv("email, ip_address", `secret-\w+`)
+	assert.Equal(t, "[REDACTED] [REDACTED] [REDACTED] 415-555-0100", r.Redact("a@b.io 10.0.0.1 secret-abc 415-555-0100"))
+	all := redactorFromEnv("pii", "")
+	assert.Equal(t, "[REDACTED]", all.Redact("415-555-0100"))
+}
+
+func TestRedactContent(t *testing.T) {
+	withContentCapture(t, contentCaptureDefault, 0, 8)
+	SetContentRedactor(RedactorFunc(func(content string) string {
+		return strings.ReplaceAll(content, "secret", "******")
+	}))
+	assert.Equal(t, "my *****", RedactContent("my secret key"))
+	// Truncation keeps valid UTF-8
+	assert.Equal(t, "日本", RedactContent("日本語"))
+	SetContentRedactor(nil)
+	assert.Equal(t, "my secre", RedactContent("my secret key"))
+}
+
+func TestMarshalMessagesRedactsArguments(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 0, 0)
+	SetContentRedactor(NewRegexRedactor(regexp.MustCompile(`\d{3}-\d{4}`)))
+	messages := []ChatMessage{{
+		Role: "assistant",
+		Parts: []MessagePart{
+			TextPart("calling 555-0100"),
+			ToolCallPart("call_1", "dial", `{"number":"555-0100","retries":3}`),
+		},
+		FinishReason: "tool_calls",
+	}}
+	var decoded []map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(MarshalMessages(messages)), &decoded))
+	assert.Equal(t, []map[string]any{{
+		"role": "assistant",
+		"parts": []any{
+			map[string]any{"type": "text", "content": "calling [REDACTED]"},
+			map[string]any{"type": "tool_call", "id": "call_1", "name": "dial", "arguments": map[string]any{"number": "[REDACTED]", "retries": float64(3)}},
+		},
+		"finish_reason": "tool_calls",
+	}}, decoded)
+	// The messages of the caller are left untouched
+	assert.Equal(t, "calling 555-0100", messages[0].Parts[0].Content)
+}
+
+func TestMarshalContentLeavesValueUntouched(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 0, 0)
+	SetContentRedactor(NewRegexRedactor(regexp.MustCompile(`secret`)))
+	arguments := map[string]any{
+		"q":       "my secret",
+		"filters": []any{"secret", map[string]any{"tag": "secret"}},
+	}
+	assert.Equal(t, `{"filters":["[REDACTED]",{"tag":"[REDACTED]"}],"q":"my [REDACTED]"}`, MarshalContent(arguments))
+	assert.Equal(t, map[string]any{
+		"q":       "my secret",
+		"filters": []any{"secret", map[string]any{"tag": "secret"}},
+	}, arguments)
+
+	messages := []ChatMessage{{
+		Role:  "assistant",
+		Parts: []MessagePart{{Type: MessagePartTypeToolCall, ID: "call_1", Name: "search", Arguments: arguments}},
+	}}
+	MarshalMessages(messages)
+	assert.Equal(t, "my secret", messages[0].Parts[0].Arguments.(map[string]any)["q"])
+}
+
+func TestMarshalMessagesLimits(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 5, 0)
+	SetContentRedactor(nil)
+	messages := []ChatMessage{
+		{Role: "user", Parts: []MessagePart{TextPart("first message"), TextPart("second part")}},
+		{Role: "assistant", Parts: []MessagePart{TextPart("ok")}},
+	}
+	assert.Equal(t, `[{"role":"user","parts":[{"type":"text","content":"first"},{"type":"text"}]},{"role":"assistant","parts":[{"type":"text","content":"ok"}]}]`, MarshalMessages(messages))
+
+	// The latest messages fitting in the total limit are kept
+	messageMaxBytes, totalMaxBytes = 0, 60
+	assert.Equal(t, `[{"role":"assistant","parts":[{"type":"text","content":"ok"}]}]`, MarshalMessages(messages))
+
+	// The latest message is truncated when it does not fit on its own
+	messages = append(messages, ChatMessage{Role: "user", Parts: []MessagePart{TextPart(strings.Repeat("x", 100))}})
+	assert.Equal(t, `[{"role":"user","parts":[{"type":"text","content":"`+strings.Repeat("x", 60)+`"}]}]`, MarshalMessages(messages))
+
+	assert.Equal(t, "", MarshalMessages(nil))
+}
+
+func TestMarshalSystemInstructions(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 10, 4)
+	SetContentRedactor(nil)
+	assert.Equal(t, `[{"type":"text","content":"Be b"}]`, MarshalSystemInstructions([]MessagePart{TextPart("Be brief.")}))
+	assert.Equal(t, "", MarshalSystemInstructions(nil))
+}
+
+type messagesRequest struct {
+	ollamaRequest
+}
+
+func (messagesRequest) GetAIInput(request testRequest) string {
+	return "flat input"
+}
+
+func (messagesRequest) GetAIOutput(response testResponse) string {
+	return "flat output"
+}
+
+func (messagesRequest) GetAISystemInstructions(request testRequest) []MessagePart {
+	return []MessagePart{TextPart("Be brief.")}
+}
+
+func (messagesRequest) GetAIInputMessages(request testRequest) []ChatMessage {
+	return []ChatMessage{{Role: "user", Parts: []MessagePart{TextPart("Hi")}}}
+}
+
+func (messagesRequest) GetAIOutputMessages(request testRequest, response testResponse) []ChatMessage {
+	return []ChatMessage{{Role: "assistant", Parts: []MessagePart{TextPart("Hello")}, FinishReason: "stop"}}
+}
+
+func contentAttributes(mode contentCaptureMode, t *testing.T) map[attribute.Key]string {
+	withContentCapture(t, mode, 0, 0)
+	SetContentRedactor(nil)
+	extractor := AILLMAttrsExtractor[testRequest, testResponse, commonRequest, messagesRequest]{
+		LLMGetter: messagesRequest{},
+	}
+	attrs, _ := extractor.OnStart(nil, context.Background(), testRequest{Operation: "chat", System: "test"})
+	attrs, _ = extractor.OnEnd(attrs, context.Background(), testRequest{Operation: "chat", System: "test"}, testResponse{}, nil)
+	content := make(map[attribute.Key]string)
+	for _, attr := range attrs {
+		switch attr.Key {
+		case semconv7.GenAIInputMessagesKey, semconv7.GenAIOutputMessagesKey, semconv7.GenAISystemInstructionsKey:
+			content[attr.Key] = attr.Value.AsString()
+		}
+	}
+	return content
+}
+
+func TestAILLMAttrsExtractorMessageContent(t *testing.T) {
+	assert.Equal(t, map[attribute.Key]string{
+		semconv7.GenAISystemInstructionsKey: `[{"type":"text","content":"Be brief."}]`,
+		semconv7.GenAIInputMessagesKey:      `[{"role":"user","parts":[{"type":"text","content":"Hi"}]}]`,
+		semconv7.GenAIOutputMessagesKey:     `[{"role":"assistant","parts":[{"type":"text","content":"Hello"}],"finish_reason":"stop"}]`,
+	}, contentAttributes(contentCaptureEnabled, t))
+}
+
+func TestAILLMAttrsExtractorFlattenedContent(t *testing.T) {
+	assert.Equal(t, map[attribute.Key]string{
+		semconv7.GenAIInputMessagesKey:  "flat input",
+		semconv7.GenAIOutputMessagesKey: "flat output",
+	}, contentAttributes(contentCaptureDefault, t))
+}
+
+func TestAILLMAttrsExtractorContentDisabled(t *testing.T) {
+	assert.Empty(t, contentAttributes(contentCaptureDisabled, t))
+}
+
+func TestMarshalContent(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 0, 0)
+	SetContentRedactor(PIIRedactor("email"))
+	assert.Equal(t, `{"to":"[REDACTED]","urgent":true}`, MarshalContent(map[string]any{"to": "a@b.io", "urgent": true}))
+	assert.Equal(t, `{"content":[{"text":"[REDACTED]"}]}`, MarshalContent(json.RawMessage(`{"content":[{"text":"a@b.io"}]}`)))
+	assert.Equal(t, "", MarshalContent(nil))
+	totalMaxBytes = 4
+	assert.Equal(t, `"abc`, MarshalContent("abcdef"))
+}
@@ -0,0 +1,383 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// OTEL_INSTRUMENTATION_GENAI_COST_ENABLED set to false disables the cost
+// accounting of GenAI client operations.
+const OTEL_INSTRUMENTATION_GENAI_COST_ENABLED = "OTEL_INSTRUMENTATION_GENAI_COST_ENABLED"
+
+// OTEL_INSTRUMENTATION_GENAI_COST_CONFIG is the path of a JSON file holding
+// the currency, exchange rates, model prices and budgets of the cost
+// accounting.
+const OTEL_INSTRUMENTATION_GENAI_COST_CONFIG = "OTEL_INSTRUMENTATION_GENAI_COST_CONFIG"
+
+// OTEL_INSTRUMENTATION_GENAI_COST_CURRENCY is the currency costs are reported
+// in, USD by default.
+const OTEL_INSTRUMENTATION_GENAI_COST_CURRENCY = "OTEL_INSTRUMENTATION_GENAI_COST_CURRENCY"
+
+// The Ollama specific variables are still honored when the GenAI ones are
+// unset.
+const (
+	ollamaCostConfig      = "OLLAMA_COST_CONFIG"
+	ollamaEnableCost      = "OLLAMA_ENABLE_COST_TRACKING"
+	ollamaDefaultCurrency = "OLLAMA_DEFAULT_CURRENCY"
+)
+
+const (
+	GenAIUsageCacheReadInputTokensKey     = attribute.Key("gen_ai.usage.cache_read.input_tokens")
+	GenAIUsageCacheCreationInputTokensKey = attribute.Key("gen_ai.usage.cache_creation.input_tokens")
+	GenAICostCurrencyKey                  = attribute.Key("gen_ai.cost.currency")
+	GenAICostTeamKey                      = attribute.Key("gen_ai.cost.team")
+	GenAIBudgetNameKey                    = attribute.Key("gen_ai.budget.name")
+	GenAIBudgetPeriodKey                  = attribute.Key("gen_ai.budget.period")
+)
+
+type Currency string
+
+const (
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	CNY Currency = "CNY"
+	GBP Currency = "GBP"
+	JPY Currency = "JPY"
+)
+
+var defaultExchangeRates = map[Currency]float64{
+	USD: 1.0,
+	EUR: 0.85,
+	CNY: 7.25,
+	GBP: 0.73,
+	JPY: 149.5,
+}
+
+// ModelPricing is the price of a model per 1K tokens. Cached input tokens are
+// billed at CachedInputCostPer1K and the tokens written to the prompt cache at
+// CacheWriteCostPer1K, both falling back to InputCostPer1K when unset.
+type ModelPricing struct {
+	ModelID              string   `json:"model_id,omitempty"`
+	InputCostPer1K       float64  `json:"input_cost_per_1k"`
+	OutputCostPer1K      float64  `json:"output_cost_per_1k"`
+	CachedInputCostPer1K float64  `json:"cached_input_cost_per_1k,omitempty"`
+	CacheWriteCostPer1K  float64  `json:"cache_write_cost_per_1k,omitempty"`
+	Currency             Currency `json:"currency,omitempty"`
+	Tier                 string   `json:"tier,omitempty"`
+}
+
+func (p *ModelPricing) cachedInputCostPer1K() float64 {
+	if p.CachedInputCostPer1K > 0 {
+		return p.CachedInputCostPer1K
+	}
+	return p.InputCostPer1K
+}
+
+func (p *ModelPricing) cacheWriteCostPer1K() float64 {
+	if p.CacheWriteCostPer1K > 0 {
+		return p.CacheWriteCostPer1K
+	}
+	return p.InputCostPer1K
+}
+
+// TokenUsage is the token usage of a GenAI operation. InputTokens includes
+// the cached tokens, as gen_ai.usage.input_tokens does.
+type TokenUsage struct {
+	InputTokens              int64
+	OutputTokens             int64
+	CacheReadInputTokens     int64
+	CacheCreationInputTokens int64
+}
+
+func (u TokenUsage) uncachedInputTokens() int64 {
+	uncached := u.InputTokens - u.CacheReadInputTokens - u.CacheCreationInputTokens
+	if uncached < 0 {
+		return 0
+	}
+	return uncached
+}
+
+type CostMetrics struct {
+	Usage             TokenUsage
+	InputCost         float64
+	OutputCost        float64
+	CacheReadCost     float64
+	CacheCreationCost float64
+	TotalCost         float64
+	Currency          Currency
+	ModelID           string
+	PricingTier       string
+	Timestamp         time.Time
+}
+
+// costConfig is the layout of the OTEL_INSTRUMENTATION_GENAI_COST_CONFIG file.
+type costConfig struct {
+	DefaultCurrency string                   `json:"default_currency,omitempty"`
+	ExchangeRates   map[Currency]float64     `json:"exchange_rates,omitempty"`
+	ModelPricing    map[string]*ModelPricing `json:"model_pricing,omitempty"`
+	Budgets         []BudgetConfig           `json:"budgets,omitempty"`
+}
+
+type PricingDatabase struct {
+	mu       sync.RWMutex
+	prices   map[string]*ModelPricing
+	currency Currency
+	rates    map[Currency]float64
+}
+
+func newPricingDatabase() *PricingDatabase {
+	db := &PricingDatabase{
+		prices:   make(map[string]*ModelPricing, len(defaultModelPricing)),
+		currency: USD,
+		rates:    make(map[Currency]float64, len(defaultExchangeRates)),
+	}
+	for modelID, pricing := range defaultModelPricing {
+		p := *pricing
+		p.ModelID = modelID
+		db.prices[modelID] = &p
+	}
+	for currency, rate := range defaultExchangeRates {
+		db.rates[currency] = rate
+	}
+	return db
+}
+
+func (db *PricingDatabase) applyConfig(config *costConfig) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if config.DefaultCurrency != "" {
+		db.currency = Currency(strings.ToUpper(config.DefaultCurrency))
+	}
+	for currency, rate := range config.ExchangeRates {
+		if rate > 0 {
+			db.rates[currency] = rate
+		}
+	}
+	for modelID, pricing := range config.ModelPricing {
+		if pricing == nil {
+			continue
+		}
+		pricing.ModelID = modelID
+		if pricing.Currency == "" {
+			pricing.Currency = USD
+		}
+		db.prices[strings.ToLower(modelID)] = pricing
+	}
+}
+
+// GetModelPricing looks the model up by its exact name, its lowercase name,
+// its name without the Ollama tag or the Gemini models/ prefix, and finally
+// by the longest known prefix, which matches the dated model releases.
+func (db *PricingDatabase) GetModelPricing(modelID string) (*ModelPricing, bool) {
+	if modelID == "" {
+		return nil, false
+	}
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	if pricing, ok := db.prices[modelID]; ok {
+		return pricing, true
+	}
+	name := strings.ToLower(modelID)
+	name = strings.TrimPrefix(name, "models/")
+	if pricing, ok := db.prices[name]; ok {
+		return pricing, true
+	}
+	if i := strings.LastIndex(name, ":"); i > 0 {
+		if pricing, ok := db.prices[name[:i]]; ok {
+			return pricing, true
+		}
+	}
+	var best *ModelPricing
+	bestLen := 0
+	for id, pricing := range db.prices {
+		if len(id) > bestLen && strings.HasPrefix(name, id) && isModelNameBoundary(name, len(id)) {
+			best, bestLen = pricing, len(id)
+		}
+	}
+	return best, best != nil
+}
+
+// isModelNameBoundary keeps gpt-4o from being priced as gpt-4.
+func isModelNameBoundary(name string, i int) bool {
+	if i == len(name) {
+		return true
+	}
+	switch name[i] {
+	case '-', ':', '@', '.', '_', '/':
+		return true
+	}
+	return false
+}
+
+func (db *PricingDatabase) SetModelPricing(modelID string, pricing *ModelPricing) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	pricing.ModelID = modelID
+	db.prices[modelID] = pricing
+}
+
+func (db *PricingDatabase) GetCurrency() Currency {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.currency
+}
+
+func (db *PricingDatabase) SetCurrency(currency Currency) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.currency = currency
+}
+
+// ConvertCurrency converts the amount through the USD exchange rates, unknown
+// currencies are left unconverted.
+func (db *PricingDatabase) ConvertCurrency(amount float64, from, to Currency) float64 {
+	if from == to || from == "" || to == "" {
+		return amount
+	}
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	amountInUSD := amount
+	if from != USD {
+		if rate, ok := db.rates[from]; ok && rate > 0 {
+			amountInUSD = amount / rate
+		}
+	}
+	if to != USD {
+		if rate, ok := db.rates[to]; ok {
+			return amountInUSD * rate
+		}
+	}
+	return amountInUSD
+}
+
+type CostCalculator struct {
+	pricingDB *PricingDatabase
+	enabled   bool
+}
+
+var (
+	pricingDB      = newPricingDatabase()
+	costCalculator = &CostCalculator{pricingDB: pricingDB, enabled: costEnabledFromEnv()}
+)
+
+func init() {
+	if currency := firstEnv(OTEL_INSTRUMENTATION_GENAI_COST_CURRENCY, ollamaDefaultCurrency); currency != "" {
+		pricingDB.SetCurrency(Currency(strings.ToUpper(currency)))
+	}
+	if path := firstEnv(OTEL_INSTRUMENTATION_GENAI_COST_CONFIG, ollamaCostConfig); path != "" {
+		if err := LoadCostConfig(path); err != nil {
+			log.Printf("failed to load the GenAI cost config %s, err is %v\n", path, err)
+		}
+	}
+	addBudgetFromEnv()
+}
+
+func firstEnv(keys ...string) string {
+	for _, key := range keys {
+		if val := strings.TrimSpace(os.Getenv(key)); val != "" {
+			return val
+		}
+	}
+	return ""
+}
+
+func costEnabledFromEnv() bool {
+	val := firstEnv(OTEL_INSTRUMENTATION_GENAI_COST_ENABLED, ollamaEnableCost)
+	if val == "" {
+		return true
+	}
+	enabled, err := strconv.ParseBool(val)
+	return err != nil || enabled
+}
+
+// LoadCostConfig merges the currency, exchange rates, model prices and
+// budgets of the JSON file into the cost accounting.
+func LoadCostConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var config costConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+	pricingDB.applyConfig(&config)
+	for _, budget := range config.Budgets {
+		AddBudget(budget)
+	}
+	return nil
+}
+
+// SetModelPricing overrides the price of a model.
+func SetModelPricing(modelID string, pricing ModelPricing) {
+	if pricing.Currency == "" {
+		pricing.Currency = USD
+	}
+	pricingDB.SetModelPricing(strings.ToLower(modelID), &pricing)
+}
+
+// CostTrackingEnabled reports whether the cost of GenAI operations is
+// recorded.
+func CostTrackingEnabled() bool {
+	return costCalculator.enabled
+}
+
+// CalculateCost prices the token usage of the model in the configured
+// currency. It returns false when cost tracking is disabled or the model has
+// no known price.
+func CalculateCost(modelID string, usage TokenUsage) (*CostMetrics, bool) {
+	return costCalculator.CalculateCost(modelID, usage)
+}
+
+func (c *CostCalculator) CalculateCost(modelID string, usage TokenUsage) (*CostMetrics, bool) {
+	if !c.enabled {
+		return nil, false
+	}
+	pricing, ok := c.pricingDB.GetModelPricing(modelID)
+	if !ok {
+		return nil, false
+	}
+	currency := c.pricingDB.GetCurrency()
+	convert := func(tokens int64, per1K float64) float64 {
+		return roundCost(c.pricingDB.ConvertCurrency(float64(tokens)/1000.0*per1K, pricing.Currency, currency))
+	}
+	metrics := &CostMetrics{
+		Usage:             usage,
+		InputCost:         convert(usage.uncachedInputTokens(), pricing.InputCostPer1K),
+		OutputCost:        convert(usage.OutputTokens, pricing.OutputCostPer1K),
+		CacheReadCost:     convert(usage.CacheReadInputTokens, pricing.cachedInputCostPer1K()),
+		CacheCreationCost: convert(usage.CacheCreationInputTokens, pricing.cacheWriteCostPer1K()),
+		Currency:          currency,
+		ModelID:           modelID,
+		PricingTier:       pricing.Tier,
+		Timestamp:         time.Now(),
+	}
+	metrics.TotalCost = roundCost(metrics.InputCost + metrics.OutputCost + metrics.CacheReadCost + metrics.CacheCreationCost)
+	return metrics, true
+}
+
+func roundCost(cost float64) float64 {
+	return math.Round(cost*1e9) / 1e9
+}
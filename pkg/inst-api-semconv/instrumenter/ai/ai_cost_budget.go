@@ -0,0 +1,282 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// OTEL_INSTRUMENTATION_GENAI_COST_BUDGET is the amount of a budget applied to
+// all GenAI operations, in the currency of the cost accounting.
+const OTEL_INSTRUMENTATION_GENAI_COST_BUDGET = "OTEL_INSTRUMENTATION_GENAI_COST_BUDGET"
+
+// OTEL_INSTRUMENTATION_GENAI_COST_BUDGET_PERIOD is the period after which
+// that budget is reset: hourly, daily, weekly, monthly or none. It is daily by
+// default.
+const OTEL_INSTRUMENTATION_GENAI_COST_BUDGET_PERIOD = "OTEL_INSTRUMENTATION_GENAI_COST_BUDGET_PERIOD"
+
+// OTEL_INSTRUMENTATION_GENAI_COST_TEAM_BAGGAGE_KEY is the baggage member whose
+// value attributes the cost to a team, team by default.
+const OTEL_INSTRUMENTATION_GENAI_COST_TEAM_BAGGAGE_KEY = "OTEL_INSTRUMENTATION_GENAI_COST_TEAM_BAGGAGE_KEY"
+
+type BudgetPeriod string
+
+const (
+	BudgetPeriodHourly  BudgetPeriod = "hourly"
+	BudgetPeriodDaily   BudgetPeriod = "daily"
+	BudgetPeriodWeekly  BudgetPeriod = "weekly"
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+	BudgetPeriodNone    BudgetPeriod = "none"
+)
+
+type BudgetStatus string
+
+const (
+	BudgetOK       BudgetStatus = "ok"
+	BudgetWarning  BudgetStatus = "warning"
+	BudgetCritical BudgetStatus = "critical"
+	BudgetExceeded BudgetStatus = "exceeded"
+)
+
+// level is the value of the gen_ai.client.budget.status gauge.
+func (s BudgetStatus) level() int64 {
+	switch s {
+	case BudgetWarning:
+		return 1
+	case BudgetCritical:
+		return 2
+	case BudgetExceeded:
+		return 3
+	default:
+		return 0
+	}
+}
+
+type BudgetThreshold struct {
+	Percentage float64      `json:"percentage"`
+	Status     BudgetStatus `json:"status"`
+}
+
+var defaultThresholds = []BudgetThreshold{
+	{Percentage: 80, Status: BudgetWarning},
+	{Percentage: 90, Status: BudgetCritical},
+	{Percentage: 100, Status: BudgetExceeded},
+}
+
+// BudgetConfig is a spending limit over a period. A budget with a Model only
+// accounts the models starting with it, and one with a Team only the
+// operations of that team.
+type BudgetConfig struct {
+	Name       string            `json:"name"`
+	Amount     float64           `json:"amount"`
+	Period     BudgetPeriod      `json:"period,omitempty"`
+	Model      string            `json:"model,omitempty"`
+	Team       string            `json:"team,omitempty"`
+	Thresholds []BudgetThreshold `json:"thresholds,omitempty"`
+}
+
+// BudgetAlert is raised when a budget changes its status.
+type BudgetAlert struct {
+	Budget         string
+	Model          string
+	Team           string
+	Status         BudgetStatus
+	PreviousStatus BudgetStatus
+	Spend          float64
+	Amount         float64
+	Utilization    float64
+	Currency       Currency
+}
+
+type budgetState struct {
+	config      *BudgetConfig
+	spend       float64
+	utilization float64
+	status      BudgetStatus
+}
+
+type budgetTracker struct {
+	mu          sync.Mutex
+	config      BudgetConfig
+	spend       float64
+	periodStart time.Time
+	status      BudgetStatus
+}
+
+var (
+	budgetsMu    sync.RWMutex
+	budgets      []*budgetTracker
+	alertHandler func(BudgetAlert)
+	teamKey      = teamKeyFromEnv()
+)
+
+func teamKeyFromEnv() string {
+	if key := firstEnv(OTEL_INSTRUMENTATION_GENAI_COST_TEAM_BAGGAGE_KEY); key != "" {
+		return key
+	}
+	return "team"
+}
+
+func addBudgetFromEnv() {
+	val := firstEnv(OTEL_INSTRUMENTATION_GENAI_COST_BUDGET)
+	if val == "" {
+		return
+	}
+	amount, err := strconv.ParseFloat(val, 64)
+	if err != nil || amount <= 0 {
+		log.Printf("invalid %s %q, no budget is tracked\n", OTEL_INSTRUMENTATION_GENAI_COST_BUDGET, val)
+		return
+	}
+	AddBudget(BudgetConfig{
+		Name:   "default",
+		Amount: amount,
+		Period: BudgetPeriod(strings.ToLower(firstEnv(OTEL_INSTRUMENTATION_GENAI_COST_BUDGET_PERIOD))),
+	})
+}
+
+// AddBudget tracks the spending against a budget. Budgets without a positive
+// amount are ignored.
+func AddBudget(config BudgetConfig) {
+	if config.Amount <= 0 {
+		return
+	}
+	if config.Name == "" {
+		config.Name = "default"
+	}
+	if config.Period == "" {
+		config.Period = BudgetPeriodDaily
+	}
+	if len(config.Thresholds) == 0 {
+		config.Thresholds = defaultThresholds
+	}
+	budgetsMu.Lock()
+	defer budgetsMu.Unlock()
+	budgets = append(budgets, &budgetTracker{
+		config:      config,
+		periodStart: budgetPeriodStart(config.Period, time.Now()),
+		status:      BudgetOK,
+	})
+}
+
+// ResetBudgets drops all the tracked budgets.
+func ResetBudgets() {
+	budgetsMu.Lock()
+	defer budgetsMu.Unlock()
+	budgets = nil
+}
+
+// SetBudgetAlertHandler is called on every budget status change, in addition
+// to the log. The handler must not block.
+func SetBudgetAlertHandler(handler func(BudgetAlert)) {
+	budgetsMu.Lock()
+	defer budgetsMu.Unlock()
+	alertHandler = handler
+}
+
+// teamFromContext returns the team the operation is attributed to by the
+// baggage.
+func teamFromContext(ctx context.Context) string {
+	return baggage.FromContext(ctx).Member(teamKey).Value()
+}
+
+// recordBudgets adds the cost to the budgets matching the model and team and
+// returns their updated state.
+func recordBudgets(model, team string, cost float64, now time.Time) []budgetState {
+	budgetsMu.RLock()
+	trackers := budgets
+	handler := alertHandler
+	budgetsMu.RUnlock()
+	if len(trackers) == 0 {
+		return nil
+	}
+	states := make([]budgetState, 0, len(trackers))
+	for _, tracker := range trackers {
+		if !tracker.matches(model, team) {
+			continue
+		}
+		state, previous := tracker.record(cost, now)
+		states = append(states, state)
+		if previous != state.status {
+			alert := BudgetAlert{
+				Budget:         tracker.config.Name,
+				Model:          model,
+				Team:           team,
+				Status:         state.status,
+				PreviousStatus: previous,
+				Spend:          state.spend,
+				Amount:         tracker.config.Amount,
+				Utilization:    state.utilization,
+				Currency:       pricingDB.GetCurrency(),
+			}
+			log.Printf("GenAI budget %s is %s, spent %.6f of %.6f %s\n", alert.Budget, alert.Status, alert.Spend, alert.Amount, alert.Currency)
+			if handler != nil {
+				handler(alert)
+			}
+		}
+	}
+	return states
+}
+
+func (b *budgetTracker) matches(model, team string) bool {
+	if b.config.Model != "" && !strings.HasPrefix(strings.ToLower(model), strings.ToLower(b.config.Model)) {
+		return false
+	}
+	return b.config.Team == "" || b.config.Team == team
+}
+
+// record resets the spend when a new period has started, so that no timer is
+// needed.
+func (b *budgetTracker) record(cost float64, now time.Time) (budgetState, BudgetStatus) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	previous := b.status
+	if start := budgetPeriodStart(b.config.Period, now); start.After(b.periodStart) {
+		b.periodStart = start
+		b.spend = 0
+	}
+	b.spend += cost
+	utilization := b.spend / b.config.Amount
+	b.status = BudgetOK
+	for _, threshold := range b.config.Thresholds {
+		if utilization*100 >= threshold.Percentage && threshold.Status.level() >= b.status.level() {
+			b.status = threshold.Status
+		}
+	}
+	return budgetState{config: &b.config, spend: b.spend, utilization: utilization, status: b.status}, previous
+}
+
+func budgetPeriodStart(period BudgetPeriod, now time.Time) time.Time {
+	year, month, day := now.Date()
+	switch period {
+	case BudgetPeriodHourly:
+		return now.Truncate(time.Hour)
+	case BudgetPeriodWeekly:
+		daysSinceMonday := (int(now.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, now.Location())
+	case BudgetPeriodMonthly:
+		return time.Date(year, month, 1, 0, 0, 0, 0, now.Location())
+	case BudgetPeriodNone:
+		return time.Time{}
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+	}
+}
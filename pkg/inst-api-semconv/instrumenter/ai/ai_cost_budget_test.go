@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestBudgetThresholds(t *testing.T) {
+	withCostTracking(t)
+	var alerts []BudgetAlert
+	SetBudgetAlertHandler(func(alert BudgetAlert) {
+		alerts = append(alerts, alert)
+	})
+	AddBudget(BudgetConfig{Name: "total", Amount: 10, Period: BudgetPeriodNone})
+	now := time.Now()
+
+	states := recordBudgets("gpt-4o", "", 5, now)
+	if assert.Len(t, states, 1) {
+		assert.Equal(t, BudgetOK, states[0].status)
+		assert.InDelta(t, 0.5, states[0].utilization, 1e-9)
+	}
+	assert.Empty(t, alerts)
+
+	assert.Equal(t, BudgetWarning, recordBudgets("gpt-4o", "", 3.5, now)[0].status)
+	assert.Equal(t, BudgetCritical, recordBudgets("gpt-4o", "", 1, now)[0].status)
+	assert.Equal(t, BudgetCritical, recordBudgets("gpt-4o", "", 0.1, now)[0].status)
+	assert.Equal(t, BudgetExceeded, recordBudgets("gpt-4o", "", 1, now)[0].status)
+
+	if assert.Len(t, alerts, 3) {
+		assert.Equal(t, BudgetOK, alerts[0].PreviousStatus)
+		assert.Equal(t, BudgetWarning, alerts[0].Status)
+		assert.Equal(t, BudgetExceeded, alerts[2].Status)
+		assert.InDelta(t, 10.6, alerts[2].Spend, 1e-9)
+		assert.Equal(t, "total", alerts[2].Budget)
+	}
+}
+
+func TestBudgetScope(t *testing.T) {
+	withCostTracking(t)
+	AddBudget(BudgetConfig{Name: "gpt", Amount: 10, Model: "gpt-4o"})
+	AddBudget(BudgetConfig{Name: "team-a", Amount: 10, Team: "a"})
+	AddBudget(BudgetConfig{Name: "ignored"})
+	now := time.Now()
+
+	assert.Len(t, recordBudgets("gpt-4o-mini", "a", 1, now), 2)
+	states := recordBudgets("claude-3-haiku", "a", 1, now)
+	if assert.Len(t, states, 1) {
+		assert.Equal(t, "team-a", states[0].config.Name)
+		assert.InDelta(t, 2.0, states[0].spend, 1e-9)
+	}
+	assert.Empty(t, recordBudgets("claude-3-haiku", "b", 1, now))
+}
+
+func TestBudgetPeriodReset(t *testing.T) {
+	withCostTracking(t)
+	AddBudget(BudgetConfig{Name: "hourly", Amount: 10, Period: BudgetPeriodHourly})
+	now := time.Now()
+	assert.Equal(t, BudgetExceeded, recordBudgets("gpt-4o", "", 20, now)[0].status)
+	states := recordBudgets("gpt-4o", "", 1, now.Add(time.Hour))
+	assert.Equal(t, BudgetOK, states[0].status)
+	assert.InDelta(t, 1.0, states[0].spend, 1e-9)
+}
+
+func TestBudgetPeriodStart(t *testing.T) {
+	// 2026-10-15 is a Thursday
+	now := time.Date(2026, 10, 15, 13, 45, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 15, 13, 0, 0, 0, time.UTC), budgetPeriodStart(BudgetPeriodHourly, now))
+	assert.Equal(t, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), budgetPeriodStart(BudgetPeriodDaily, now))
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), budgetPeriodStart(BudgetPeriodWeekly, now))
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), budgetPeriodStart(BudgetPeriodMonthly, now))
+	assert.True(t, budgetPeriodStart(BudgetPeriodNone, now).IsZero())
+}
+
+func TestTeamFromContext(t *testing.T) {
+	assert.Equal(t, "", teamFromContext(context.Background()))
+	member, err := baggage.NewMember("team", "search")
+	assert.NoError(t, err)
+	bag, err := baggage.New(member)
+	assert.NoError(t, err)
+	assert.Equal(t, "search", teamFromContext(baggage.ContextWithBaggage(context.Background(), bag)))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+// defaultModelPricing is the pricing table of the cost accounting, in USD per
+// 1K tokens. Models are matched by the longest prefix of their name, so that
+// dated releases like gpt-4o-2024-08-06 use the price of gpt-4o. Entries can be
+// overridden by the file of OTEL_INSTRUMENTATION_GENAI_COST_CONFIG.
+var defaultModelPricing = map[string]*ModelPricing{
+	// OpenAI
+	"gpt-5":                  {InputCostPer1K: 0.00125, OutputCostPer1K: 0.01, CachedInputCostPer1K: 0.000125, Currency: USD, Tier: "premium"},
+	"gpt-5-mini":             {InputCostPer1K: 0.00025, OutputCostPer1K: 0.002, CachedInputCostPer1K: 0.000025, Currency: USD, Tier: "standard"},
+	"gpt-5-nano":             {InputCostPer1K: 0.00005, OutputCostPer1K: 0.0004, CachedInputCostPer1K: 0.000005, Currency: USD, Tier: "economy"},
+	"gpt-4.1":                {InputCostPer1K: 0.002, OutputCostPer1K: 0.008, CachedInputCostPer1K: 0.0005, Currency: USD, Tier: "premium"},
+	"gpt-4.1-mini":           {InputCostPer1K: 0.0004, OutputCostPer1K: 0.0016, CachedInputCostPer1K: 0.0001, Currency: USD, Tier: "standard"},
+	"gpt-4.1-nano":           {InputCostPer1K: 0.0001, OutputCostPer1K: 0.0004, CachedInputCostPer1K: 0.000025, Currency: USD, Tier: "economy"},
+	"gpt-4o":                 {InputCostPer1K: 0.0025, OutputCostPer1K: 0.01, CachedInputCostPer1K: 0.00125, Currency: USD, Tier: "premium"},
+	"gpt-4o-mini":            {InputCostPer1K: 0.00015, OutputCostPer1K: 0.0006, CachedInputCostPer1K: 0.000075, Currency: USD, Tier: "economy"},
+	"gpt-4-turbo":            {InputCostPer1K: 0.01, OutputCostPer1K: 0.03, Currency: USD, Tier: "premium"},
+	"gpt-4":                  {InputCostPer1K: 0.03, OutputCostPer1K: 0.06, Currency: USD, Tier: "premium"},
+	"gpt-3.5-turbo":          {InputCostPer1K: 0.0005, OutputCostPer1K: 0.0015, Currency: USD, Tier: "economy"},
+	"o1":                     {InputCostPer1K: 0.015, OutputCostPer1K: 0.06, CachedInputCostPer1K: 0.0075, Currency: USD, Tier: "premium"},
+	"o1-mini":                {InputCostPer1K: 0.0011, OutputCostPer1K: 0.0044, CachedInputCostPer1K: 0.00055, Currency: USD, Tier: "standard"},
+	"o3":                     {InputCostPer1K: 0.002, OutputCostPer1K: 0.008, CachedInputCostPer1K: 0.0005, Currency: USD, Tier: "premium"},
+	"o3-mini":                {InputCostPer1K: 0.0011, OutputCostPer1K: 0.0044, CachedInputCostPer1K: 0.00055, Currency: USD, Tier: "standard"},
+	"o4-mini":                {InputCostPer1K: 0.0011, OutputCostPer1K: 0.0044, CachedInputCostPer1K: 0.000275, Currency: USD, Tier: "standard"},
+	"text-embedding-3-small": {InputCostPer1K: 0.00002, OutputCostPer1K: 0, Currency: USD, Tier: "embedding"},
+	"text-embedding-3-large": {InputCostPer1K: 0.00013, OutputCostPer1K: 0, Currency: USD, Tier: "embedding"},
+	"text-embedding-ada-002": {InputCostPer1K: 0.0001, OutputCostPer1K: 0, Currency: USD, Tier: "embedding"},
+
+	// Anthropic, cache writes are billed at 1.25 times and cache reads at 0.1 times
+	// the input price.
+	"claude-opus-4-5":   {InputCostPer1K: 0.005, OutputCostPer1K: 0.025, CachedInputCostPer1K: 0.0005, CacheWriteCostPer1K: 0.00625, Currency: USD, Tier: "premium"},
+	"claude-opus-4-1":   {InputCostPer1K: 0.015, OutputCostPer1K: 0.075, CachedInputCostPer1K: 0.0015, CacheWriteCostPer1K: 0.01875, Currency: USD, Tier: "premium"},
+	"claude-opus-4":     {InputCostPer1K: 0.015, OutputCostPer1K: 0.075, CachedInputCostPer1K: 0.0015, CacheWriteCostPer1K: 0.01875, Currency: USD, Tier: "premium"},
+	"claude-sonnet-4-5": {InputCostPer1K: 0.003, OutputCostPer1K: 0.015, CachedInputCostPer1K: 0.0003, CacheWriteCostPer1K: 0.00375, Currency: USD, Tier: "standard"},
+	"claude-sonnet-4":   {InputCostPer1K: 0.003, OutputCostPer1K: 0.015, CachedInputCostPer1K: 0.0003, CacheWriteCostPer1K: 0.00375, Currency: USD, Tier: "standard"},
+	"claude-haiku-4-5":  {InputCostPer1K: 0.001, OutputCostPer1K: 0.005, CachedInputCostPer1K: 0.0001, CacheWriteCostPer1K: 0.00125, Currency: USD, Tier: "economy"},
+	"claude-3-7-sonnet": {InputCostPer1K: 0.003, OutputCostPer1K: 0.015, CachedInputCostPer1K: 0.0003, CacheWriteCostPer1K: 0.00375, Currency: USD, Tier: "standard"},
+	"claude-3-5-sonnet": {InputCostPer1K: 0.003, OutputCostPer1K: 0.015, CachedInputCostPer1K: 0.0003, CacheWriteCostPer1K: 0.00375, Currency: USD, Tier: "standard"},
+	"claude-3-5-haiku":  {InputCostPer1K: 0.0008, OutputCostPer1K: 0.004, CachedInputCostPer1K: 0.00008, CacheWriteCostPer1K: 0.001, Currency: USD, Tier: "economy"},
+	"claude-3-opus":     {InputCostPer1K: 0.015, OutputCostPer1K: 0.075, CachedInputCostPer1K: 0.0015, CacheWriteCostPer1K: 0.01875, Currency: USD, Tier: "premium"},
+	"claude-3-haiku":    {InputCostPer1K: 0.00025, OutputCostPer1K: 0.00125, CachedInputCostPer1K: 0.00003, CacheWriteCostPer1K: 0.0003, Currency: USD, Tier: "economy"},
+
+	// Gemini, prices of prompts up to 200k tokens.
+	"gemini-2.5-pro":        {InputCostPer1K: 0.00125, OutputCostPer1K: 0.01, CachedInputCostPer1K: 0.00031, Currency: USD, Tier: "premium"},
+	"gemini-2.5-flash":      {InputCostPer1K: 0.0003, OutputCostPer1K: 0.0025, CachedInputCostPer1K: 0.000075, Currency: USD, Tier: "standard"},
+	"gemini-2.5-flash-lite": {InputCostPer1K: 0.0001, OutputCostPer1K: 0.0004, CachedInputCostPer1K: 0.000025, Currency: USD, Tier: "economy"},
+	"gemini-2.0-flash":      {InputCostPer1K: 0.0001, OutputCostPer1K: 0.0004, CachedInputCostPer1K: 0.000025, Currency: USD, Tier: "economy"},
+	"gemini-2.0-flash-lite": {InputCostPer1K: 0.000075, OutputCostPer1K: 0.0003, Currency: USD, Tier: "economy"},
+	"gemini-1.5-pro":        {InputCostPer1K: 0.00125, OutputCostPer1K: 0.005, CachedInputCostPer1K: 0.0003125, Currency: USD, Tier: "premium"},
+	"gemini-1.5-flash":      {InputCostPer1K: 0.000075, OutputCostPer1K: 0.0003, CachedInputCostPer1K: 0.00001875, Currency: USD, Tier: "economy"},
+	"gemini-embedding-001":  {InputCostPer1K: 0.00015, OutputCostPer1K: 0, Currency: USD, Tier: "embedding"},
+
+	// Local models served by Ollama, priced after their compute cost.
+	"tinyllama":     {InputCostPer1K: 0.00001, OutputCostPer1K: 0.00002, Currency: USD, Tier: "economy"},
+	"llama3":        {InputCostPer1K: 0.00005, OutputCostPer1K: 0.0001, Currency: USD, Tier: "standard"},
+	"llama3:8b":     {InputCostPer1K: 0.00005, OutputCostPer1K: 0.0001, Currency: USD, Tier: "standard"},
+	"llama3:70b":    {InputCostPer1K: 0.0002, OutputCostPer1K: 0.0004, Currency: USD, Tier: "premium"},
+	"mistral:7b":    {InputCostPer1K: 0.00004, OutputCostPer1K: 0.00008, Currency: USD, Tier: "standard"},
+	"codellama:13b": {InputCostPer1K: 0.00008, OutputCostPer1K: 0.00016, Currency: USD, Tier: "standard"},
+	"gemma:2b":      {InputCostPer1K: 0.00002, OutputCostPer1K: 0.00004, Currency: USD, Tier: "economy"},
+	"qwen:7b":       {InputCostPer1K: 0.00004, OutputCostPer1K: 0.00008, Currency: USD, Tier: "standard"},
+}
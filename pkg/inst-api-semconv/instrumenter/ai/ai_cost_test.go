@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func withCostTracking(t *testing.T) {
+	oldDB, oldCalculator := pricingDB, costCalculator
+	pricingDB = newPricingDatabase()
+	costCalculator = &CostCalculator{pricingDB: pricingDB, enabled: true}
+	budgetsMu.Lock()
+	oldBudgets, oldHandler := budgets, alertHandler
+	budgets, alertHandler = nil, nil
+	budgetsMu.Unlock()
+	t.Cleanup(func() {
+		pricingDB, costCalculator = oldDB, oldCalculator
+		budgetsMu.Lock()
+		budgets, alertHandler = oldBudgets, oldHandler
+		budgetsMu.Unlock()
+	})
+}
+
+func TestGetModelPricing(t *testing.T) {
+	withCostTracking(t)
+	for model, expected := range map[string]string{
+		"gpt-4o":                     "gpt-4o",
+		"GPT-4o":                     "gpt-4o",
+		"gpt-4o-2024-08-06":          "gpt-4o",
+		"gpt-4o-mini-2024-07-18":     "gpt-4o-mini",
+		"gpt-4-0613":                 "gpt-4",
+		"claude-3-5-sonnet-20241022": "claude-3-5-sonnet",
+		"claude-sonnet-4-5":          "claude-sonnet-4-5",
+		"models/gemini-2.5-flash":    "gemini-2.5-flash",
+		"gemini-2.5-flash-lite":      "gemini-2.5-flash-lite",
+		"llama3:8b":                  "llama3:8b",
+		"llama3:latest":              "llama3",
+		"tinyllama:latest":           "tinyllama",
+	} {
+		pricing, ok := pricingDB.GetModelPricing(model)
+		if assert.True(t, ok, model) {
+			assert.Equal(t, expected, pricing.ModelID, model)
+		}
+	}
+	_, ok := pricingDB.GetModelPricing("gpt-4oo")
+	assert.False(t, ok)
+	_, ok = pricingDB.GetModelPricing("unknown-model")
+	assert.False(t, ok)
+	_, ok = pricingDB.GetModelPricing("")
+	assert.False(t, ok)
+}
+
+func TestCalculateCost(t *testing.T) {
+	withCostTracking(t)
+	SetModelPricing("test-model", ModelPricing{InputCostPer1K: 1, OutputCostPer1K: 2, CachedInputCostPer1K: 0.1, CacheWriteCostPer1K: 1.25})
+	cost, ok := CalculateCost("test-model", TokenUsage{InputTokens: 3000, OutputTokens: 500, CacheReadInputTokens: 1000, CacheCreationInputTokens: 1000})
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0, cost.InputCost, 1e-9)
+	assert.InDelta(t, 1.0, cost.OutputCost, 1e-9)
+	assert.InDelta(t, 0.1, cost.CacheReadCost, 1e-9)
+	assert.InDelta(t, 1.25, cost.CacheCreationCost, 1e-9)
+	assert.InDelta(t, 3.35, cost.TotalCost, 1e-9)
+	assert.Equal(t, USD, cost.Currency)
+
+	// cached tokens are billed as input tokens without a cached price
+	SetModelPricing("plain-model", ModelPricing{InputCostPer1K: 1, OutputCostPer1K: 2})
+	cost, ok = CalculateCost("plain-model", TokenUsage{InputTokens: 2000, CacheReadInputTokens: 1000})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0, cost.TotalCost, 1e-9)
+
+	_, ok = CalculateCost("unknown-model", TokenUsage{InputTokens: 1000})
+	assert.False(t, ok)
+
+	costCalculator.enabled = false
+	_, ok = CalculateCost("test-model", TokenUsage{InputTokens: 1000})
+	assert.False(t, ok)
+}
+
+func TestCalculateCostCurrency(t *testing.T) {
+	withCostTracking(t)
+	SetModelPricing("test-model", ModelPricing{InputCostPer1K: 1, OutputCostPer1K: 1})
+	pricingDB.SetCurrency(CNY)
+	cost, ok := CalculateCost("test-model", TokenUsage{InputTokens: 1000, OutputTokens: 1000})
+	assert.True(t, ok)
+	assert.Equal(t, CNY, cost.Currency)
+	assert.InDelta(t, 14.5, cost.TotalCost, 1e-9)
+	assert.InDelta(t, 1.0, pricingDB.ConvertCurrency(7.25, CNY, USD), 1e-9)
+	assert.InDelta(t, 3.0, pricingDB.ConvertCurrency(3.0, "XYZ", USD), 1e-9)
+}
+
+func TestLoadCostConfig(t *testing.T) {
+	withCostTracking(t)
+	path := filepath.Join(t.TempDir(), "cost.json")
+	config := `{
+  "default_currency": "eur",
+  "exchange_rates": {"EUR": 0.5},
+  "model_pricing": {
+    "My-Model": {"input_cost_per_1k": 1, "output_cost_per_1k": 2, "cached_input_cost_per_1k": 0.5, "tier": "custom"}
+  },
+  "budgets": [{"name": "team-a", "amount": 10, "team": "a"}]
+}`
+	assert.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	assert.NoError(t, LoadCostConfig(path))
+
+	cost, ok := CalculateCost("my-model", TokenUsage{InputTokens: 1000, OutputTokens: 1000})
+	assert.True(t, ok)
+	assert.Equal(t, EUR, cost.Currency)
+	assert.Equal(t, "custom", cost.PricingTier)
+	assert.InDelta(t, 1.5, cost.TotalCost, 1e-9)
+	if assert.Len(t, budgets, 1) {
+		assert.Equal(t, "team-a", budgets[0].config.Name)
+		assert.Equal(t, BudgetPeriodDaily, budgets[0].config.Period)
+	}
+
+	assert.Error(t, LoadCostConfig(filepath.Join(t.TempDir(), "missing.json")))
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"encoding/json"
+)
+
+// Message part types of the GenAI semantic conventions.
+// Spec: https://opentelemetry.io/docs/specs/semconv/gen-ai/gen-ai-spans/
+const (
+	MessagePartTypeText             = "text"
+	MessagePartTypeToolCall         = "tool_call"
+	MessagePartTypeToolCallResponse = "tool_call_response"
+)
+
+// ChatMessage is a message of gen_ai.input.messages or gen_ai.output.messages.
+type ChatMessage struct {
+	Role         string        `json:"role"`
+	Parts        []MessagePart `json:"parts"`
+	Name         string        `json:"name,omitempty"`
+	FinishReason string        `json:"finish_reason,omitempty"`
+}
+
+// MessagePart is a part of a ChatMessage or of gen_ai.system_instructions.
+// Content is set for text parts, ID, Name and Arguments for tool calls, ID
+// and Response for tool call responses.
+type MessagePart struct {
+	Type      string `json:"type"`
+	Content   string `json:"content,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Arguments any    `json:"arguments,omitempty"`
+	Response  any    `json:"response,omitempty"`
+}
+
+// MessagesAttrsGetter is optionally implemented by the LLMGetter of an
+// AILLMAttrsExtractor to report structured messages. They are only recorded
+// when OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT is true, the
+// flattened GetAIInput and GetAIOutput being recorded otherwise.
+type MessagesAttrsGetter[REQUEST any, RESPONSE any] interface {
+	GetAISystemInstructions(request REQUEST) []MessagePart
+	GetAIInputMessages(request REQUEST) []ChatMessage
+	GetAIOutputMessages(request REQUEST, response RESPONSE) []ChatMessage
+}
+
+func TextPart(content string) MessagePart {
+	return MessagePart{Type: MessagePartTypeText, Content: content}
+}
+
+// ToolCallPart returns the part of a tool call, arguments given as a JSON
+// string are kept as JSON.
+func ToolCallPart(id string, name string, arguments any) MessagePart {
+	return MessagePart{Type: MessagePartTypeToolCall, ID: id, Name: name, Arguments: jsonOrValue(arguments)}
+}
+
+// ToolCallResponsePart returns the part of a tool call result, a response
+// given as a JSON string is kept as JSON.
+func ToolCallResponsePart(id string, response any) MessagePart {
+	return MessagePart{Type: MessagePartTypeToolCallResponse, ID: id, Response: jsonOrValue(response)}
+}
+
+func jsonOrValue(value any) any {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if s == "" {
+		return nil
+	}
+	if json.Valid([]byte(s)) && (s[0] == '{' || s[0] == '[') {
+		return json.RawMessage(s)
+	}
+	return s
+}
+
+type openAIMessage struct {
+	Role       string          `json:"role"`
+	Name       string          `json:"name"`
+	Content    json.RawMessage `json:"content"`
+	Refusal    string          `json:"refusal"`
+	ToolCallID string          `json:"tool_call_id"`
+	ToolCalls  []struct {
+		ID       string `json:"id"`
+		Function struct {
+			Name      string `json:"name"`
+			Arguments string `json:"arguments"`
+		} `json:"function"`
+	} `json:"tool_calls"`
+}
+
+type openAIContentPart struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// ChatMessagesFromOpenAI converts messages serialized in the format of the
+// OpenAI chat completions API, which most SDKs share, to ChatMessages.
+func ChatMessagesFromOpenAI(data []byte) []ChatMessage {
+	var messages []openAIMessage
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil
+	}
+	chatMessages := make([]ChatMessage, 0, len(messages))
+	for _, message := range messages {
+		chatMessage := ChatMessage{Role: message.Role, Name: message.Name, Parts: []MessagePart{}}
+		content := openAIContentParts(message.Content)
+		if message.Role == "tool" {
+			var response string
+			for _, part := range content {
+				response += part.Content
+			}
+			chatMessage.Parts = append(chatMessage.Parts, ToolCallResponsePart(message.ToolCallID, response))
+		} else {
+			chatMessage.Parts = append(chatMessage.Parts, content...)
+		}
+		if message.Refusal != "" {
+			chatMessage.Parts = append(chatMessage.Parts, TextPart(message.Refusal))
+		}
+		for _, toolCall := range message.ToolCalls {
+			chatMessage.Parts = append(chatMessage.Parts, ToolCallPart(toolCall.ID, toolCall.Function.Name, toolCall.Function.Arguments))
+		}
+		chatMessages = append(chatMessages, chatMessage)
+	}
+	return chatMessages
+}
+
+// openAIContentParts returns the parts of a content given either as a string
+// or as an array of content parts.
+func openAIContentParts(content json.RawMessage) []MessagePart {
+	if len(content) == 0 || string(content) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(content, &text); err == nil {
+		if text == "" {
+			return nil
+		}
+		return []MessagePart{TextPart(text)}
+	}
+	var contentParts []openAIContentPart
+	if err := json.Unmarshal(content, &contentParts); err != nil {
+		return nil
+	}
+	parts := make([]MessagePart, 0, len(contentParts))
+	for _, contentPart := range contentParts {
+		if contentPart.Type == MessagePartTypeText {
+			parts = append(parts, TextPart(contentPart.Text))
+		} else {
+			parts = append(parts, MessagePart{Type: contentPart.Type})
+		}
+	}
+	return parts
+}
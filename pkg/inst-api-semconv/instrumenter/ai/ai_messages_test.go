@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatMessagesFromOpenAI(t *testing.T) {
+	data := `[
+		{"role":"system","content":"You are helpful."},
+		{"role":"user","content":[{"type":"text","text":"What is the weather?"},{"type":"image_url","image_url":{"url":"https://example.com/a.png"}}]},
+		{"role":"assistant","content":null,"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":\"Paris\"}"}}]},
+		{"role":"tool","tool_call_id":"call_1","content":"sunny"}
+	]`
+	messages := ChatMessagesFromOpenAI([]byte(data))
+	assert.Len(t, messages, 4)
+	assert.Equal(t, ChatMessage{Role: "system", Parts: []MessagePart{TextPart("You are helpful.")}}, messages[0])
+	assert.Equal(t, []MessagePart{TextPart("What is the weather?"), {Type: "image_url"}}, messages[1].Parts)
+	assert.Len(t, messages[2].Parts, 1)
+	assert.Equal(t, MessagePartTypeToolCall, messages[2].Parts[0].Type)
+	assert.Equal(t, "call_1", messages[2].Parts[0].ID)
+	assert.Equal(t, "get_weather", messages[2].Parts[0].Name)
+	assert.Equal(t, json.RawMessage(`{"city":"Paris"}`), messages[2].Parts[0].Arguments)
+	assert.Equal(t, []MessagePart{{Type: MessagePartTypeToolCallResponse, ID: "call_1", Response: "sunny"}}, messages[3].Parts)
+}
+
+func TestChatMessagesFromOpenAIInvalid(t *testing.T) {
+	assert.Nil(t, ChatMessagesFromOpenAI([]byte(`{"role":"user"}`)))
+}
+
+func TestToolCallPartArguments(t *testing.T) {
+	assert.Equal(t, json.RawMessage(`["a"]`), ToolCallPart("id", "name", `["a"]`).Arguments)
+	assert.Equal(t, "not json", ToolCallPart("id", "name", "not json").Arguments)
+	assert.Nil(t, ToolCallPart("id", "name", "").Arguments)
+	arguments := map[string]any{"a": 1}
+	assert.Equal(t, arguments, ToolCallPart("id", "name", arguments).Arguments)
+}
@@ -35,6 +35,10 @@ const (
 	gen_ai_client_token_usage         = "gen_ai.client.token.usage"
 	gen_ai_client_operation_duration  = "gen_ai.client.operation.duration"
 	gen_ai_server_time_to_first_token = "gen_ai.server.time_to_first_token"
+	gen_ai_client_cost                = "gen_ai.client.cost"
+	gen_ai_client_budget_spend        = "gen_ai.client.budget.spend"
+	gen_ai_client_budget_utilization  = "gen_ai.client.budget.utilization"
+	gen_ai_client_budget_status       = "gen_ai.client.budget.status"
 )
 
 type AIClientMetric struct {
@@ -42,6 +46,10 @@ type AIClientMetric struct {
 	clientOperationDuration metric.Float64Histogram
 	clientTokenUsage        metric.Int64Histogram
 	serverTimeToFirstToken  metric.Float64Histogram
+	clientCost              metric.Float64Counter
+	budgetSpend             metric.Float64Gauge
+	budgetUtilization       metric.Float64Gauge
+	budgetStatus            metric.Int64Gauge
 }
 
 var _ instrumenter.OperationListener = (*AIClientMetric)(nil)
@@ -91,9 +99,21 @@ func newAIClientMetric(key string, meter metric.Meter) (*AIClientMetric, error)
 	if err != nil {
 		return nil, err
 	}
+	clientCost, err := newAIClientCostMeasures(meter)
+	if err != nil {
+		return nil, err
+	}
+	budgetSpend, budgetUtilization, budgetStatus, err := newAIClientBudgetMeasures(meter)
+	if err != nil {
+		return nil, err
+	}
 	m.clientOperationDuration = clientOperationDuration
 	m.clientTokenUsage = clientTokenUsage
 	m.serverTimeToFirstToken = serverTimeToFirstToken
+	m.clientCost = clientCost
+	m.budgetSpend = budgetSpend
+	m.budgetUtilization = budgetUtilization
+	m.budgetStatus = budgetStatus
 	return m, nil
 }
 
@@ -151,6 +171,52 @@ func newAIClientServerTimeToFirstTokenMeasures(meter metric.Meter) (metric.Float
 	}
 }
 
+func newAIClientCostMeasures(meter metric.Meter) (metric.Float64Counter, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if meter == nil {
+		return nil, errors.New("nil meter")
+	}
+	d, err := meter.Float64Counter(gen_ai_client_cost,
+		metric.WithUnit("{currency}"),
+		metric.WithDescription("Cost of the tokens used in prompt and completions."),
+	)
+	if err == nil {
+		return d, nil
+	} else {
+		return d, errors.New(fmt.Sprintf("failed to create gen_ai.client.cost counter, %v", err))
+	}
+}
+
+func newAIClientBudgetMeasures(meter metric.Meter) (metric.Float64Gauge, metric.Float64Gauge, metric.Int64Gauge, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if meter == nil {
+		return nil, nil, nil, errors.New("nil meter")
+	}
+	spend, err := meter.Float64Gauge(gen_ai_client_budget_spend,
+		metric.WithUnit("{currency}"),
+		metric.WithDescription("Spending against the budget in the current period."),
+	)
+	if err != nil {
+		return nil, nil, nil, errors.New(fmt.Sprintf("failed to create gen_ai.client.budget.spend gauge, %v", err))
+	}
+	utilization, err := meter.Float64Gauge(gen_ai_client_budget_utilization,
+		metric.WithUnit("1"),
+		metric.WithDescription("Fraction of the budget spent in the current period."),
+	)
+	if err != nil {
+		return nil, nil, nil, errors.New(fmt.Sprintf("failed to create gen_ai.client.budget.utilization gauge, %v", err))
+	}
+	status, err := meter.Int64Gauge(gen_ai_client_budget_status,
+		metric.WithDescription("Status of the budget: 0 ok, 1 warning, 2 critical, 3 exceeded."),
+	)
+	if err != nil {
+		return nil, nil, nil, errors.New(fmt.Sprintf("failed to create gen_ai.client.budget.status gauge, %v", err))
+	}
+	return spend, utilization, status, nil
+}
+
 type aiMetricContext struct {
 	startTime       time.Time
 	startAttributes []attribute.KeyValue
@@ -201,8 +267,18 @@ func (a AIClientMetric) OnAfterEnd(ctx context.Context, endAttributes []attribut
 
 	var inputTokens, outputTokens attribute.Value
 	var hasInputTokens, hasOutputTokens bool
+	var usage TokenUsage
+	var requestModel, responseModel string
 	for _, kv := range endAttributes {
 		switch kv.Key {
+		case GenAIUsageCacheReadInputTokensKey:
+			usage.CacheReadInputTokens = kv.Value.AsInt64()
+		case GenAIUsageCacheCreationInputTokensKey:
+			usage.CacheCreationInputTokens = kv.Value.AsInt64()
+		case semconv.GenAIRequestModelKey:
+			requestModel = kv.Value.AsString()
+		case semconv.GenAIResponseModelKey:
+			responseModel = kv.Value.AsString()
 		case semconv.GenAIUsageInputTokensKey:
 			if !hasInputTokens {
 				inputTokens = kv.Value
@@ -214,9 +290,6 @@ func (a AIClientMetric) OnAfterEnd(ctx context.Context, endAttributes []attribut
 				hasOutputTokens = true
 			}
 		}
-		if hasInputTokens && hasOutputTokens {
-			break
-		}
 	}
 
 	// record the client token usage
@@ -231,6 +304,16 @@ func (a AIClientMetric) OnAfterEnd(ctx context.Context, endAttributes []attribut
 			metric.WithAttributes(semconv.GenAITokenTypeCompletion))
 	}
 
+	// record the cost of the tokens and the budgets it is accounted to
+	if CostTrackingEnabled() && (hasInputTokens || hasOutputTokens) {
+		usage.InputTokens, usage.OutputTokens = inputTokens.AsInt64(), outputTokens.AsInt64()
+		model := responseModel
+		if model == "" {
+			model = requestModel
+		}
+		a.recordCost(ctx, model, usage, metricsAttrs[0:n], endTime)
+	}
+
 	// record the server time to first token
 	if firstTokenTime, ok := ctx.Value(TimeToFirstTokenKey{}).(time.Time); ok {
 		if a.serverTimeToFirstToken == nil {
@@ -244,3 +327,72 @@ func (a AIClientMetric) OnAfterEnd(ctx context.Context, endAttributes []attribut
 		a.serverTimeToFirstToken.Record(ctx, firstTokenTime.Sub(startTime).Seconds(), metric.WithAttributeSet(attribute.NewSet(metricsAttrs[0:n]...)))
 	}
 }
+
+func (a AIClientMetric) recordCost(ctx context.Context, model string, usage TokenUsage, metricsAttrs []attribute.KeyValue, endTime time.Time) {
+	cost, ok := CalculateCost(model, usage)
+	if !ok {
+		return
+	}
+	if a.clientCost == nil {
+		var err error
+		// second change to init the metric
+		a.clientCost, err = newAIClientCostMeasures(globalMeter)
+		if err != nil {
+			log.Printf("failed to create clientCost, err is %v\n", err)
+			return
+		}
+	}
+	team := teamFromContext(ctx)
+	costAttrs := []attribute.KeyValue{GenAICostCurrencyKey.String(string(cost.Currency))}
+	if team != "" {
+		costAttrs = append(costAttrs, GenAICostTeamKey.String(team))
+	}
+	attrSet := metric.WithAttributeSet(attribute.NewSet(metricsAttrs...))
+	for _, c := range []struct {
+		tokenType attribute.KeyValue
+		tokens    int64
+		cost      float64
+	}{
+		{semconv.GenAITokenTypeInput, usage.uncachedInputTokens(), cost.InputCost},
+		{semconv.GenAITokenTypeCompletion, usage.OutputTokens, cost.OutputCost},
+		{semconv.GenAITokenTypeKey.String("cache_read"), usage.CacheReadInputTokens, cost.CacheReadCost},
+		{semconv.GenAITokenTypeKey.String("cache_creation"), usage.CacheCreationInputTokens, cost.CacheCreationCost},
+	} {
+		if c.tokens > 0 {
+			a.clientCost.Add(ctx, c.cost, attrSet, metric.WithAttributes(append(costAttrs, c.tokenType)...))
+		}
+	}
+
+	states := recordBudgets(model, team, cost.TotalCost, endTime)
+	if len(states) == 0 {
+		return
+	}
+	if a.budgetSpend == nil || a.budgetUtilization == nil || a.budgetStatus == nil {
+		var err error
+		// second change to init the metric
+		a.budgetSpend, a.budgetUtilization, a.budgetStatus, err = newAIClientBudgetMeasures(globalMeter)
+		if err != nil {
+			log.Printf("failed to create budget gauges, err is %v\n", err)
+			return
+		}
+	}
+	for _, state := range states {
+		// a budget reports the model and team it is scoped to, its spend
+		// being shared by all the matching operations
+		attrs := []attribute.KeyValue{
+			GenAIBudgetNameKey.String(state.config.Name),
+			GenAIBudgetPeriodKey.String(string(state.config.Period)),
+			GenAICostCurrencyKey.String(string(cost.Currency)),
+		}
+		if state.config.Model != "" {
+			attrs = append(attrs, semconv.GenAIRequestModelKey.String(state.config.Model))
+		}
+		if state.config.Team != "" {
+			attrs = append(attrs, GenAICostTeamKey.String(state.config.Team))
+		}
+		budgetAttrs := metric.WithAttributes(attrs...)
+		a.budgetSpend.Record(ctx, state.spend, budgetAttrs)
+		a.budgetUtilization.Record(ctx, state.utilization, budgetAttrs)
+		a.budgetStatus.Record(ctx, state.status.level(), budgetAttrs)
+	}
+}
@@ -110,6 +110,58 @@ func TestLazyAIClientMetric(t *testing.T) {
 	}
 }
 
+func TestAIClientCostMetric(t *testing.T) {
+	withCostTracking(t)
+	SetModelPricing("test-model", ModelPricing{InputCostPer1K: 1, OutputCostPer1K: 2, CachedInputCostPer1K: 0.5})
+	AddBudget(BudgetConfig{Name: "total", Amount: 1, Period: BudgetPeriodNone})
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	client, err := newAIClientMetric("test", mp.Meter("test-meter"))
+	if err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	start := time.Now()
+	ctx = client.OnBeforeStart(ctx, start)
+	ctx = client.OnBeforeEnd(ctx, []attribute.KeyValue{semconv.GenAIRequestModel("test-model")}, start)
+	client.OnAfterEnd(ctx, []attribute.KeyValue{
+		semconv.GenAISystemKey.String("openai"),
+		semconv.GenAIOperationNameKey.String("chat"),
+		semconv.GenAIResponseModel("test-model-2026"),
+		semconv.GenAIUsageInputTokens(2000),
+		semconv.GenAIUsageOutputTokens(500),
+		GenAIUsageCacheReadInputTokensKey.Int64(1000),
+	}, time.Now())
+
+	rm := &metricdata.ResourceMetrics{}
+	if err := reader.Collect(ctx, rm); err != nil {
+		panic(err)
+	}
+	costs := map[string]float64{}
+	var status int64
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch m.Name {
+		case "gen_ai.client.cost":
+			for _, dp := range m.Data.(metricdata.Sum[float64]).DataPoints {
+				tokenType, _ := dp.Attributes.Value(semconv.GenAITokenTypeKey)
+				currency, _ := dp.Attributes.Value(GenAICostCurrencyKey)
+				if currency.AsString() != "USD" {
+					t.Fatalf("unexpected currency %s", currency.AsString())
+				}
+				costs[tokenType.AsString()] = dp.Value
+			}
+		case "gen_ai.client.budget.status":
+			status = m.Data.(metricdata.Gauge[int64]).DataPoints[0].Value
+		}
+	}
+	if len(costs) != 3 || costs["input"] != 1 || costs["output"] != 1 || costs["cache_read"] != 0.5 {
+		t.Fatalf("unexpected costs %v", costs)
+	}
+	if status != BudgetExceeded.level() {
+		t.Fatalf("unexpected budget status %d", status)
+	}
+}
+
 func TestAINilMeter(t *testing.T) {
 	_, err := newAIClientMetric("test", nil)
 	if err == nil {
@@ -0,0 +1,331 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv7 "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const OperationNameExecuteTool = "execute_tool"
+
+const (
+	GenAIToolCallArgumentsKey = attribute.Key("gen_ai.tool.call.arguments")
+	GenAIToolCallResultKey    = attribute.Key("gen_ai.tool.call.result")
+)
+
+// maxRequestedToolCalls bounds the tool calls remembered for linking, the
+// oldest ones being forgotten first.
+const maxRequestedToolCalls = 1024
+
+type ToolCallAttrsGetter[REQUEST any, RESPONSE any] interface {
+	GetAIToolName(request REQUEST) string
+	GetAIToolCallID(request REQUEST) string
+	GetAIToolType(request REQUEST) string
+	GetAIToolDescription(request REQUEST) string
+	GetAIToolCallArguments(request REQUEST) any
+	GetAIToolCallResult(request REQUEST, response RESPONSE) any
+}
+
+// AIToolCallAttrsExtractor records the attributes of an execute_tool span and
+// links it to the generation span that requested the tool call. Arguments and
+// result are only recorded when the message content is captured.
+type AIToolCallAttrsExtractor[REQUEST any, RESPONSE any, GETTER ToolCallAttrsGetter[REQUEST, RESPONSE]] struct {
+	Getter GETTER
+}
+
+func (h *AIToolCallAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request REQUEST) ([]attribute.KeyValue, context.Context) {
+	name := h.Getter.GetAIToolName(request)
+	callID := h.Getter.GetAIToolCallID(request)
+	if name == "" && callID == "" {
+		return attributes, parentContext
+	}
+	attributes = append(attributes, attribute.KeyValue{
+		Key:   semconv7.GenAIToolNameKey,
+		Value: attribute.StringValue(name),
+	})
+	if callID != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   semconv7.GenAIToolCallIDKey,
+			Value: attribute.StringValue(callID),
+		})
+	}
+	if toolType := h.Getter.GetAIToolType(request); toolType != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   semconv7.GenAIToolTypeKey,
+			Value: attribute.StringValue(toolType),
+		})
+	}
+	if description := h.Getter.GetAIToolDescription(request); description != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   semconv7.GenAIToolDescriptionKey,
+			Value: attribute.StringValue(description),
+		})
+	}
+	if CaptureMessageContent() {
+		if arguments := MarshalContent(jsonOrValue(h.Getter.GetAIToolCallArguments(request))); arguments != "" {
+			attributes = append(attributes, attribute.KeyValue{
+				Key:   GenAIToolCallArgumentsKey,
+				Value: attribute.StringValue(arguments),
+			})
+		}
+	}
+	traceID := trace.SpanContextFromContext(parentContext).TraceID()
+	if requested, ok := requestedToolCalls.take(traceID, callID, name); ok {
+		trace.SpanFromContext(parentContext).AddLink(trace.Link{
+			SpanContext: requested.spanContext,
+			Attributes: []attribute.KeyValue{
+				semconv7.GenAIToolCallIDKey.String(requested.id),
+			},
+		})
+	}
+	return attributes, parentContext
+}
+
+func (h *AIToolCallAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attributes []attribute.KeyValue, context context.Context, request REQUEST, response RESPONSE, err error) ([]attribute.KeyValue, context.Context) {
+	if !CaptureMessageContent() {
+		return attributes, context
+	}
+	if h.Getter.GetAIToolName(request) == "" && h.Getter.GetAIToolCallID(request) == "" {
+		return attributes, context
+	}
+	if result := MarshalContent(jsonOrValue(h.Getter.GetAIToolCallResult(request, response))); result != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   GenAIToolCallResultKey,
+			Value: attribute.StringValue(result),
+		})
+	}
+	return attributes, context
+}
+
+// ToolCallRequest describes the execution of a tool, usually requested by a
+// model through a tool call.
+type ToolCallRequest struct {
+	System      string
+	Name        string
+	CallID      string
+	Type        string
+	Description string
+	Arguments   any
+}
+
+type ToolCallResponse struct {
+	Result any
+}
+
+type toolCallGetter struct {
+}
+
+var _ CommonAttrsGetter[ToolCallRequest, ToolCallResponse] = toolCallGetter{}
+var _ ToolCallAttrsGetter[ToolCallRequest, ToolCallResponse] = toolCallGetter{}
+
+func (toolCallGetter) GetAIOperationName(request ToolCallRequest) string {
+	return OperationNameExecuteTool
+}
+func (toolCallGetter) GetAISystem(request ToolCallRequest) string {
+	return request.System
+}
+func (toolCallGetter) GetGenAISpanKind(request ToolCallRequest) GenAISpanKind {
+	return GenAISpanKindTool
+}
+func (toolCallGetter) GetAIToolName(request ToolCallRequest) string {
+	return request.Name
+}
+func (toolCallGetter) GetAIToolCallID(request ToolCallRequest) string {
+	return request.CallID
+}
+func (toolCallGetter) GetAIToolType(request ToolCallRequest) string {
+	return request.Type
+}
+func (toolCallGetter) GetAIToolDescription(request ToolCallRequest) string {
+	return request.Description
+}
+func (toolCallGetter) GetAIToolCallArguments(request ToolCallRequest) any {
+	return request.Arguments
+}
+func (toolCallGetter) GetAIToolCallResult(request ToolCallRequest, response ToolCallResponse) any {
+	return response.Result
+}
+
+// BuildToolCallInstrumenter builds the instrumenter of the execute_tool spans
+// shared by the GenAI instrumentations.
+func BuildToolCallInstrumenter(scopeName string) instrumenter.Instrumenter[ToolCallRequest, ToolCallResponse] {
+	builder := instrumenter.Builder[ToolCallRequest, ToolCallResponse]{}
+	return builder.Init().SetSpanNameExtractor(&AISpanNameExtractor[ToolCallRequest, ToolCallResponse]{Getter: toolCallGetter{}}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[ToolCallRequest]{}).
+		AddAttributesExtractor(&AICommonAttrsExtractor[ToolCallRequest, ToolCallResponse, toolCallGetter]{CommonGetter: toolCallGetter{}}).
+		AddAttributesExtractor(&GenAISpanKindAttrsExtractor[ToolCallRequest, ToolCallResponse, toolCallGetter]{Getter: toolCallGetter{}}).
+		AddAttributesExtractor(&AIToolCallAttrsExtractor[ToolCallRequest, ToolCallResponse, toolCallGetter]{Getter: toolCallGetter{}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    scopeName,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+// ReportToolCallResults records an execute_tool span for every tool call
+// response of messages whose tool call was requested by an earlier generation
+// of the same trace and has not been reported yet. It serves the SDKs that
+// leave the execution of the tools to the application, the span covering the
+// time between the generation and the request carrying the result.
+func ReportToolCallResults(ctx context.Context, toolInstrumenter instrumenter.Instrumenter[ToolCallRequest, ToolCallResponse], system string, messages []ChatMessage) {
+	now := time.Now()
+	traceID := trace.SpanContextFromContext(ctx).TraceID()
+	for _, message := range messages {
+		for _, part := range message.Parts {
+			if part.Type != MessagePartTypeToolCallResponse || part.ID == "" {
+				continue
+			}
+			requested, ok := requestedToolCalls.get(traceID, part.ID)
+			if !ok {
+				continue
+			}
+			request := ToolCallRequest{
+				System:    system,
+				Name:      requested.name,
+				CallID:    part.ID,
+				Type:      "function",
+				Arguments: requested.arguments,
+			}
+			toolInstrumenter.StartAndEnd(ctx, request, ToolCallResponse{Result: part.Response}, nil, requested.requestedAt, now)
+		}
+	}
+}
+
+// RecordToolCalls remembers the tool calls of the output messages of the
+// generation span in ctx, so that the spans executing them can link to it.
+func RecordToolCalls(ctx context.Context, messages []ChatMessage) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return
+	}
+	now := time.Now()
+	for _, message := range messages {
+		for _, part := range message.Parts {
+			if part.Type != MessagePartTypeToolCall || (part.ID == "" && part.Name == "") {
+				continue
+			}
+			requestedToolCalls.add(&requestedToolCall{
+				id:          part.ID,
+				name:        part.Name,
+				arguments:   part.Arguments,
+				requestedAt: now,
+				spanContext: spanContext,
+			})
+		}
+	}
+}
+
+type requestedToolCall struct {
+	id          string
+	name        string
+	arguments   any
+	requestedAt time.Time
+	spanContext trace.SpanContext
+}
+
+// toolCallKey scopes the tool calls to the trace of the generation requesting
+// them, the ids and the names of the tools are only unique within a
+// conversation.
+type toolCallKey struct {
+	traceID trace.TraceID
+	id      string
+}
+
+// key identifies the tool call by its id, by its tool name when the model did
+// not assign one.
+func (c *requestedToolCall) key() toolCallKey {
+	if c.id != "" {
+		return toolCallKey{traceID: c.spanContext.TraceID(), id: c.id}
+	}
+	return toolCallKey{traceID: c.spanContext.TraceID(), id: "\x00" + c.name}
+}
+
+func (c *requestedToolCall) nameKey() toolCallKey {
+	return toolCallKey{traceID: c.spanContext.TraceID(), id: c.name}
+}
+
+type toolCallRegistry struct {
+	mu     sync.Mutex
+	calls  map[toolCallKey]*requestedToolCall
+	byName map[toolCallKey]*requestedToolCall
+	order  []*requestedToolCall
+}
+
+var requestedToolCalls = newToolCallRegistry()
+
+func newToolCallRegistry() *toolCallRegistry {
+	return &toolCallRegistry{
+		calls:  make(map[toolCallKey]*requestedToolCall),
+		byName: make(map[toolCallKey]*requestedToolCall),
+	}
+}
+
+func (r *toolCallRegistry) add(call *requestedToolCall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls[call.key()] = call
+	if call.name != "" {
+		r.byName[call.nameKey()] = call
+	}
+	r.order = append(r.order, call)
+	for len(r.order) > maxRequestedToolCalls {
+		r.remove(r.order[0])
+		r.order[0] = nil
+		r.order = r.order[1:]
+	}
+}
+
+func (r *toolCallRegistry) get(traceID trace.TraceID, id string) (*requestedToolCall, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, ok := r.calls[toolCallKey{traceID: traceID, id: id}]
+	return call, ok
+}
+
+// take forgets and returns the tool call of the trace with the given id, the
+// latest one of the tool in the trace otherwise.
+func (r *toolCallRegistry) take(traceID trace.TraceID, id string, name string) (*requestedToolCall, bool) {
+	if !traceID.IsValid() {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, ok := r.calls[toolCallKey{traceID: traceID, id: id}]
+	if !ok {
+		if call, ok = r.byName[toolCallKey{traceID: traceID, id: name}]; !ok {
+			return nil, false
+		}
+	}
+	r.remove(call)
+	return call, true
+}
+
+func (r *toolCallRegistry) remove(call *requestedToolCall) {
+	if r.calls[call.key()] == call {
+		delete(r.calls, call.key())
+	}
+	if r.byName[call.nameKey()] == call {
+		delete(r.byName, call.nameKey())
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ai
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv7 "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func withToolCallTracing(t *testing.T) (*tracetest.SpanRecorder, trace.Tracer) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	originalTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	originalCalls := requestedToolCalls
+	requestedToolCalls = newToolCallRegistry()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(originalTP)
+		requestedToolCalls = originalCalls
+	})
+	return sr, tp.Tracer("test-tracer")
+}
+
+func requestToolCall(tracer trace.Tracer, id string, name string, arguments string) trace.SpanContext {
+	ctx, span := tracer.Start(context.Background(), "chat")
+	defer span.End()
+	RecordToolCalls(ctx, []ChatMessage{{
+		Role:  "assistant",
+		Parts: []MessagePart{ToolCallPart(id, name, arguments)},
+	}})
+	return span.SpanContext()
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]string {
+	attrs := make(map[attribute.Key]string)
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value.Emit()
+	}
+	return attrs
+}
+
+func TestToolCallSpanLinksGeneration(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 0, 0)
+	SetContentRedactor(nil)
+	sr, tracer := withToolCallTracing(t)
+	generation := requestToolCall(tracer, "call_1", "get_weather", `{"city":"Paris"}`)
+
+	toolInstrumenter := BuildToolCallInstrumenter("test")
+	request := ToolCallRequest{System: "test", Name: "get_weather", CallID: "call_1", Type: "function", Arguments: `{"city":"Paris"}`}
+	ctx := toolInstrumenter.Start(trace.ContextWithSpanContext(context.Background(), generation), request)
+	toolInstrumenter.End(ctx, request, ToolCallResponse{Result: "sunny"}, nil)
+
+	spans := sr.Ended()
+	assert.Len(t, spans, 2)
+	span := spans[1]
+	assert.Equal(t, OperationNameExecuteTool, span.Name())
+	assert.Equal(t, trace.SpanKindInternal, span.SpanKind())
+	attrs := spanAttributes(span)
+	assert.Equal(t, "tool", attrs[GenAISpanKindKey])
+	assert.Equal(t, "get_weather", attrs[semconv7.GenAIToolNameKey])
+	assert.Equal(t, "call_1", attrs[semconv7.GenAIToolCallIDKey])
+	assert.Equal(t, "function", attrs[semconv7.GenAIToolTypeKey])
+	assert.Equal(t, `{"city":"Paris"}`, attrs[GenAIToolCallArgumentsKey])
+	assert.Equal(t, `"sunny"`, attrs[GenAIToolCallResultKey])
+	assert.Len(t, span.Links(), 1)
+	assert.Equal(t, generation, span.Links()[0].SpanContext)
+
+	_, ok := requestedToolCalls.get(generation.TraceID(), "call_1")
+	assert.False(t, ok)
+}
+
+func TestToolCallSpanOfAnotherTrace(t *testing.T) {
+	sr, tracer := withToolCallTracing(t)
+	generation := requestToolCall(tracer, "call_1", "get_weather", `{"city":"Paris"}`)
+
+	toolInstrumenter := BuildToolCallInstrumenter("test")
+	request := ToolCallRequest{System: "test", Name: "get_weather", CallID: "call_1"}
+	ctx := toolInstrumenter.Start(context.Background(), request)
+	toolInstrumenter.End(ctx, request, ToolCallResponse{Result: "sunny"}, nil)
+
+	spans := sr.Ended()
+	assert.Len(t, spans, 2)
+	assert.NotEqual(t, generation.TraceID(), spans[1].SpanContext().TraceID())
+	assert.Empty(t, spans[1].Links())
+	_, ok := requestedToolCalls.get(generation.TraceID(), "call_1")
+	assert.True(t, ok)
+}
+
+func TestConcurrentToolCallsOfTheSameTool(t *testing.T) {
+	sr, tracer := withToolCallTracing(t)
+	toolInstrumenter := BuildToolCallInstrumenter("test")
+	const generations = 2
+	requested := make([]trace.SpanContext, generations)
+	for i := range requested {
+		// the models do not always assign an id, the tool is then matched by name
+		requested[i] = requestToolCall(tracer, "", "get_weather", `{"city":"Paris"}`)
+	}
+	var wg sync.WaitGroup
+	for i := range requested {
+		wg.Add(1)
+		go func(generation trace.SpanContext) {
+			defer wg.Done()
+			request := ToolCallRequest{System: "test", Name: "get_weather"}
+			ctx := toolInstrumenter.Start(trace.ContextWithSpanContext(context.Background(), generation), request)
+			toolInstrumenter.End(ctx, request, ToolCallResponse{Result: "sunny"}, nil)
+		}(requested[i])
+	}
+	wg.Wait()
+
+	linked := 0
+	for _, span := range sr.Ended() {
+		if span.Name() != OperationNameExecuteTool {
+			continue
+		}
+		assert.Len(t, span.Links(), 1)
+		assert.Equal(t, span.SpanContext().TraceID(), span.Links()[0].SpanContext.TraceID())
+		assert.Equal(t, span.Parent(), span.Links()[0].SpanContext)
+		linked++
+	}
+	assert.Equal(t, generations, linked)
+	assert.Empty(t, requestedToolCalls.calls)
+}
+
+func TestToolCallSpanWithoutContent(t *testing.T) {
+	withContentCapture(t, contentCaptureDefault, 0, 0)
+	sr, _ := withToolCallTracing(t)
+
+	toolInstrumenter := BuildToolCallInstrumenter("test")
+	request := ToolCallRequest{System: "test", Name: "get_weather", Arguments: `{"city":"Paris"}`}
+	ctx := toolInstrumenter.Start(context.Background(), request)
+	toolInstrumenter.End(ctx, request, ToolCallResponse{Result: "sunny"}, nil)
+
+	spans := sr.Ended()
+	assert.Len(t, spans, 1)
+	attrs := spanAttributes(spans[0])
+	assert.Equal(t, "get_weather", attrs[semconv7.GenAIToolNameKey])
+	assert.NotContains(t, attrs, GenAIToolCallArgumentsKey)
+	assert.NotContains(t, attrs, GenAIToolCallResultKey)
+	assert.Empty(t, spans[0].Links())
+}
+
+func TestReportToolCallResults(t *testing.T) {
+	withContentCapture(t, contentCaptureEnabled, 0, 0)
+	SetContentRedactor(nil)
+	sr, tracer := withToolCallTracing(t)
+	generation := requestToolCall(tracer, "call_1", "get_weather", `{"city":"Paris"}`)
+
+	messages := []ChatMessage{
+		{Role: "tool", Parts: []MessagePart{ToolCallResponsePart("call_1", "sunny")}},
+		{Role: "tool", Parts: []MessagePart{ToolCallResponsePart("call_2", "unknown")}},
+	}
+	toolInstrumenter := BuildToolCallInstrumenter("test")
+	// the results of another trace are not reported
+	ReportToolCallResults(context.Background(), toolInstrumenter, "test", messages)
+	ctx := trace.ContextWithSpanContext(context.Background(), generation)
+	ReportToolCallResults(ctx, toolInstrumenter, "test", messages)
+	ReportToolCallResults(ctx, toolInstrumenter, "test", messages)
+
+	spans := sr.Ended()
+	assert.Len(t, spans, 2)
+	span := spans[1]
+	attrs := spanAttributes(span)
+	assert.Equal(t, "get_weather", attrs[semconv7.GenAIToolNameKey])
+	assert.Equal(t, "call_1", attrs[semconv7.GenAIToolCallIDKey])
+	assert.Equal(t, `{"city":"Paris"}`, attrs[GenAIToolCallArgumentsKey])
+	assert.Equal(t, `"sunny"`, attrs[GenAIToolCallResultKey])
+	assert.Equal(t, generation, span.Links()[0].SpanContext)
+	assert.False(t, span.StartTime().Before(spans[0].StartTime()))
+}
+
+func TestToolCallRegistry(t *testing.T) {
+	registry := newToolCallRegistry()
+	traceID := trace.TraceID{1}
+	otherTraceID := trace.TraceID{2}
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}})
+	first := &requestedToolCall{name: "search", spanContext: spanContext}
+	second := &requestedToolCall{id: "call_2", name: "search", spanContext: spanContext}
+	registry.add(first)
+	registry.add(second)
+
+	_, ok := registry.take(otherTraceID, "call_2", "search")
+	assert.False(t, ok)
+	_, ok = registry.take(trace.TraceID{}, "call_2", "search")
+	assert.False(t, ok)
+	call, ok := registry.take(traceID, "1", "search")
+	assert.True(t, ok)
+	assert.Same(t, second, call)
+	_, ok = registry.get(traceID, "call_2")
+	assert.False(t, ok)
+	_, ok = registry.take(traceID, "", "search")
+	assert.False(t, ok)
+	assert.Len(t, registry.calls, 1)
+
+	for i := 0; i <= maxRequestedToolCalls; i++ {
+		registry.add(&requestedToolCall{id: fmt.Sprintf("call_%d", i), name: "search", spanContext: spanContext})
+	}
+	_, ok = registry.get(traceID, "call_0")
+	assert.False(t, ok)
+	_, ok = registry.get(traceID, fmt.Sprintf("call_%d", maxRequestedToolCalls))
+	assert.True(t, ok)
+	_, ok = registry.get(otherTraceID, fmt.Sprintf("call_%d", maxRequestedToolCalls))
+	assert.False(t, ok)
+}
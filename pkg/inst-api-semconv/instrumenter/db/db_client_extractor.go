@@ -20,6 +20,7 @@ import (
 	"os"
 	"strconv"
 
+	semconvutils "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"go.opentelemetry.io/otel/attribute"
@@ -86,13 +87,16 @@ func (d *DbClientAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnStart(attrs []attr
 }
 
 func (d *DbClientAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attrs []attribute.KeyValue, context context.Context, request REQUEST, response RESPONSE, err error) ([]attribute.KeyValue, context.Context) {
+	n := len(attrs)
 	attrs, context = d.Base.OnEnd(attrs, context, request, response, err)
+	statement := d.Base.Getter.GetStatement(request)
+	batchSize := d.Base.Getter.GetBatchSize(request)
 	attrs = append(attrs, attribute.KeyValue{
 		Key:   semconv.DBQueryTextKey,
-		Value: attribute.StringValue(d.Base.Getter.GetStatement(request)),
+		Value: attribute.StringValue(statement),
 	}, attribute.KeyValue{
 		Key:   semconv.DBOperationNameKey,
-		Value: attribute.StringValue(d.Base.Getter.GetOperation(request)),
+		Value: attribute.StringValue(BatchOperationName(d.Base.Getter.GetOperation(request), batchSize)),
 	}, attribute.KeyValue{
 		Key:   semconv.ServerAddressKey,
 		Value: attribute.StringValue(d.Base.Getter.GetServerAddress(request)),
@@ -100,14 +104,21 @@ func (d *DbClientAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attrs []attrib
 		Key:   semconv.DBCollectionNameKey,
 		Value: attribute.StringValue(d.Base.Getter.GetCollection(request)),
 	})
-	batchSize := d.Base.Getter.GetBatchSize(request)
-	if batchSize > 0 {
+	// Operations are only considered batches when they contain two or more operations.
+	if batchSize > 1 {
 		attrs = append(attrs, attribute.KeyValue{Key: semconv.DBOperationBatchSizeKey, Value: attribute.IntValue(batchSize)})
 	}
+	if summary := QuerySummary(statement); summary != "" {
+		attrs = append(attrs, attribute.KeyValue{Key: semconv.DBQuerySummaryKey, Value: attribute.StringValue(summary)})
+	}
+	if port := d.Base.Getter.GetServerPort(request); port > 0 {
+		attrs = append(attrs, attribute.KeyValue{Key: semconv.ServerPortKey, Value: attribute.IntValue(port)})
+	}
 	dbNameSpace := d.Base.Getter.GetDbNamespace(request)
 	if dbNameSpace != "" {
 		attrs = append(attrs, attribute.KeyValue{Key: semconv.DBNamespaceKey, Value: attribute.StringValue(dbNameSpace)})
 	}
+	attrs = semconvutils.DupAttributes(semconvutils.DatabaseSemconv, semconvutils.DbOldConvOf(d.Base.Getter.GetSystem(request)), attrs, n)
 	if d.Base.AttributesFilter != nil {
 		attrs = d.Base.AttributesFilter(attrs)
 	}
@@ -127,4 +138,3 @@ func (d *DbClientAttrsExtractor[REQUEST, RESPONSE, GETTER]) GetSpanKey() attribu
 }
 
 // TODO: sanitize sql
-// TODO: batch sql
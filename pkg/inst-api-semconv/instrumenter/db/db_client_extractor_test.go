@@ -19,8 +19,15 @@ import (
 	"log"
 	"testing"
 
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 )
 
@@ -47,6 +54,10 @@ func (m mongoAttrsGetter) GetServerAddress(request testRequest) string {
 	return "test"
 }
 
+func (m mongoAttrsGetter) GetServerPort(request testRequest) int {
+	return 0
+}
+
 func (m mongoAttrsGetter) GetStatement(request testRequest) string {
 	return "test"
 }
@@ -134,3 +145,70 @@ func TestDbClientExtractorWithFilter(t *testing.T) {
 		panic("attribute should be test")
 	}
 }
+
+func TestOrmOperationNestsDbClientSpan(t *testing.T) {
+	// the database/sql client spans are nested in the ORM operations
+	sr := tracetest.NewSpanRecorder()
+	originalTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	defer otel.SetTracerProvider(originalTP)
+	getter := mongoAttrsGetter{}
+	// only the database/sql instrumenter records the db.client.request.duration
+	reader := metric.NewManualReader()
+	sqlMetric, err := newDbClientMetric("test", metric.NewMeterProvider(metric.WithReader(reader)).Meter("test-meter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newInstrumenter := func(scopeName string, kindExtractor instrumenter.SpanKindExtractor[testRequest], listeners ...instrumenter.OperationListener) instrumenter.Instrumenter[testRequest, testResponse] {
+		builder := instrumenter.Builder[testRequest, testResponse]{}
+		return builder.Init().SetSpanNameExtractor(&DBSpanNameExtractor[testRequest]{Getter: getter}).
+			SetSpanKindExtractor(kindExtractor).
+			AddAttributesExtractor(&DbClientAttrsExtractor[testRequest, testResponse, mongoAttrsGetter]{Base: DbClientCommonAttrsExtractor[testRequest, testResponse, mongoAttrsGetter]{Getter: getter}}).
+			AddOperationListeners(listeners...).
+			SetInstrumentationScope(instrumentation.Scope{
+				Name:    scopeName,
+				Version: "test",
+			}).
+			BuildInstrumenter()
+	}
+	sqlInstrumenter := newInstrumenter(utils.DATABASE_SQL_SCOPE_NAME, &instrumenter.AlwaysClientExtractor[testRequest]{}, sqlMetric)
+	ormScopeNames := []string{utils.ENT_SCOPE_NAME, utils.BUN_SCOPE_NAME, utils.XORM_SCOPE_NAME}
+	for _, scopeName := range ormScopeNames {
+		sr.Reset()
+		ormInstrumenter := newInstrumenter(scopeName, &instrumenter.AlwaysInternalExtractor[testRequest]{})
+		request := testRequest{Name: "test", Operation: "SELECT", Target: "users"}
+		ormCtx := ormInstrumenter.Start(context.Background(), request)
+		sqlCtx := sqlInstrumenter.Start(ormCtx, request)
+		sqlInstrumenter.End(sqlCtx, request, testResponse{}, nil)
+		ormInstrumenter.End(ormCtx, request, testResponse{}, nil)
+		spans := sr.Ended()
+		if len(spans) != 2 {
+			t.Fatalf("expected the ORM span and the db client span of %s, got %d spans", scopeName, len(spans))
+		}
+		sqlSpan, ormSpan := spans[0], spans[1]
+		if sqlSpan.InstrumentationScope().Name != utils.DATABASE_SQL_SCOPE_NAME || ormSpan.InstrumentationScope().Name != scopeName {
+			t.Fatalf("unexpected scopes %s and %s", sqlSpan.InstrumentationScope().Name, ormSpan.InstrumentationScope().Name)
+		}
+		if sqlSpan.Parent().SpanID() != ormSpan.SpanContext().SpanID() {
+			t.Errorf("the db client span should be nested in the %s operation", scopeName)
+		}
+	}
+	rm := &metricdata.ResourceMetrics{}
+	if err := reader.Collect(context.Background(), rm); err != nil {
+		t.Fatal(err)
+	}
+	count := uint64(0)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != db_client_request_duration {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+				count += point.Count
+			}
+		}
+	}
+	if count != uint64(len(ormScopeNames)) {
+		t.Errorf("expected the duration to be recorded once per ORM operation, got %d records for %d operations", count, len(ormScopeNames))
+	}
+}
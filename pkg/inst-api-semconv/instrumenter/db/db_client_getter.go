@@ -17,6 +17,7 @@ package db
 type DbClientCommonAttrsGetter[REQUEST any] interface {
 	GetSystem(REQUEST) string
 	GetServerAddress(REQUEST) string
+	GetServerPort(REQUEST) int
 	GetDbNamespace(REQUEST) string
 	GetBatchSize(REQUEST) int
 }
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+// Connection pool metrics (Stability: development).
+// Spec: https://opentelemetry.io/docs/specs/semconv/database/database-metrics/#connection-pools
+const (
+	db_client_connection_count     = "db.client.connection.count"
+	db_client_connection_max       = "db.client.connection.max"
+	db_client_connection_wait_time = "db.client.connection.wait_time"
+)
+
+// ConnectionPoolStats is a snapshot of the connections of a pool.
+type ConnectionPoolStats struct {
+	Idle int64
+	Used int64
+	Max  int64
+}
+
+type connectionPool struct {
+	name  string
+	stats func() ConnectionPoolStats
+}
+
+type connectionPoolMetrics struct {
+	poolsMu  sync.Mutex
+	pools    map[*connectionPool]struct{}
+	waitTime metric.Float64Histogram
+}
+
+var globalPoolMetrics *connectionPoolMetrics
+
+func newConnectionPoolMetrics(meter metric.Meter) (*connectionPoolMetrics, error) {
+	if meter == nil {
+		return nil, errors.New("nil meter")
+	}
+	m := &connectionPoolMetrics{pools: map[*connectionPool]struct{}{}}
+	connCount, err := meter.Int64ObservableUpDownCounter(db_client_connection_count,
+		metric.WithUnit("{connection}"),
+		metric.WithDescription("The number of connections that are currently in state described by the state attribute."))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create db.client.connection.count, %v", err))
+	}
+	maxConns, err := meter.Int64ObservableUpDownCounter(db_client_connection_max,
+		metric.WithUnit("{connection}"),
+		metric.WithDescription("The maximum number of open connections allowed."))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create db.client.connection.max, %v", err))
+	}
+	m.waitTime, err = meter.Float64Histogram(db_client_connection_wait_time,
+		metric.WithUnit("s"),
+		metric.WithDescription("The time it took to obtain an open connection from the pool."))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to create db.client.connection.wait_time, %v", err))
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		m.poolsMu.Lock()
+		defer m.poolsMu.Unlock()
+		for pool := range m.pools {
+			stats := pool.stats()
+			poolName := semconv.DBClientConnectionPoolName(pool.name)
+			o.ObserveInt64(connCount, stats.Idle, metric.WithAttributes(poolName, semconv.DBClientConnectionStateIdle))
+			o.ObserveInt64(connCount, stats.Used, metric.WithAttributes(poolName, semconv.DBClientConnectionStateUsed))
+			if stats.Max > 0 {
+				o.ObserveInt64(maxConns, stats.Max, metric.WithAttributes(poolName))
+			}
+		}
+		return nil
+	}, connCount, maxConns)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to register connection pool callback, %v", err))
+	}
+	return m, nil
+}
+
+func getConnectionPoolMetrics() *connectionPoolMetrics {
+	mu.Lock()
+	defer mu.Unlock()
+	if globalPoolMetrics == nil && globalMeter != nil {
+		var err error
+		globalPoolMetrics, err = newConnectionPoolMetrics(globalMeter)
+		if err != nil {
+			log.Printf("failed to create connection pool metrics, err is %v\n", err)
+		}
+	}
+	return globalPoolMetrics
+}
+
+func (m *connectionPoolMetrics) register(poolName string, stats func() ConnectionPoolStats) func() {
+	pool := &connectionPool{name: poolName, stats: stats}
+	m.poolsMu.Lock()
+	m.pools[pool] = struct{}{}
+	m.poolsMu.Unlock()
+	return func() {
+		m.poolsMu.Lock()
+		delete(m.pools, pool)
+		m.poolsMu.Unlock()
+	}
+}
+
+// RegisterConnectionPool reports db.client.connection.count and
+// db.client.connection.max of the pool until the returned function is called.
+func RegisterConnectionPool(poolName string, stats func() ConnectionPoolStats) (unregister func()) {
+	m := getConnectionPoolMetrics()
+	if m == nil {
+		return func() {}
+	}
+	return m.register(poolName, stats)
+}
+
+// RecordConnectionWaitTime records db.client.connection.wait_time of obtaining
+// a connection from the pool.
+func RecordConnectionWaitTime(ctx context.Context, poolName string, waitTime time.Duration) {
+	m := getConnectionPoolMetrics()
+	if m == nil {
+		return
+	}
+	m.waitTime.Record(ctx, waitTime.Seconds(), metric.WithAttributes(semconv.DBClientConnectionPoolName(poolName)))
+}
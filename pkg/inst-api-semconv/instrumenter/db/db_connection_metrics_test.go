@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func collectPoolMetrics(t *testing.T, reader *metric.ManualReader) map[string]metricdata.Metrics {
+	rm := &metricdata.ResourceMetrics{}
+	if err := reader.Collect(context.Background(), rm); err != nil {
+		t.Fatal(err)
+	}
+	metrics := map[string]metricdata.Metrics{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+	return metrics
+}
+
+func TestConnectionPoolMetrics(t *testing.T) {
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	m, err := newConnectionPoolMetrics(mp.Meter("test-meter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unregister := m.register("localhost:5432/test", func() ConnectionPoolStats {
+		return ConnectionPoolStats{Idle: 2, Used: 3, Max: 10}
+	})
+	m.waitTime.Record(context.Background(), (10 * time.Millisecond).Seconds())
+
+	metrics := collectPoolMetrics(t, reader)
+	count, ok := metrics[db_client_connection_count]
+	if !ok {
+		t.Fatal("expected db.client.connection.count")
+	}
+	points := count.Data.(metricdata.Sum[int64]).DataPoints
+	if len(points) != 2 {
+		t.Fatalf("expected 2 data points, got %d", len(points))
+	}
+	for _, p := range points {
+		state, _ := p.Attributes.Value(semconv.DBClientConnectionStateKey)
+		if (state.AsString() == "idle" && p.Value != 2) || (state.AsString() == "used" && p.Value != 3) {
+			t.Fatalf("unexpected %s connections %d", state.AsString(), p.Value)
+		}
+		if name, _ := p.Attributes.Value(semconv.DBClientConnectionPoolNameKey); name.AsString() != "localhost:5432/test" {
+			t.Fatalf("unexpected pool name %s", name.AsString())
+		}
+	}
+	maxConns, ok := metrics[db_client_connection_max]
+	if !ok || maxConns.Data.(metricdata.Sum[int64]).DataPoints[0].Value != 10 {
+		t.Fatal("expected db.client.connection.max to be 10")
+	}
+	if _, ok := metrics[db_client_connection_wait_time]; !ok {
+		t.Fatal("expected db.client.connection.wait_time")
+	}
+
+	unregister()
+	metrics = collectPoolMetrics(t, reader)
+	if c, ok := metrics[db_client_connection_count]; ok && len(c.Data.(metricdata.Sum[int64]).DataPoints) != 0 {
+		t.Fatal("expected no connection count after unregister")
+	}
+}
+
+func TestConnectionPoolNilMeter(t *testing.T) {
+	if _, err := newConnectionPoolMetrics(nil); err == nil {
+		t.Fatal("expected error on nil meter")
+	}
+}
@@ -19,9 +19,11 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
 	"testing"
 	"time"
 )
@@ -137,3 +139,30 @@ func TestNilMeter(t *testing.T) {
 		panic(err)
 	}
 }
+
+func TestDbClientMetricsExemplar(t *testing.T) {
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader), metric.WithExemplarFilter(exemplar.TraceBasedFilter))
+	client, err := newDbClientMetric("test", mp.Meter("test-meter"))
+	if err != nil {
+		panic(err)
+	}
+	traceID := trace.TraceID{0x01}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	start := time.Now()
+	ctx = client.OnBeforeStart(ctx, start)
+	ctx = client.OnBeforeEnd(ctx, []attribute.KeyValue{}, start)
+	client.OnAfterStart(ctx, start)
+	client.OnAfterEnd(ctx, []attribute.KeyValue{}, start.Add(time.Second))
+	rm := &metricdata.ResourceMetrics{}
+	reader.Collect(ctx, rm)
+	hist := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+	exemplars := hist.DataPoints[0].Exemplars
+	if len(exemplars) != 1 || trace.TraceID(exemplars[0].TraceID) != traceID {
+		panic("expected the exemplar to link to the sampled trace")
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"strings"
+)
+
+const batchOperationPrefix = "BATCH"
+
+// maxQuerySummaryLength follows the semconv recommendation that
+// db.query.summary should not exceed 255 characters.
+const maxQuerySummaryLength = 255
+
+var sqlOperations = map[string]bool{
+	"SELECT":   true,
+	"INSERT":   true,
+	"UPDATE":   true,
+	"DELETE":   true,
+	"REPLACE":  true,
+	"MERGE":    true,
+	"UPSERT":   true,
+	"CREATE":   true,
+	"DROP":     true,
+	"ALTER":    true,
+	"TRUNCATE": true,
+	"WITH":     true,
+	"SET":      true,
+	"CALL":     true,
+	"EXEC":     true,
+	"EXECUTE":  true,
+	"BEGIN":    true,
+	"START":    true,
+	"COMMIT":   true,
+	"ROLLBACK": true,
+	"USE":      true,
+	"SHOW":     true,
+}
+
+// BatchOperationName returns the db.operation.name for an operation executed
+// with the given batch size.
+// ref: https://opentelemetry.io/docs/specs/semconv/database/database-spans/#batch-operations
+// Operations are only considered batches when they contain two or more
+// operations. If all operations in the batch are the same, the name is
+// `BATCH <operation>`, otherwise it is `BATCH`.
+func BatchOperationName(operation string, batchSize int) string {
+	if batchSize < 2 {
+		return operation
+	}
+	if operation == "" {
+		return batchOperationPrefix
+	}
+	upper := strings.ToUpper(operation)
+	if upper == batchOperationPrefix || strings.HasPrefix(upper, batchOperationPrefix+" ") {
+		return operation
+	}
+	return batchOperationPrefix + " " + operation
+}
+
+// CommonOperation returns the operation shared by all the given operations,
+// or an empty string if they differ.
+func CommonOperation(operations ...string) string {
+	if len(operations) == 0 {
+		return ""
+	}
+	first := operations[0]
+	for _, op := range operations[1:] {
+		if !strings.EqualFold(op, first) {
+			return ""
+		}
+	}
+	return first
+}
+
+// SplitStatements splits a query text into its statements, separated by
+// semicolons outside quoted literals and identifiers. Empty statements are
+// dropped.
+func SplitStatements(query string) []string {
+	var statements []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ';':
+			if s := strings.TrimSpace(query[start:i]); s != "" {
+				statements = append(statements, s)
+			}
+			start = i + 1
+		}
+	}
+	if s := strings.TrimSpace(query[start:]); s != "" {
+		statements = append(statements, s)
+	}
+	return statements
+}
+
+// QuerySummary returns the db.query.summary of a multi-statement query text,
+// e.g. `INSERT orders SELECT users` for
+// `INSERT INTO orders VALUES (1); SELECT * FROM users`.
+// An empty string is returned when the query holds a single statement or does
+// not look like SQL, in which case callers should fall back to the operation
+// and collection name.
+func QuerySummary(query string) string {
+	if strings.IndexByte(query, ';') < 0 {
+		return ""
+	}
+	statements := SplitStatements(query)
+	if len(statements) < 2 {
+		return ""
+	}
+	var sb strings.Builder
+	for _, statement := range statements {
+		part, ok := summarizeStatement(statement)
+		if !ok {
+			return ""
+		}
+		if sb.Len() > 0 {
+			if sb.Len()+1+len(part) > maxQuerySummaryLength {
+				break
+			}
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(part)
+	}
+	summary := sb.String()
+	if len(summary) > maxQuerySummaryLength {
+		summary = summary[:maxQuerySummaryLength]
+	}
+	return summary
+}
+
+// summarizeStatement returns `<operation> <target>` of a single statement, and
+// false if the statement does not start with a known SQL operation.
+func summarizeStatement(statement string) (string, bool) {
+	operation, target := StatementOperation(statement)
+	if operation == "" {
+		return "", false
+	}
+	if target == "" {
+		return operation, true
+	}
+	return operation + " " + target, true
+}
+
+// StatementOperation returns the operation and the target collection of a
+// single SQL statement, e.g. `SELECT` and `users` for `SELECT * FROM users`.
+// An empty operation is returned when the statement does not start with a
+// known SQL operation.
+func StatementOperation(statement string) (operation string, target string) {
+	tokens := strings.FieldsFunc(statement, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '(' || r == ')' || r == ','
+	})
+	if len(tokens) == 0 {
+		return "", ""
+	}
+	operation = strings.ToUpper(tokens[0])
+	if !sqlOperations[operation] {
+		return "", ""
+	}
+	switch operation {
+	case "SELECT", "DELETE":
+		target = tokenAfter(tokens, "FROM")
+	case "INSERT", "REPLACE", "MERGE", "UPSERT":
+		target = tokenAfter(tokens, "INTO")
+	case "UPDATE":
+		target = targetAt(tokens, 1)
+	case "CREATE", "DROP", "ALTER", "TRUNCATE":
+		// e.g. `CREATE TABLE IF NOT EXISTS users` -> `CREATE TABLE users`
+		for i := 1; i < len(tokens); i++ {
+			switch strings.ToUpper(tokens[i]) {
+			case "TEMPORARY", "TEMP", "UNIQUE", "OR", "REPLACE":
+				continue
+			}
+			operation += " " + strings.ToUpper(tokens[i])
+			target = targetAt(tokens, i+1)
+			break
+		}
+	}
+	return operation, target
+}
+
+func tokenAfter(tokens []string, keyword string) string {
+	for i := 1; i < len(tokens)-1; i++ {
+		if strings.EqualFold(tokens[i], keyword) {
+			return targetAt(tokens, i+1)
+		}
+	}
+	return ""
+}
+
+// targetAt returns the first token from index i that is not part of an
+// `IF [NOT] EXISTS` clause.
+func targetAt(tokens []string, i int) string {
+	for ; i < len(tokens); i++ {
+		switch strings.ToUpper(tokens[i]) {
+		case "IF", "NOT", "EXISTS":
+			continue
+		}
+		return tokens[i]
+	}
+	return ""
+}
+
+var identifierQuotes = strings.NewReplacer("`", "", "\"", "", "[", "", "]", "")
+
+// UnquoteIdentifier removes the quotes of an identifier returned by
+// StatementOperation, e.g. public.users for "public"."users".
+func UnquoteIdentifier(identifier string) string {
+	return identifierQuotes.Replace(identifier)
+}
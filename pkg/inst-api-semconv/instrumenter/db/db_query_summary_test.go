@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type batchRequest struct {
+	statement string
+	operation string
+	target    string
+	batchSize int
+}
+
+type batchAttrsGetter struct {
+}
+
+func (b batchAttrsGetter) GetSystem(request batchRequest) string {
+	return "mysql"
+}
+
+func (b batchAttrsGetter) GetServerAddress(request batchRequest) string {
+	return "localhost"
+}
+
+func (b batchAttrsGetter) GetServerPort(request batchRequest) int {
+	return 3306
+}
+
+func (b batchAttrsGetter) GetDbNamespace(request batchRequest) string {
+	return ""
+}
+
+func (b batchAttrsGetter) GetBatchSize(request batchRequest) int {
+	return request.batchSize
+}
+
+func (b batchAttrsGetter) GetStatement(request batchRequest) string {
+	return request.statement
+}
+
+func (b batchAttrsGetter) GetOperation(request batchRequest) string {
+	return request.operation
+}
+
+func (b batchAttrsGetter) GetCollection(request batchRequest) string {
+	return request.target
+}
+
+func (b batchAttrsGetter) GetParameters(request batchRequest) []any {
+	return nil
+}
+
+func TestBatchOperationName(t *testing.T) {
+	cases := []struct {
+		operation string
+		batchSize int
+		expected  string
+	}{
+		{"INSERT", 0, "INSERT"},
+		{"INSERT", 1, "INSERT"},
+		{"INSERT", 2, "BATCH INSERT"},
+		{"", 3, "BATCH"},
+		{"BATCH", 3, "BATCH"},
+		{"BATCH INSERT", 3, "BATCH INSERT"},
+	}
+	for _, c := range cases {
+		if actual := BatchOperationName(c.operation, c.batchSize); actual != c.expected {
+			t.Fatalf("expected %q for (%q, %d), got %q", c.expected, c.operation, c.batchSize, actual)
+		}
+	}
+}
+
+func TestCommonOperation(t *testing.T) {
+	if CommonOperation() != "" {
+		t.Fatal("expected empty operation")
+	}
+	if CommonOperation("set", "SET", "set") != "set" {
+		t.Fatal("expected set")
+	}
+	if CommonOperation("set", "get") != "" {
+		t.Fatal("expected empty operation for mixed batch")
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	statements := SplitStatements("INSERT INTO t VALUES ('a;b'); SELECT `x;y` FROM t;;  ")
+	if len(statements) != 2 {
+		t.Fatalf("expected 2 statements, got %v", statements)
+	}
+	if statements[0] != "INSERT INTO t VALUES ('a;b')" || statements[1] != "SELECT `x;y` FROM t" {
+		t.Fatalf("unexpected statements %v", statements)
+	}
+}
+
+func TestQuerySummary(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT * FROM users", ""},
+		{"SELECT * FROM users;", ""},
+		{"INSERT INTO orders (id) VALUES (1); SELECT * FROM users WHERE id = 1", "INSERT orders SELECT users"},
+		{"CREATE TABLE IF NOT EXISTS users (id int); DROP TABLE tmp", "CREATE TABLE users DROP TABLE tmp"},
+		{"UPDATE users SET a = 1; DELETE FROM logs; COMMIT", "UPDATE users DELETE logs COMMIT"},
+		{"SET key a;b", ""},
+	}
+	for _, c := range cases {
+		if actual := QuerySummary(c.query); actual != c.expected {
+			t.Fatalf("expected %q for %q, got %q", c.expected, c.query, actual)
+		}
+	}
+}
+
+func TestStatementOperation(t *testing.T) {
+	cases := []struct {
+		statement string
+		operation string
+		target    string
+	}{
+		{"select * from users where id = $1", "SELECT", "users"},
+		{"INSERT INTO orders (id) VALUES ($1)", "INSERT", "orders"},
+		{"CREATE TABLE IF NOT EXISTS users (id int)", "CREATE TABLE", "users"},
+		{"COMMIT", "COMMIT", ""},
+		{"LISTEN channel", "", ""},
+	}
+	for _, c := range cases {
+		operation, target := StatementOperation(c.statement)
+		if operation != c.operation || target != c.target {
+			t.Fatalf("expected %q %q for %q, got %q %q", c.operation, c.target, c.statement, operation, target)
+		}
+	}
+}
+
+func TestUnquoteIdentifier(t *testing.T) {
+	cases := map[string]string{
+		"users":            "users",
+		"`users`":          "users",
+		`"public"."users"`: "public.users",
+		"[dbo].[users]":    "dbo.users",
+	}
+	for identifier, expected := range cases {
+		if actual := UnquoteIdentifier(identifier); actual != expected {
+			t.Fatalf("expected %q for %q, got %q", expected, identifier, actual)
+		}
+	}
+}
+
+func TestQuerySummaryTruncated(t *testing.T) {
+	query := strings.Repeat("SELECT * FROM a_very_long_table_name; ", 20)
+	summary := QuerySummary(query)
+	if len(summary) > maxQuerySummaryLength {
+		t.Fatalf("summary should not exceed %d characters, got %d", maxQuerySummaryLength, len(summary))
+	}
+	if !strings.HasPrefix(summary, "SELECT a_very_long_table_name") || strings.HasSuffix(summary, " ") {
+		t.Fatalf("unexpected summary %q", summary)
+	}
+}
+
+func TestBatchSpanName(t *testing.T) {
+	extractor := DBSpanNameExtractor[batchRequest]{Getter: batchAttrsGetter{}}
+	if name := extractor.Extract(batchRequest{operation: "INSERT", target: "users", batchSize: 5}); name != "BATCH INSERT users" {
+		t.Fatalf("expected BATCH INSERT users, got %s", name)
+	}
+	if name := extractor.Extract(batchRequest{operation: "INSERT", target: "users", batchSize: 1}); name != "INSERT users" {
+		t.Fatalf("expected INSERT users, got %s", name)
+	}
+	if name := extractor.Extract(batchRequest{statement: "INSERT INTO a VALUES (1); SELECT * FROM b", operation: "INSERT"}); name != "INSERT a SELECT b" {
+		t.Fatalf("expected INSERT a SELECT b, got %s", name)
+	}
+}
+
+func TestDbClientExtractorBatch(t *testing.T) {
+	dbExtractor := DbClientAttrsExtractor[batchRequest, any, batchAttrsGetter]{}
+	attrs, _ := dbExtractor.OnEnd(nil, context.Background(), batchRequest{
+		statement: "INSERT INTO a VALUES (1); INSERT INTO a VALUES (2)",
+		operation: "INSERT",
+		target:    "a",
+		batchSize: 2,
+	}, nil, nil)
+	expected := map[attribute.Key]attribute.Value{
+		semconv.DBOperationNameKey:      attribute.StringValue("BATCH INSERT"),
+		semconv.DBOperationBatchSizeKey: attribute.IntValue(2),
+		semconv.DBQuerySummaryKey:       attribute.StringValue("INSERT a INSERT a"),
+		semconv.ServerPortKey:           attribute.IntValue(3306),
+	}
+	for _, attr := range attrs {
+		if v, ok := expected[attr.Key]; ok {
+			if v != attr.Value {
+				t.Fatalf("expected %s to be %v, got %v", attr.Key, v.Emit(), attr.Value.Emit())
+			}
+			delete(expected, attr.Key)
+		}
+	}
+	if len(expected) != 0 {
+		t.Fatalf("missing attributes %v", expected)
+	}
+}
+
+func TestDbClientExtractorSingleBatch(t *testing.T) {
+	dbExtractor := DbClientAttrsExtractor[batchRequest, any, batchAttrsGetter]{}
+	attrs, _ := dbExtractor.OnEnd(nil, context.Background(), batchRequest{
+		statement: "INSERT INTO a VALUES (1)",
+		operation: "INSERT",
+		batchSize: 1,
+	}, nil, nil)
+	for _, attr := range attrs {
+		if attr.Key == semconv.DBOperationBatchSizeKey || attr.Key == semconv.DBQuerySummaryKey {
+			t.Fatalf("unexpected attribute %s", attr.Key)
+		}
+	}
+}
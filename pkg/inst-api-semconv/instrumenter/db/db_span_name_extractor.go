@@ -20,7 +20,11 @@ type DBSpanNameExtractor[REQUEST any] struct {
 
 // ref: https://opentelemetry.io/docs/specs/semconv/database/database-spans/#name
 func (d *DBSpanNameExtractor[REQUEST]) Extract(request REQUEST) string {
-	operation := d.Getter.GetOperation(request)
+	// Multi-statement queries are named after their summary, e.g. `INSERT orders SELECT users`.
+	if summary := QuerySummary(d.Getter.GetStatement(request)); summary != "" {
+		return summary
+	}
+	operation := BatchOperationName(d.Getter.GetOperation(request), d.Getter.GetBatchSize(request))
 	target := d.Getter.GetCollection(request)
 	system := d.Getter.GetSystem(request)
 
@@ -35,6 +39,6 @@ func (d *DBSpanNameExtractor[REQUEST]) Extract(request REQUEST) string {
 	if system != "" {
 		return system
 	}
-	
+
 	return "DB"
-}
\ No newline at end of file
+}
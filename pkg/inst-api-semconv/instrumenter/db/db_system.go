@@ -0,0 +1,46 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+// db.system.name of the vector databases. The registry does not list them yet,
+// so they use the lowercase product name as it asks for the unlisted systems.
+const (
+	SystemMilvus   = "milvus"
+	SystemQdrant   = "qdrant"
+	SystemWeaviate = "weaviate"
+)
+
+// SystemName returns the db.system.name of a database/sql driver or an ORM
+// dialect name, or an empty string for the unknown ones.
+// ref: https://opentelemetry.io/docs/specs/semconv/registry/attributes/db/#db-system-name
+func SystemName(driverName string) string {
+	switch driverName {
+	case "mysql":
+		return "mysql"
+	case "postgres", "postgresql", "pg", "pgx", "pgx/v5":
+		return "postgresql"
+	case "sqlserver", "mssql", "azuresql":
+		return "microsoft.sql_server"
+	case "clickhouse":
+		return "clickhouse"
+	case "sqlite3", "sqlite":
+		return "sqlite"
+	case "oracle", "godror":
+		return "oracle.db"
+	case "snowflake":
+		return "other_sql"
+	}
+	return ""
+}
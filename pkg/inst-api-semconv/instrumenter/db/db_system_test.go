@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import "testing"
+
+func TestSystemName(t *testing.T) {
+	tests := map[string]string{
+		"mysql":   "mysql",
+		"pgx/v5":  "postgresql",
+		"pg":      "postgresql",
+		"sqlite3": "sqlite",
+		"mssql":   "microsoft.sql_server",
+		"godror":  "oracle.db",
+		"gremlin": "",
+		"":        "",
+	}
+	for driverName, expected := range tests {
+		if actual := SystemName(driverName); actual != expected {
+			t.Errorf("SystemName(%q) = %q, want %q", driverName, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	DBVectorQueryTopKKey = attribute.Key("db.vector.query.top_k")
+	DBVectorDimensionKey = attribute.Key("db.vector.dimension")
+)
+
+// VectorDbAttrsGetter reports the similarity search details of the vector
+// database clients. The getters return zero for the unknown values, except
+// GetResultCount which returns a negative number, since an empty result is
+// meaningful.
+type VectorDbAttrsGetter[REQUEST any, RESPONSE any] interface {
+	GetTopK(REQUEST) int
+	GetVectorDimension(REQUEST) int
+	GetResultCount(REQUEST, RESPONSE) int
+}
+
+type VectorDbAttrsExtractor[REQUEST any, RESPONSE any, GETTER VectorDbAttrsGetter[REQUEST, RESPONSE]] struct {
+	Getter GETTER
+}
+
+func (v *VectorDbAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnStart(attrs []attribute.KeyValue, parentContext context.Context, request REQUEST) ([]attribute.KeyValue, context.Context) {
+	if topK := v.Getter.GetTopK(request); topK > 0 {
+		attrs = append(attrs, attribute.KeyValue{Key: DBVectorQueryTopKKey, Value: attribute.IntValue(topK)})
+	}
+	if dimension := v.Getter.GetVectorDimension(request); dimension > 0 {
+		attrs = append(attrs, attribute.KeyValue{Key: DBVectorDimensionKey, Value: attribute.IntValue(dimension)})
+	}
+	return attrs, parentContext
+}
+
+func (v *VectorDbAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attrs []attribute.KeyValue, context context.Context, request REQUEST, response RESPONSE, err error) ([]attribute.KeyValue, context.Context) {
+	if err != nil {
+		return attrs, context
+	}
+	if count := v.Getter.GetResultCount(request, response); count >= 0 {
+		attrs = append(attrs, attribute.KeyValue{Key: semconv.DBResponseReturnedRowsKey, Value: attribute.IntValue(count)})
+	}
+	return attrs, context
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type vectorRequest struct {
+	topK      int
+	dimension int
+}
+
+type vectorResponse struct {
+	count int
+}
+
+type vectorAttrsGetter struct {
+}
+
+func (v vectorAttrsGetter) GetTopK(request vectorRequest) int {
+	return request.topK
+}
+
+func (v vectorAttrsGetter) GetVectorDimension(request vectorRequest) int {
+	return request.dimension
+}
+
+func (v vectorAttrsGetter) GetResultCount(request vectorRequest, response vectorResponse) int {
+	return response.count
+}
+
+func TestVectorDbExtractorSearch(t *testing.T) {
+	extractor := VectorDbAttrsExtractor[vectorRequest, vectorResponse, vectorAttrsGetter]{}
+	request := vectorRequest{topK: 5, dimension: 768}
+	attrs, _ := extractor.OnStart(nil, context.Background(), request)
+	attrs, _ = extractor.OnEnd(attrs, context.Background(), request, vectorResponse{count: 0}, nil)
+	expected := map[attribute.Key]int64{
+		DBVectorQueryTopKKey:              5,
+		DBVectorDimensionKey:              768,
+		semconv.DBResponseReturnedRowsKey: 0,
+	}
+	if len(attrs) != len(expected) {
+		t.Fatalf("expected %d attributes, got %v", len(expected), attrs)
+	}
+	for _, attr := range attrs {
+		if value, ok := expected[attr.Key]; !ok || attr.Value.AsInt64() != value {
+			t.Errorf("unexpected attribute %s=%v", attr.Key, attr.Value.Emit())
+		}
+	}
+}
+
+func TestVectorDbExtractorUnknownValues(t *testing.T) {
+	extractor := VectorDbAttrsExtractor[vectorRequest, vectorResponse, vectorAttrsGetter]{}
+	attrs, _ := extractor.OnStart(nil, context.Background(), vectorRequest{})
+	attrs, _ = extractor.OnEnd(attrs, context.Background(), vectorRequest{}, vectorResponse{count: -1}, nil)
+	if len(attrs) != 0 {
+		t.Fatalf("expected no attributes, got %v", attrs)
+	}
+}
+
+func TestVectorDbExtractorError(t *testing.T) {
+	extractor := VectorDbAttrsExtractor[vectorRequest, vectorResponse, vectorAttrsGetter]{}
+	attrs, _ := extractor.OnEnd(nil, context.Background(), vectorRequest{}, vectorResponse{count: 3}, errors.New("unavailable"))
+	if len(attrs) != 0 {
+		t.Fatalf("expected no result count on error, got %v", attrs)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type GraphqlAttrsExtractor[REQUEST any, RESPONSE any, GETTER GraphqlAttrsGetter[REQUEST]] struct {
+	Getter GETTER
+}
+
+func (g *GraphqlAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request REQUEST) ([]attribute.KeyValue, context.Context) {
+	if operationName := g.Getter.GetOperationName(request); operationName != "" {
+		attributes = append(attributes, semconv.GraphqlOperationName(operationName))
+	}
+	if operationType := g.Getter.GetOperationType(request); operationType != "" {
+		attributes = append(attributes, semconv.GraphqlOperationTypeKey.String(operationType))
+	}
+	if document := g.Getter.GetDocument(request); document != "" {
+		attributes = append(attributes, semconv.GraphqlDocument(SanitizeDocument(document)))
+	}
+	return attributes, parentContext
+}
+
+func (g *GraphqlAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attributes []attribute.KeyValue, context context.Context, request REQUEST, response RESPONSE, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func TestGraphqlAttrsExtractorStart(t *testing.T) {
+	extractor := GraphqlAttrsExtractor[testRequest, testResponse, graphqlAttrsGetter]{}
+	attrs, _ := extractor.OnStart([]attribute.KeyValue{}, context.Background(), testRequest{
+		operationName: "GetUser",
+		operationType: "query",
+		document:      `query GetUser { user(id: "1") { name } }`,
+	})
+	if len(attrs) != 3 {
+		t.Fatalf("expected 3 attributes, got %d", len(attrs))
+	}
+	if attrs[0].Key != semconv.GraphqlOperationNameKey || attrs[0].Value.AsString() != "GetUser" {
+		t.Fatalf("graphql.operation.name should be GetUser")
+	}
+	if attrs[1].Key != semconv.GraphqlOperationTypeKey || attrs[1].Value.AsString() != "query" {
+		t.Fatalf("graphql.operation.type should be query")
+	}
+	if attrs[2].Key != semconv.GraphqlDocumentKey || attrs[2].Value.AsString() != `query GetUser { user(id: ?) { name } }` {
+		t.Fatalf("graphql.document should be sanitized, got %s", attrs[2].Value.AsString())
+	}
+}
+
+func TestGraphqlAttrsExtractorSkipEmpty(t *testing.T) {
+	extractor := GraphqlAttrsExtractor[testRequest, testResponse, graphqlAttrsGetter]{}
+	attrs, _ := extractor.OnStart([]attribute.KeyValue{}, context.Background(), testRequest{})
+	if len(attrs) != 0 {
+		t.Fatalf("expected no attributes, got %d", len(attrs))
+	}
+	attrs, _ = extractor.OnEnd(attrs, context.Background(), testRequest{}, testResponse{}, nil)
+	if len(attrs) != 0 {
+		t.Fatalf("expected no attributes, got %d", len(attrs))
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"strings"
+)
+
+// SanitizeDocument replaces the string and number literals of a GraphQL
+// document with "?" and drops its comments, so that the values inlined by the
+// clients are never recorded. The white spaces are collapsed as well.
+func SanitizeDocument(document string) string {
+	var b strings.Builder
+	b.Grow(len(document))
+	space := false
+	emit := func(token string) {
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteString(token)
+	}
+	for i := 0; i < len(document); {
+		c := document[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+			i++
+		case c == '#':
+			for i < len(document) && document[i] != '\n' && document[i] != '\r' {
+				i++
+			}
+			space = true
+		case c == '"':
+			i = skipString(document, i)
+			emit("?")
+		case c == '-' || isDigit(c):
+			j := i + 1
+			for j < len(document) && isNumberContinue(document[j]) {
+				j++
+			}
+			emit("?")
+			i = j
+		case isNameStart(c):
+			j := i + 1
+			for j < len(document) && (isNameStart(document[j]) || isDigit(document[j])) {
+				j++
+			}
+			emit(document[i:j])
+			i = j
+		case strings.HasPrefix(document[i:], "..."):
+			emit("...")
+			i += 3
+		default:
+			emit(document[i : i+1])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// skipString returns the index after the string or block string starting at i.
+func skipString(document string, i int) int {
+	if strings.HasPrefix(document[i:], `"""`) {
+		for j := i + 3; j < len(document); j++ {
+			if document[j] == '\\' && strings.HasPrefix(document[j+1:], `"""`) {
+				j += 3
+				continue
+			}
+			if strings.HasPrefix(document[j:], `"""`) {
+				return j + 3
+			}
+		}
+		return len(document)
+	}
+	for j := i + 1; j < len(document); j++ {
+		switch document[j] {
+		case '\\':
+			j++
+		case '"', '\n', '\r':
+			return j + 1
+		}
+	}
+	return len(document)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNumberContinue(c byte) bool {
+	return isDigit(c) || c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-'
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import "testing"
+
+func TestSanitizeDocument(t *testing.T) {
+	tests := map[string]string{
+		`query GetUser($id: ID!) { user(id: $id) { name } }`:                    `query GetUser($id: ID!) { user(id: $id) { name } }`,
+		`{ user(id: "42", age: 18, score: -1.5e3) { name } }`:                   `{ user(id: ?, age: ?, score: ?) { name } }`,
+		"query {\n  # the secret is 42\n  user(token: \"a\\\"b\") { name2 }\n}": `query { user(token: ?) { name2 } }`,
+		"mutation { post(body: \"\"\"multi\nline \\\"\"\" text\"\"\") { id } }": `mutation { post(body: ?) { id } }`,
+		`{ ...UserFields } fragment UserFields on User { id }`:                  `{ ...UserFields } fragment UserFields on User { id }`,
+		`{ users(first: 10) @include(if: true) { id } }`:                        `{ users(first: ?) @include(if: true) { id } }`,
+	}
+	for document, expected := range tests {
+		if actual := SanitizeDocument(document); actual != expected {
+			t.Fatalf("expected %q, got %q", expected, actual)
+		}
+	}
+}
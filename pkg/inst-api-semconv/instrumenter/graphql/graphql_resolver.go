@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+const OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE = "OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE"
+
+// OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH limits the depth of the
+// fields traced, the root fields are at depth 1.
+const OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH = "OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH"
+
+// OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS limits the resolver spans
+// of one operation.
+const OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS = "OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS"
+
+const (
+	defaultResolverMaxDepth = 5
+	defaultResolverMaxSpans = 100
+)
+
+var (
+	resolverSpanEnabled = os.Getenv(OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE) == "true"
+	resolverMaxDepth    = positiveIntFromEnv(OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH, defaultResolverMaxDepth)
+	resolverMaxSpans    = positiveIntFromEnv(OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS, defaultResolverMaxSpans)
+)
+
+type resolverBudgetKey struct{}
+
+type resolverBudget struct {
+	remaining atomic.Int64
+}
+
+func positiveIntFromEnv(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// ContextWithResolverBudget returns the context of an operation in which at
+// most OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS resolver spans are
+// started.
+func ContextWithResolverBudget(ctx context.Context) context.Context {
+	if !resolverSpanEnabled {
+		return ctx
+	}
+	budget := &resolverBudget{}
+	budget.remaining.Store(int64(resolverMaxSpans))
+	return context.WithValue(ctx, resolverBudgetKey{}, budget)
+}
+
+// ShouldTraceResolver reports whether the resolver of a field at depth should
+// be traced, resolver spans are only started in the context of an operation.
+func ShouldTraceResolver(ctx context.Context, depth int) bool {
+	if !resolverSpanEnabled || depth > resolverMaxDepth {
+		return false
+	}
+	budget, ok := ctx.Value(resolverBudgetKey{}).(*resolverBudget)
+	return ok && budget.remaining.Add(-1) >= 0
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+	"testing"
+)
+
+func TestShouldTraceResolver(t *testing.T) {
+	resolverSpanEnabled = true
+	resolverMaxDepth = 2
+	resolverMaxSpans = 3
+	defer func() {
+		resolverSpanEnabled = false
+		resolverMaxDepth = defaultResolverMaxDepth
+		resolverMaxSpans = defaultResolverMaxSpans
+	}()
+	if ShouldTraceResolver(context.Background(), 1) {
+		t.Fatalf("resolver should not be traced out of an operation")
+	}
+	ctx := ContextWithResolverBudget(context.Background())
+	if ShouldTraceResolver(ctx, 3) {
+		t.Fatalf("resolver deeper than the max depth should not be traced")
+	}
+	for i := 0; i < 3; i++ {
+		if !ShouldTraceResolver(ctx, 1) {
+			t.Fatalf("resolver %d should be traced", i)
+		}
+	}
+	if ShouldTraceResolver(ctx, 1) {
+		t.Fatalf("resolver over the budget should not be traced")
+	}
+}
+
+func TestShouldTraceResolverDisabled(t *testing.T) {
+	ctx := ContextWithResolverBudget(context.Background())
+	if ShouldTraceResolver(ctx, 1) {
+		t.Fatalf("resolver spans are disabled by default")
+	}
+}
+
+func TestPositiveIntFromEnv(t *testing.T) {
+	t.Setenv(OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH, "7")
+	if v := positiveIntFromEnv(OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH, 5); v != 7 {
+		t.Fatalf("expected 7, got %d", v)
+	}
+	t.Setenv(OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH, "-1")
+	if v := positiveIntFromEnv(OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH, 5); v != 5 {
+		t.Fatalf("expected default 5, got %d", v)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+type GraphqlSpanNameExtractor[REQUEST any] struct {
+	Getter GraphqlAttrsGetter[REQUEST]
+}
+
+// Extract names the span as {graphql.operation.type} {graphql.operation.name},
+// e.g. query GetUser.
+func (g *GraphqlSpanNameExtractor[REQUEST]) Extract(request REQUEST) string {
+	operationType := g.Getter.GetOperationType(request)
+	if operationType == "" {
+		return "GraphQL Operation"
+	}
+	operationName := g.Getter.GetOperationName(request)
+	if operationName == "" {
+		return operationType
+	}
+	return operationType + " " + operationName
+}
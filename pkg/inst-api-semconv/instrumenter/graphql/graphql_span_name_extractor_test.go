@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import "testing"
+
+type testRequest struct {
+	operationName string
+	operationType string
+	document      string
+}
+
+type testResponse struct {
+}
+
+type graphqlAttrsGetter struct {
+}
+
+func (g graphqlAttrsGetter) GetOperationName(request testRequest) string {
+	return request.operationName
+}
+
+func (g graphqlAttrsGetter) GetOperationType(request testRequest) string {
+	return request.operationType
+}
+
+func (g graphqlAttrsGetter) GetDocument(request testRequest) string {
+	return request.document
+}
+
+func TestExtractSpanName(t *testing.T) {
+	g := GraphqlSpanNameExtractor[testRequest]{Getter: graphqlAttrsGetter{}}
+	if spanName := g.Extract(testRequest{operationName: "GetUser", operationType: "query"}); spanName != "query GetUser" {
+		t.Fatalf("expected 'query GetUser', got '%s'", spanName)
+	}
+	if spanName := g.Extract(testRequest{operationType: "mutation"}); spanName != "mutation" {
+		t.Fatalf("expected 'mutation', got '%s'", spanName)
+	}
+	if spanName := g.Extract(testRequest{}); spanName != "GraphQL Operation" {
+		t.Fatalf("expected 'GraphQL Operation', got '%s'", spanName)
+	}
+}
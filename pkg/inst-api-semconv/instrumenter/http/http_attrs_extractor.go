@@ -17,9 +17,11 @@ package http
 import (
 	"context"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/net"
+	semconvutils "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"go.opentelemetry.io/otel/attribute"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv20 "go.opentelemetry.io/otel/semconv/v1.20.0"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 	"strings"
@@ -68,10 +70,10 @@ type HttpClientAttrsExtractor[REQUEST any, RESPONSE any, GETTER1 HttpClientAttrs
 }
 
 func (h *HttpClientAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request REQUEST) ([]attribute.KeyValue, context.Context) {
+	n := len(attributes)
 	attributes, parentContext = h.Base.OnStart(attributes, parentContext, request)
 	attributes, parentContext = h.NetworkExtractor.OnStart(attributes, parentContext, request)
 	fullUrl := h.Base.HttpGetter.GetUrlFull(request)
-	// TODO: add resend count
 	attributes = append(attributes, attribute.KeyValue{
 		Key:   semconv.URLFullKey,
 		Value: attribute.StringValue(fullUrl),
@@ -82,6 +84,11 @@ func (h *HttpClientAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnStart(
 		Key:   semconv.ServerPortKey,
 		Value: attribute.IntValue(h.Base.HttpGetter.GetServerPort(request)),
 	})
+	// the first request of a logical request is not a resend
+	if resendCount, ok := nextResendCount(parentContext); ok && resendCount > 0 {
+		attributes = append(attributes, semconv.HTTPRequestResendCount(resendCount))
+	}
+	attributes = semconvutils.DupAttributes(semconvutils.HttpSemconv, semconvutils.HttpClientOldConv, attributes, n)
 	if h.Base.AttributesFilter != nil {
 		attributes = h.Base.AttributesFilter(attributes)
 	}
@@ -89,8 +96,10 @@ func (h *HttpClientAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnStart(
 }
 
 func (h *HttpClientAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2]) OnEnd(attributes []attribute.KeyValue, context context.Context, request REQUEST, response RESPONSE, err error) ([]attribute.KeyValue, context.Context) {
+	n := len(attributes)
 	attributes, context = h.Base.OnEnd(attributes, context, request, response, err)
 	attributes, context = h.NetworkExtractor.OnEnd(attributes, context, request, response, err)
+	attributes = semconvutils.DupAttributes(semconvutils.HttpSemconv, semconvutils.HttpClientOldConv, attributes, n)
 	if h.Base.AttributesFilter != nil {
 		attributes = h.Base.AttributesFilter(attributes)
 	}
@@ -108,6 +117,7 @@ type HttpServerAttrsExtractor[REQUEST any, RESPONSE any, GETTER1 HttpServerAttrs
 }
 
 func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3]) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request REQUEST) ([]attribute.KeyValue, context.Context) {
+	n := len(attributes)
 	attributes, parentContext = h.Base.OnStart(attributes, parentContext, request)
 	attributes, parentContext = h.UrlExtractor.OnStart(attributes, parentContext, request)
 	userAgent := h.Base.HttpGetter.GetHttpRequestHeader(request, "User-Agent")
@@ -121,6 +131,10 @@ func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3])
 		Key:   semconv.UserAgentOriginalKey,
 		Value: attribute.StringValue(firstUserAgent),
 	})
+	if semconvutils.EmitOldSemconv(semconvutils.HttpSemconv) {
+		attributes = append(attributes, httpTarget(attributes[n:]))
+	}
+	attributes = semconvutils.DupAttributes(semconvutils.HttpSemconv, semconvutils.HttpServerOldConv, attributes, n)
 	if h.Base.AttributesFilter != nil {
 		attributes = h.Base.AttributesFilter(attributes)
 	}
@@ -128,6 +142,7 @@ func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3])
 }
 
 func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3]) OnEnd(attributes []attribute.KeyValue, context context.Context, request REQUEST, response RESPONSE, err error) ([]attribute.KeyValue, context.Context) {
+	n := len(attributes)
 	attributes, context = h.Base.OnEnd(attributes, context, request, response, err)
 	attributes, context = h.UrlExtractor.OnEnd(attributes, context, request, response, err)
 	attributes, context = h.NetworkExtractor.OnEnd(attributes, context, request, response, err)
@@ -143,6 +158,7 @@ func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3])
 			Value: attribute.StringValue(route),
 		})
 	}
+	attributes = semconvutils.DupAttributes(semconvutils.HttpSemconv, semconvutils.HttpServerOldConv, attributes, n)
 	if h.Base.AttributesFilter != nil {
 		attributes = h.Base.AttributesFilter(attributes)
 	}
@@ -152,3 +168,20 @@ func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3])
 func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3]) GetSpanKey() attribute.Key {
 	return utils.HTTP_SERVER_KEY
 }
+
+// httpTarget composes the old http.target attribute of url.path and url.query.
+func httpTarget(attrs []attribute.KeyValue) attribute.KeyValue {
+	var path, query string
+	for _, attr := range attrs {
+		switch attr.Key {
+		case semconv.URLPathKey:
+			path = attr.Value.AsString()
+		case semconv.URLQueryKey:
+			query = attr.Value.AsString()
+		}
+	}
+	if query != "" {
+		path += "?" + query
+	}
+	return semconv20.HTTPTarget(path)
+}
@@ -443,3 +443,32 @@ func TestNonRecordingSpan(t *testing.T) {
 		t.Fatalf("wrong network peer port")
 	}
 }
+
+func TestHttpClientExtractorResendCount(t *testing.T) {
+	httpClientExtractor := HttpClientAttrsExtractor[testRequest, testResponse, httpClientAttrsGetter, networkAttrsGetter]{
+		Base:             HttpCommonAttrsExtractor[testRequest, testResponse, httpClientAttrsGetter, networkAttrsGetter]{},
+		NetworkExtractor: net.NetworkAttrsExtractor[testRequest, testResponse, networkAttrsGetter]{},
+	}
+	parentContext := ContextWithResendCounter(context.Background())
+	for i := 0; i < 3; i++ {
+		attrs, _ := httpClientExtractor.OnStart(make([]attribute.KeyValue, 0), parentContext, testRequest{})
+		var resendCount attribute.Value
+		for _, attr := range attrs {
+			if attr.Key == semconv.HTTPRequestResendCountKey {
+				resendCount = attr.Value
+			}
+		}
+		if i == 0 && resendCount.Type() != attribute.INVALID {
+			t.Fatalf("the first request should not have resend count")
+		}
+		if i > 0 && resendCount.AsInt64() != int64(i) {
+			t.Fatalf("resend count should be %d, got %v", i, resendCount.AsInt64())
+		}
+	}
+	attrs, _ := httpClientExtractor.OnStart(make([]attribute.KeyValue, 0), context.Background(), testRequest{})
+	for _, attr := range attrs {
+		if attr.Key == semconv.HTTPRequestResendCountKey {
+			t.Fatalf("resend count should not be set without counter")
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type httpResendCounterKey struct{}
+
+type httpResendCounter struct {
+	sent atomic.Int64
+}
+
+// ContextWithResendCounter returns a context that counts the http client
+// requests started with it. Libraries that retry a logical request use it so
+// that every request after the first one is stamped with
+// http.request.resend_count.
+func ContextWithResendCounter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, httpResendCounterKey{}, &httpResendCounter{})
+}
+
+func nextResendCount(ctx context.Context) (int, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	counter, ok := ctx.Value(httpResendCounterKey{}).(*httpResendCounter)
+	if !ok {
+		return 0, false
+	}
+	return int(counter.sent.Add(1) - 1), true
+}
@@ -16,6 +16,8 @@ package message
 
 import (
 	"context"
+
+	semconvutils "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
@@ -45,6 +47,7 @@ func (m *MessageAttrsExtractor[REQUEST, RESPONSE, GETTER]) GetSpanKey() attribut
 }
 
 func (m *MessageAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request REQUEST) ([]attribute.KeyValue, context.Context) {
+	n := len(attributes)
 	messageAttrSystem := m.Getter.GetSystem(request)
 	isTemporaryDestination := m.Getter.IsTemporaryDestination(request)
 	if isTemporaryDestination {
@@ -97,6 +100,7 @@ func (m *MessageAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnStart(attributes []
 		Key:   semconv.MessagingSystemKey,
 		Value: attribute.StringValue(messageAttrSystem),
 	})
+	attributes = semconvutils.DupAttributes(semconvutils.MessagingSemconv, semconvutils.MessagingOldConv, attributes, n)
 	return attributes, parentContext
 }
 
@@ -108,6 +112,16 @@ func (m *MessageAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attributes []at
 		Key:   semconv.MessagingBatchMessageCountKey,
 		Value: attribute.Int64Value(m.Getter.GetBatchMessageCount(request, response)),
 	})
+	if err != nil {
+		errorType := m.Getter.GetErrorType(request, response, err)
+		if errorType == "" {
+			errorType = semconv.ErrorTypeOther.Value.AsString()
+		}
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   semconv.ErrorTypeKey,
+			Value: attribute.StringValue(errorType),
+		})
+	}
 	// TODO: add custom captured headers attributes
 	return attributes, context
 }
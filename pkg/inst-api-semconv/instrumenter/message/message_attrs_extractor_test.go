@@ -16,6 +16,7 @@ package message
 
 import (
 	"context"
+	"errors"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
@@ -77,6 +78,15 @@ func (m messageAttrsGetter) GetDestinationPartitionId(request testRequest) strin
 	return "partition-id"
 }
 
+var errTestTimeout = errors.New("timeout")
+
+func (m messageAttrsGetter) GetErrorType(request testRequest, response testResponse, err error) string {
+	if errors.Is(err, errTestTimeout) {
+		return "timeout"
+	}
+	return ""
+}
+
 func TestMessageGetSpanKey(t *testing.T) {
 	messageExtractor := &MessageAttrsExtractor[testRequest, testResponse, messageAttrsGetter]{Operation: PUBLISH}
 	if messageExtractor.GetSpanKey() != utils.PRODUCER_KEY {
@@ -178,3 +188,15 @@ func TestMessageClientExtractorEnd(t *testing.T) {
 		t.Fatalf("messaging batch message count should be 2024")
 	}
 }
+
+func TestMessageClientExtractorEndWithError(t *testing.T) {
+	messageExtractor := MessageAttrsExtractor[testRequest, testResponse, messageAttrsGetter]{}
+	attrs, _ := messageExtractor.OnEnd(nil, context.Background(), testRequest{}, testResponse{}, errTestTimeout)
+	if attrs[2].Key != semconv.ErrorTypeKey || attrs[2].Value.AsString() != "timeout" {
+		t.Fatalf("error type should be timeout, got %v", attrs[2])
+	}
+	attrs, _ = messageExtractor.OnEnd(nil, context.Background(), testRequest{}, testResponse{}, errors.New("unknown"))
+	if attrs[2].Key != semconv.ErrorTypeKey || attrs[2].Value.AsString() != "_OTHER" {
+		t.Fatalf("error type should be _OTHER, got %v", attrs[2])
+	}
+}
@@ -28,4 +28,7 @@ type MessageAttrsGetter[REQUEST any, RESPONSE any] interface {
 	GetBatchMessageCount(request REQUEST, response RESPONSE) int64
 	GetMessageHeader(request REQUEST, name string) []string
 	GetDestinationPartitionId(request REQUEST) string
+	// GetErrorType returns a low-cardinality error.type of err, such as the
+	// error code of the protocol, or "" when the error is not known.
+	GetErrorType(request REQUEST, response RESPONSE, err error) string
 }
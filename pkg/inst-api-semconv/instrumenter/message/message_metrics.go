@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+// Messaging metrics instrumentation (Stability: development).
+// Spec: https://opentelemetry.io/docs/specs/semconv/messaging/messaging-metrics/
+const (
+	messaging_client_operation_duration = "messaging.client.operation.duration"
+	messaging_client_sent_messages      = "messaging.client.sent.messages"
+	messaging_client_consumed_messages  = "messaging.client.consumed.messages"
+	messaging_process_duration          = "messaging.process.duration"
+)
+
+var messageDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+
+type MessagingMetric struct {
+	key                     attribute.Key
+	operation               MessageOperation
+	clientOperationDuration metric.Float64Histogram
+	clientSentMessages      metric.Int64Counter
+	clientConsumedMessages  metric.Int64Counter
+	processDuration         metric.Float64Histogram
+}
+
+var _ instrumenter.OperationListener = (*MessagingMetric)(nil)
+
+var mu sync.Mutex
+
+var messageMetricsConv = map[attribute.Key]bool{
+	semconv.MessagingSystemKey:                      true,
+	semconv.MessagingOperationNameKey:               true,
+	semconv.MessagingDestinationNameKey:             true,
+	semconv.MessagingDestinationTemplateKey:         true,
+	semconv.MessagingDestinationPartitionIDKey:      true,
+	semconv.MessagingConsumerGroupNameKey:           true,
+	semconv.MessagingDestinationSubscriptionNameKey: true,
+	semconv.ServerAddressKey:                        true,
+	semconv.ServerPortKey:                           true,
+	semconv.ErrorTypeKey:                            true,
+}
+
+var globalMeter metric.Meter
+
+func InitMessageMetrics(m metric.Meter) {
+	mu.Lock()
+	defer mu.Unlock()
+	globalMeter = m
+}
+
+// MessagingMetrics returns the metrics listener of the given messaging operation.
+// Publish operations record messaging.client.operation.duration and
+// messaging.client.sent.messages, receive operations record
+// messaging.client.operation.duration and messaging.client.consumed.messages,
+// process operations record messaging.process.duration and
+// messaging.client.consumed.messages.
+func MessagingMetrics(key string, operation MessageOperation) *MessagingMetric {
+	mu.Lock()
+	defer mu.Unlock()
+	return &MessagingMetric{key: attribute.Key(key), operation: operation}
+}
+
+// for test only
+func newMessagingMetric(key string, operation MessageOperation, meter metric.Meter) (*MessagingMetric, error) {
+	m := &MessagingMetric{
+		key:       attribute.Key(key),
+		operation: operation,
+	}
+	if err := m.initMeasures(meter); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *MessagingMetric) initMeasures(meter metric.Meter) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if meter == nil {
+		return errors.New("nil meter")
+	}
+	var err error
+	switch m.operation {
+	case PROCESS:
+		m.processDuration, err = meter.Float64Histogram(messaging_process_duration,
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of processing operation."),
+			metric.WithExplicitBucketBoundaries(messageDurationBuckets...))
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to create messaging.process.duration histogram, %v", err))
+		}
+	default:
+		m.clientOperationDuration, err = meter.Float64Histogram(messaging_client_operation_duration,
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of messaging operation initiated by a producer or consumer client."),
+			metric.WithExplicitBucketBoundaries(messageDurationBuckets...))
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to create messaging.client.operation.duration histogram, %v", err))
+		}
+	}
+	switch m.operation {
+	case PUBLISH:
+		m.clientSentMessages, err = meter.Int64Counter(messaging_client_sent_messages,
+			metric.WithUnit("{message}"),
+			metric.WithDescription("Number of messages producer attempted to send to the broker."))
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to create messaging.client.sent.messages counter, %v", err))
+		}
+	default:
+		m.clientConsumedMessages, err = meter.Int64Counter(messaging_client_consumed_messages,
+			metric.WithUnit("{message}"),
+			metric.WithDescription("Number of messages that were delivered to the application."))
+		if err != nil {
+			return errors.New(fmt.Sprintf("failed to create messaging.client.consumed.messages counter, %v", err))
+		}
+	}
+	return nil
+}
+
+type messageMetricContext struct {
+	startTime       time.Time
+	startAttributes []attribute.KeyValue
+}
+
+func (m *MessagingMetric) OnBeforeStart(parentContext context.Context, startTime time.Time) context.Context {
+	return parentContext
+}
+
+func (m *MessagingMetric) OnBeforeEnd(ctx context.Context, startAttributes []attribute.KeyValue, startTime time.Time) context.Context {
+	return context.WithValue(ctx, m.key, messageMetricContext{
+		startTime:       startTime,
+		startAttributes: startAttributes,
+	})
+}
+
+func (m *MessagingMetric) OnAfterStart(context context.Context, endTime time.Time) {
+	return
+}
+
+func (m *MessagingMetric) OnAfterEnd(ctx context.Context, endAttributes []attribute.KeyValue, endTime time.Time) {
+	mc, ok := ctx.Value(m.key).(messageMetricContext)
+	if !ok {
+		return
+	}
+	startTime, startAttributes := mc.startTime, mc.startAttributes
+	if m.clientOperationDuration == nil && m.processDuration == nil {
+		// second change to init the metric
+		if err := m.initMeasures(globalMeter); err != nil {
+			log.Printf("failed to create messaging metrics, err is %v\n", err)
+			return
+		}
+	}
+	// end attributes should be shadowed by AttrsShadower
+	endAttributes = append(endAttributes, startAttributes...)
+	messageCount := int64(1)
+	for _, kv := range endAttributes {
+		if kv.Key == semconv.MessagingBatchMessageCountKey && kv.Value.AsInt64() > 0 {
+			messageCount = kv.Value.AsInt64()
+			break
+		}
+	}
+	n, metricsAttrs := utils.Shadow(endAttributes, messageMetricsConv)
+	attrSet := metric.WithAttributeSet(attribute.NewSet(metricsAttrs[0:n]...))
+	duration := endTime.Sub(startTime).Seconds()
+	if m.clientOperationDuration != nil {
+		m.clientOperationDuration.Record(ctx, duration, attrSet)
+	}
+	if m.processDuration != nil {
+		m.processDuration.Record(ctx, duration, attrSet)
+	}
+	if m.clientSentMessages != nil {
+		m.clientSentMessages.Add(ctx, messageCount, attrSet)
+	}
+	if m.clientConsumedMessages != nil {
+		m.clientConsumedMessages.Add(ctx, messageCount, attrSet)
+	}
+}
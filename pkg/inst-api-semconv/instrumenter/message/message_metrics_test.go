@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package message
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func collectMessageMetrics(t *testing.T, listener *MessagingMetric, reader *metric.ManualReader, attrs []attribute.KeyValue) map[string]metricdata.Metrics {
+	ctx := context.Background()
+	start := time.Now()
+	ctx = listener.OnBeforeStart(ctx, start)
+	ctx = listener.OnBeforeEnd(ctx, []attribute.KeyValue{}, start)
+	listener.OnAfterStart(ctx, time.Now())
+	listener.OnAfterEnd(ctx, attrs, time.Now())
+
+	rm := &metricdata.ResourceMetrics{}
+	if err := reader.Collect(ctx, rm); err != nil {
+		t.Fatal(err)
+	}
+	if len(rm.ScopeMetrics) <= 0 || len(rm.ScopeMetrics[0].Metrics) <= 0 {
+		t.Fatal("no metrics collected")
+	}
+	metrics := map[string]metricdata.Metrics{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+	return metrics
+}
+
+func newTestReader() (*metric.ManualReader, *metric.MeterProvider) {
+	reader := metric.NewManualReader()
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("messaging-service"),
+		semconv.ServiceVersion("v1.0.0"),
+	)
+	return reader, metric.NewMeterProvider(metric.WithReader(reader), metric.WithResource(res))
+}
+
+func TestPublishMetrics(t *testing.T) {
+	reader, mp := newTestReader()
+	listener, err := newMessagingMetric("test", PUBLISH, mp.Meter("test-meter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := collectMessageMetrics(t, listener, reader, []attribute.KeyValue{
+		semconv.MessagingSystemKafka,
+		semconv.MessagingOperationName("publish"),
+		semconv.MessagingDestinationName("topic"),
+		semconv.MessagingBatchMessageCount(3),
+	})
+	if _, ok := metrics[messaging_client_operation_duration]; !ok {
+		t.Fatal("expected messaging.client.operation.duration")
+	}
+	sent, ok := metrics[messaging_client_sent_messages]
+	if !ok {
+		t.Fatal("expected messaging.client.sent.messages")
+	}
+	if v := sent.Data.(metricdata.Sum[int64]).DataPoints[0].Value; v != 3 {
+		t.Fatalf("expected 3 sent messages, got %d", v)
+	}
+	if _, ok := metrics[messaging_client_consumed_messages]; ok {
+		t.Fatal("unexpected messaging.client.consumed.messages for publish")
+	}
+}
+
+func TestProcessMetrics(t *testing.T) {
+	reader, mp := newTestReader()
+	listener, err := newMessagingMetric("test", PROCESS, mp.Meter("test-meter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := collectMessageMetrics(t, listener, reader, []attribute.KeyValue{
+		semconv.MessagingSystemRocketmq,
+		semconv.MessagingOperationName("process"),
+	})
+	if _, ok := metrics[messaging_process_duration]; !ok {
+		t.Fatal("expected messaging.process.duration")
+	}
+	if _, ok := metrics[messaging_client_operation_duration]; ok {
+		t.Fatal("unexpected messaging.client.operation.duration for process")
+	}
+	consumed, ok := metrics[messaging_client_consumed_messages]
+	if !ok {
+		t.Fatal("expected messaging.client.consumed.messages")
+	}
+	if v := consumed.Data.(metricdata.Sum[int64]).DataPoints[0].Value; v != 1 {
+		t.Fatalf("expected 1 consumed message, got %d", v)
+	}
+}
+
+func TestLazyReceiveMetrics(t *testing.T) {
+	reader, mp := newTestReader()
+	InitMessageMetrics(mp.Meter("test-meter"))
+	listener := MessagingMetrics("messaging.metric", RECEIVE)
+	metrics := collectMessageMetrics(t, listener, reader, []attribute.KeyValue{
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingOperationName("receive"),
+	})
+	if _, ok := metrics[messaging_client_operation_duration]; !ok {
+		t.Fatal("expected messaging.client.operation.duration")
+	}
+	if _, ok := metrics[messaging_client_consumed_messages]; !ok {
+		t.Fatal("expected messaging.client.consumed.messages")
+	}
+}
+
+func TestMessageNilMeter(t *testing.T) {
+	_, err := newMessagingMetric("test", PUBLISH, nil)
+	if err == nil {
+		t.Fatal("expected error on nil meter")
+	}
+}
+
+func TestMessageAttrShadower(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		semconv.MessagingSystemKafka,
+		semconv.MessagingDestinationName("topic"),
+		semconv.MessagingMessageID("id"),
+		semconv.MessagingOperationName("publish"),
+	}
+	n, shadowed := utils.Shadow(attrs, messageMetricsConv)
+	if n != 3 {
+		t.Fatalf("expected 3 valid metric attributes, got %d", n)
+	}
+	if shadowed[n].Key != semconv.MessagingMessageIDKey {
+		t.Fatal("unexpected attribute shadowing order")
+	}
+}
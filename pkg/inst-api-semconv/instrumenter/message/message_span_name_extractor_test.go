@@ -95,6 +95,10 @@ func (t testGetter) GetDestinationPartitionId(request testRequest) string {
 	return "partition-id"
 }
 
+func (t testGetter) GetErrorType(request testRequest, response testResponse, err error) string {
+	return ""
+}
+
 func TestExtractSpanName(t *testing.T) {
 	r := MessageSpanNameExtractor[testRequest, testResponse]{Getter: testGetter{}}
 	spanName := r.Extract(testRequest{IsTemporaryDestination: true, Destination: "Destination"})
@@ -62,6 +62,20 @@ func (r *RpcAttrsExtractor[REQUEST, RESPONSE, GETTER]) OnEnd(attributes []attrib
 			})
 		}
 	}
+	if getter, ok := any(r.Getter).(RpcErrorCodeGetter[REQUEST, RESPONSE]); ok {
+		if code := getter.GetErrorCode(request, response, err); code != "" {
+			attributes = append(attributes, attribute.KeyValue{
+				Key:   semconv.ErrorTypeKey,
+				Value: attribute.StringValue(code),
+			})
+			if keyGetter, ok := any(r.Getter).(RpcErrorCodeKeyGetter[REQUEST]); ok {
+				attributes = append(attributes, attribute.KeyValue{
+					Key:   keyGetter.GetErrorCodeKey(request),
+					Value: attribute.StringValue(code),
+				})
+			}
+		}
+	}
 	return attributes, context
 }
 
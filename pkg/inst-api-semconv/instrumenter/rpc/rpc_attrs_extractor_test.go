@@ -16,6 +16,7 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
@@ -157,3 +158,66 @@ func TestGrpcClientExtractorEndSuccess(t *testing.T) {
 		log.Fatal("Expected status code 0 (OK)")
 	}
 }
+
+type connectAttrsGetter struct {
+	rpcAttrsGetter
+}
+
+func (h connectAttrsGetter) GetSystem(request testRequest) string {
+	return "connect_rpc"
+}
+
+func (h connectAttrsGetter) GetErrorCode(request testRequest, response testResponse, err error) string {
+	if err == nil {
+		return ""
+	}
+	return "not_found"
+}
+
+func (h connectAttrsGetter) GetErrorCodeKey(request testRequest) attribute.Key {
+	return semconv.RPCConnectRPCErrorCodeKey
+}
+
+type twirpAttrsGetter struct {
+	rpcAttrsGetter
+}
+
+func (h twirpAttrsGetter) GetSystem(request testRequest) string {
+	return "twirp"
+}
+
+func (h twirpAttrsGetter) GetErrorCode(request testRequest, response testResponse, err error) string {
+	if err == nil {
+		return ""
+	}
+	return "not_found"
+}
+
+func TestRpcExtractorEndWithErrorCodeOnly(t *testing.T) {
+	rpcExtractor := ServerRpcAttrsExtractor[testRequest, testResponse, twirpAttrsGetter]{}
+	attrs, _ := rpcExtractor.OnEnd(nil, context.Background(), testRequest{}, testResponse{}, errors.New("not found"))
+	if len(attrs) != 1 {
+		t.Fatalf("expected 1 attribute, got %d", len(attrs))
+	}
+	if attrs[0].Key != semconv.ErrorTypeKey || attrs[0].Value.AsString() != "not_found" {
+		t.Fatal("error type should be not_found")
+	}
+}
+
+func TestRpcExtractorEndWithErrorCode(t *testing.T) {
+	rpcExtractor := ClientRpcAttrsExtractor[testRequest, testResponse, connectAttrsGetter]{}
+	attrs, _ := rpcExtractor.OnEnd(nil, context.Background(), testRequest{}, testResponse{}, errors.New("not found"))
+	if len(attrs) != 2 {
+		t.Fatalf("expected 2 attributes, got %d", len(attrs))
+	}
+	if attrs[0].Key != semconv.ErrorTypeKey || attrs[0].Value.AsString() != "not_found" {
+		t.Fatal("error type should be not_found")
+	}
+	if attrs[1].Key != semconv.RPCConnectRPCErrorCodeKey || attrs[1].Value.AsString() != "not_found" {
+		t.Fatal("connect error code should be not_found")
+	}
+	attrs, _ = rpcExtractor.OnEnd(nil, context.Background(), testRequest{}, testResponse{}, nil)
+	if len(attrs) != 0 {
+		t.Fatal("attrs should be empty without error")
+	}
+}
@@ -14,9 +14,24 @@
 
 package rpc
 
+import "go.opentelemetry.io/otel/attribute"
+
 type RpcAttrsGetter[REQUEST any] interface {
 	GetSystem(request REQUEST) string
 	GetService(request REQUEST) string
 	GetMethod(request REQUEST) string
 	GetServerAddress(request REQUEST) string
 }
+
+// RpcErrorCodeGetter is optionally implemented by the getters of the rpc
+// systems whose errors carry a code, such as Connect and Twirp.
+type RpcErrorCodeGetter[REQUEST any, RESPONSE any] interface {
+	GetErrorCode(request REQUEST, response RESPONSE, err error) string
+}
+
+// RpcErrorCodeKeyGetter is optionally implemented next to RpcErrorCodeGetter
+// by the getters of the rpc systems that also record the error code under an
+// attribute of their own, such as rpc.connect_rpc.error_code.
+type RpcErrorCodeKeyGetter[REQUEST any] interface {
+	GetErrorCodeKey(request REQUEST) attribute.Key
+}
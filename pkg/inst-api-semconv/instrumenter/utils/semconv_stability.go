@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv20 "go.opentelemetry.io/otel/semconv/v1.20.0"
+	semconv24 "go.opentelemetry.io/otel/semconv/v1.24.0"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+// EnvSemconvStabilityOptIn is a comma-separated list of the semantic
+// convention domains to opt in, e.g. `http/dup,database`. `<domain>` selects
+// the stable attributes and `<domain>/dup` both the old and the stable ones.
+// ref: https://opentelemetry.io/docs/specs/semconv/http/migration-guide/
+const EnvSemconvStabilityOptIn = "OTEL_SEMCONV_STABILITY_OPT_IN"
+
+type SemconvDomain string
+
+const (
+	HttpSemconv      SemconvDomain = "http"
+	DatabaseSemconv  SemconvDomain = "database"
+	MessagingSemconv SemconvDomain = "messaging"
+)
+
+type StabilityMode int
+
+const (
+	// StabilityModeStable emits the stable attributes only. The extractors
+	// already emit the stable conventions, so this is also the default when
+	// the domain is not opted in.
+	StabilityModeStable StabilityMode = iota
+	// StabilityModeDup emits both the old and the stable attributes.
+	StabilityModeDup
+)
+
+var stabilityModes = parseStabilityOptIn(os.Getenv(EnvSemconvStabilityOptIn))
+
+// HttpClientOldConv maps the stable HTTP client attributes to the ones of
+// semconv v1.20.0.
+var HttpClientOldConv = map[attribute.Key]attribute.Key{
+	semconv.HTTPRequestMethodKey:      semconv20.HTTPMethodKey,
+	semconv.HTTPResponseStatusCodeKey: semconv20.HTTPStatusCodeKey,
+	semconv.URLFullKey:                semconv20.HTTPURLKey,
+	semconv.ServerAddressKey:          semconv20.NetPeerNameKey,
+	semconv.ServerPortKey:             semconv20.NetPeerPortKey,
+	semconv.NetworkPeerAddressKey:     semconv20.NetSockPeerAddrKey,
+	semconv.NetworkPeerPortKey:        semconv20.NetSockPeerPortKey,
+	semconv.NetworkProtocolNameKey:    semconv20.NetProtocolNameKey,
+	semconv.NetworkProtocolVersionKey: semconv20.NetProtocolVersionKey,
+}
+
+// HttpServerOldConv maps the stable HTTP server attributes to the ones of
+// semconv v1.20.0. http.target is composed of url.path and url.query by the
+// server extractor.
+var HttpServerOldConv = map[attribute.Key]attribute.Key{
+	semconv.HTTPRequestMethodKey:      semconv20.HTTPMethodKey,
+	semconv.HTTPResponseStatusCodeKey: semconv20.HTTPStatusCodeKey,
+	semconv.URLSchemeKey:              semconv20.HTTPSchemeKey,
+	semconv.ServerAddressKey:          semconv20.NetHostNameKey,
+	semconv.ServerPortKey:             semconv20.NetHostPortKey,
+	semconv.ClientAddressKey:          semconv20.HTTPClientIPKey,
+	semconv.NetworkPeerAddressKey:     semconv20.NetSockPeerAddrKey,
+	semconv.NetworkPeerPortKey:        semconv20.NetSockPeerPortKey,
+	semconv.NetworkLocalAddressKey:    semconv20.NetSockHostAddrKey,
+	semconv.NetworkLocalPortKey:       semconv20.NetSockHostPortKey,
+	semconv.NetworkProtocolNameKey:    semconv20.NetProtocolNameKey,
+	semconv.NetworkProtocolVersionKey: semconv20.NetProtocolVersionKey,
+}
+
+// DbOldConv maps the stable database attributes to the ones of semconv v1.24.0.
+// db.collection.name had a key per system then, see DbOldConvOf.
+var DbOldConv = map[attribute.Key]attribute.Key{
+	semconv.DBSystemNameKey:    semconv24.DBSystemKey,
+	semconv.DBQueryTextKey:     semconv24.DBStatementKey,
+	semconv.DBOperationNameKey: semconv24.DBOperationKey,
+	semconv.DBNamespaceKey:     semconv24.DBNameKey,
+}
+
+// dbCollectionOldKeys holds the key of db.collection.name in semconv v1.24.0
+// by db.system.name, the systems not listed have none.
+var dbCollectionOldKeys = map[string]attribute.Key{
+	"mongodb":   semconv24.DBMongoDBCollectionKey,
+	"cassandra": semconv24.DBCassandraTableKey,
+	"cosmosdb":  semconv24.DBCosmosDBContainerKey,
+}
+
+// sqlSystems are the relational db.system.name values, their collections
+// are tables and were reported as db.sql.table. database/sql instrumentations
+// may report the driver name when they don't know the system.
+var sqlSystems = []string{
+	"mysql", "mariadb", "tidb", "postgresql", "postgres", "pgx", "microsoft.sql_server",
+	"mssql", "sqlserver", "clickhouse", "sqlite", "sqlite3", "oracle.db", "oracle",
+	"db2", "h2", "hsqldb", "derby", "other_sql",
+}
+
+var dbOldConvs = func() map[string]map[attribute.Key]attribute.Key {
+	convs := map[string]map[attribute.Key]attribute.Key{}
+	for _, system := range sqlSystems {
+		dbCollectionOldKeys[system] = semconv24.DBSQLTableKey
+	}
+	for system, key := range dbCollectionOldKeys {
+		conv := map[attribute.Key]attribute.Key{semconv.DBCollectionNameKey: key}
+		for k, v := range DbOldConv {
+			conv[k] = v
+		}
+		convs[system] = conv
+	}
+	return convs
+}()
+
+// DbOldConvOf returns the conversion of the database attributes of the given
+// db.system.name, including db.collection.name for the systems which had an
+// old key for it.
+func DbOldConvOf(system string) map[attribute.Key]attribute.Key {
+	if conv, ok := dbOldConvs[system]; ok {
+		return conv
+	}
+	return DbOldConv
+}
+
+// MessagingOldConv maps the messaging attributes to the ones of semconv v1.24.0.
+var MessagingOldConv = map[attribute.Key]attribute.Key{
+	semconv.MessagingOperationNameKey: semconv24.MessagingOperationKey,
+	semconv.MessagingClientIDKey:      semconv24.MessagingClientIDKey,
+}
+
+// KafkaOldConv maps the Kafka specific attributes, on top of the messaging
+// ones, to the ones of semconv v1.24.0.
+var KafkaOldConv = map[attribute.Key]attribute.Key{
+	semconv.MessagingOperationNameKey:     semconv24.MessagingOperationKey,
+	semconv.MessagingClientIDKey:          semconv24.MessagingClientIDKey,
+	semconv.MessagingKafkaOffsetKey:       semconv24.MessagingKafkaMessageOffsetKey,
+	semconv.MessagingConsumerGroupNameKey: semconv24.MessagingKafkaConsumerGroupKey,
+}
+
+func parseStabilityOptIn(value string) map[SemconvDomain]StabilityMode {
+	modes := map[SemconvDomain]StabilityMode{}
+	for _, opt := range strings.Split(value, ",") {
+		opt = strings.ToLower(strings.TrimSpace(opt))
+		mode := StabilityModeStable
+		domain, variant, found := strings.Cut(opt, "/")
+		switch {
+		case !found:
+		case variant == "dup":
+			mode = StabilityModeDup
+		default:
+			continue
+		}
+		switch SemconvDomain(domain) {
+		case HttpSemconv, DatabaseSemconv, MessagingSemconv:
+		default:
+			continue
+		}
+		// `http/dup` takes precedence over `http`
+		if modes[SemconvDomain(domain)] == StabilityModeDup {
+			continue
+		}
+		modes[SemconvDomain(domain)] = mode
+	}
+	return modes
+}
+
+// GetStabilityMode returns the stability mode of the domain configured by
+// OTEL_SEMCONV_STABILITY_OPT_IN.
+func GetStabilityMode(domain SemconvDomain) StabilityMode {
+	return stabilityModes[domain]
+}
+
+// EmitOldSemconv reports whether the old attributes of the domain should be emitted.
+func EmitOldSemconv(domain SemconvDomain) bool {
+	return GetStabilityMode(domain) == StabilityModeDup
+}
+
+// DupAttributes appends the old attributes converted from the stable ones in
+// attrs[from:] according to conv when the domain is in the dup mode.
+// Extractors call it once on the attributes they appended so that every
+// instrumentation emits the same attribute set from the same code path.
+func DupAttributes(domain SemconvDomain, conv map[attribute.Key]attribute.Key, attrs []attribute.KeyValue, from int) []attribute.KeyValue {
+	if from < 0 || from > len(attrs) {
+		return attrs
+	}
+	if GetStabilityMode(domain) != StabilityModeDup {
+		return attrs
+	}
+	end := len(attrs)
+	for i := from; i < end; i++ {
+		if old, ok := conv[attrs[i].Key]; ok {
+			attrs = append(attrs, attribute.KeyValue{Key: old, Value: attrs[i].Value})
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func TestParseStabilityOptIn(t *testing.T) {
+	modes := parseStabilityOptIn(" http/dup, database ,messaging,unknown/dup")
+	if modes[HttpSemconv] != StabilityModeDup {
+		t.Fatal("expected http to be dup")
+	}
+	if m, ok := modes[DatabaseSemconv]; !ok || m != StabilityModeStable {
+		t.Fatal("expected database to be stable")
+	}
+	if _, ok := modes[MessagingSemconv]; !ok {
+		t.Fatal("expected messaging to be opted in")
+	}
+	if len(modes) != 3 {
+		t.Fatalf("unexpected modes %v", modes)
+	}
+	if parseStabilityOptIn("database,database/dup")[DatabaseSemconv] != StabilityModeDup {
+		t.Fatal("expected database/dup to take precedence")
+	}
+	if parseStabilityOptIn("http/dup,http")[HttpSemconv] != StabilityModeDup {
+		t.Fatal("expected http/dup to take precedence in any order")
+	}
+	if len(parseStabilityOptIn("http/old,database/new,messaging/")) != 0 {
+		t.Fatal("expected the unknown variants to be ignored")
+	}
+	if len(parseStabilityOptIn("")) != 0 {
+		t.Fatal("expected no domain to be opted in")
+	}
+}
+
+func TestDupAttributes(t *testing.T) {
+	defer func(old map[SemconvDomain]StabilityMode) { stabilityModes = old }(stabilityModes)
+	attrs := []attribute.KeyValue{
+		attribute.String("before", "extractor"),
+		semconv.DBSystemNameMySQL,
+		semconv.DBQueryText("SELECT 1"),
+		semconv.ServerAddress("localhost"),
+	}
+
+	stabilityModes = parseStabilityOptIn("database")
+	if actual := DupAttributes(DatabaseSemconv, DbOldConv, attrs, 1); len(actual) != len(attrs) {
+		t.Fatalf("expected no old attributes, got %v", actual)
+	}
+
+	stabilityModes = parseStabilityOptIn("database/dup")
+	actual := DupAttributes(DatabaseSemconv, DbOldConv, attrs, 1)
+	if len(actual) != len(attrs)+2 {
+		t.Fatalf("expected 2 old attributes, got %v", actual)
+	}
+	if actual[4].Key != "db.system" || actual[4].Value.AsString() != "mysql" {
+		t.Fatalf("unexpected old attribute %v", actual[4])
+	}
+	if actual[5].Key != "db.statement" || actual[5].Value.AsString() != "SELECT 1" {
+		t.Fatalf("unexpected old attribute %v", actual[5])
+	}
+	if EmitOldSemconv(HttpSemconv) {
+		t.Fatal("expected http not to emit old attributes")
+	}
+}
+
+func TestDupAttributesOfDomains(t *testing.T) {
+	defer func(old map[SemconvDomain]StabilityMode) { stabilityModes = old }(stabilityModes)
+	cases := []struct {
+		domain   SemconvDomain
+		conv     map[attribute.Key]attribute.Key
+		attrs    []attribute.KeyValue
+		expected []attribute.KeyValue
+	}{
+		{
+			domain: HttpSemconv,
+			conv:   HttpClientOldConv,
+			attrs: []attribute.KeyValue{
+				semconv.HTTPRequestMethodGet,
+				semconv.HTTPResponseStatusCode(200),
+				semconv.URLFull("http://localhost/a"),
+				semconv.HTTPRequestResendCount(1),
+			},
+			expected: []attribute.KeyValue{
+				attribute.String("http.method", "GET"),
+				attribute.Int("http.status_code", 200),
+				attribute.String("http.url", "http://localhost/a"),
+			},
+		},
+		{
+			domain: DatabaseSemconv,
+			conv:   DbOldConvOf("mysql"),
+			attrs: []attribute.KeyValue{
+				semconv.DBSystemNameMySQL,
+				semconv.DBQueryText("SELECT * FROM users"),
+				semconv.DBCollectionName("users"),
+				semconv.DBQuerySummary("SELECT users"),
+			},
+			expected: []attribute.KeyValue{
+				attribute.String("db.system", "mysql"),
+				attribute.String("db.statement", "SELECT * FROM users"),
+				attribute.String("db.sql.table", "users"),
+			},
+		},
+		{
+			domain: MessagingSemconv,
+			conv:   KafkaOldConv,
+			attrs: []attribute.KeyValue{
+				semconv.MessagingSystemKafka,
+				semconv.MessagingOperationName("process"),
+				semconv.MessagingKafkaOffset(42),
+				semconv.MessagingConsumerGroupName("group"),
+			},
+			expected: []attribute.KeyValue{
+				attribute.String("messaging.operation", "process"),
+				attribute.Int("messaging.kafka.message.offset", 42),
+				attribute.String("messaging.kafka.consumer.group", "group"),
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(string(c.domain), func(t *testing.T) {
+			stabilityModes = parseStabilityOptIn(string(c.domain) + "/dup")
+			before := attribute.String("before", "extractor")
+			actual := DupAttributes(c.domain, c.conv, append([]attribute.KeyValue{before}, c.attrs...), 1)
+			expected := append(append([]attribute.KeyValue{before}, c.attrs...), c.expected...)
+			if len(actual) != len(expected) {
+				t.Fatalf("expected %v, got %v", expected, actual)
+			}
+			for i := range expected {
+				if actual[i] != expected[i] {
+					t.Fatalf("expected %v, got %v", expected[i], actual[i])
+				}
+			}
+			// the other domains are left alone
+			for _, other := range []SemconvDomain{HttpSemconv, DatabaseSemconv, MessagingSemconv} {
+				if other != c.domain && EmitOldSemconv(other) {
+					t.Fatalf("expected %s not to emit old attributes", other)
+				}
+			}
+		})
+	}
+}
+
+func TestDbOldConvOf(t *testing.T) {
+	cases := map[string]attribute.Key{
+		"mysql":                "db.sql.table",
+		"postgresql":           "db.sql.table",
+		"microsoft.sql_server": "db.sql.table",
+		"mongodb":              "db.mongodb.collection",
+		"cassandra":            "db.cassandra.table",
+		"redis":                "",
+		"elasticsearch":        "",
+		"":                     "",
+	}
+	for system, expected := range cases {
+		conv := DbOldConvOf(system)
+		if conv[semconv.DBCollectionNameKey] != expected {
+			t.Errorf("expected %q for %q, got %q", expected, system, conv[semconv.DBCollectionNameKey])
+		}
+		if conv[semconv.DBQueryTextKey] != "db.statement" {
+			t.Errorf("expected db.statement for %q", system)
+		}
+	}
+}
@@ -107,6 +107,7 @@ func (i *InternalInstrumenter[REQUEST, RESPONSE]) doStart(parentContext context.
 	spanKind := i.spanKindExtractor.Extract(request)
 	options = append(options, trace.WithSpanKind(spanKind), trace.WithTimestamp(timestamp))
 	newCtx, span := i.tracer.Start(parentContext, spanName, options...)
+	newCtx = setPprofLabels(parentContext, newCtx, spanKind, spanName, span)
 	attrs := make([]attribute.KeyValue, 0, 20)
 	// extract span attrs
 	for _, extractor := range i.attributesExtractors {
@@ -149,6 +150,7 @@ func (i *InternalInstrumenter[REQUEST, RESPONSE]) doEnd(ctx context.Context, req
 	span.SetAttributes(attrs...)
 	options = append(options, trace.WithTimestamp(timestamp))
 	span.End(options...)
+	restorePprofLabels(ctx, span)
 	for _, listener := range i.operationListeners {
 		listener.OnAfterEnd(ctx, attrs, timestamp)
 	}
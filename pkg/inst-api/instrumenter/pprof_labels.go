@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumenter
+
+import (
+	"context"
+	"os"
+	"runtime/pprof"
+	_ "unsafe"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTEL_INSTRUMENTATION_EXPERIMENTAL_PPROF_LABELS_ENABLE labels the goroutines
+// serving a request with its trace, so that the samples of the profiles can
+// be filtered by trace or endpoint.
+const OTEL_INSTRUMENTATION_EXPERIMENTAL_PPROF_LABELS_ENABLE = "OTEL_INSTRUMENTATION_EXPERIMENTAL_PPROF_LABELS_ENABLE"
+
+const (
+	pprofLabelTraceId  = "trace_id"
+	pprofLabelSpanId   = "span_id"
+	pprofLabelSpanName = "span_name"
+)
+
+var pprofLabelsEnabled = os.Getenv(OTEL_INSTRUMENTATION_EXPERIMENTAL_PPROF_LABELS_ENABLE) == "true"
+
+type pprofLabelsKey struct{}
+
+// pprofLabels remembers the labeled span, the goroutine it labeled and the
+// context holding the labels of the goroutine before it.
+type pprofLabels struct {
+	spanId        trace.SpanID
+	goroutineId   uint64
+	parentContext context.Context
+}
+
+// otel_get_goroutine_id returns the id of the current goroutine, it is
+// provided by the instrumented runtime like the goroutine local storage.
+//
+//go:linkname otel_get_goroutine_id otel_get_goroutine_id
+var otel_get_goroutine_id func() uint64
+
+// setPprofLabels labels the goroutine with the local root span, the server
+// and consumer spans without a parent in the process. The runtime copies the
+// labels into the goroutines started from then on, as it does for the trace
+// context kept in GLS, so their samples carry the labels too. The goroutines
+// are not labeled without the instrumented runtime, which tells the goroutine
+// ending the span.
+func setPprofLabels(parentContext, ctx context.Context, spanKind trace.SpanKind, spanName string, span trace.Span) context.Context {
+	if !pprofLabelsEnabled || otel_get_goroutine_id == nil ||
+		(spanKind != trace.SpanKindServer && spanKind != trace.SpanKindConsumer) {
+		return ctx
+	}
+	if parent := trace.SpanContextFromContext(parentContext); parent.IsValid() && !parent.IsRemote() {
+		return ctx
+	}
+	spanContext := span.SpanContext()
+	if !spanContext.IsValid() {
+		return ctx
+	}
+	labeled := pprof.WithLabels(ctx, pprof.Labels(
+		pprofLabelTraceId, spanContext.TraceID().String(),
+		pprofLabelSpanId, spanContext.SpanID().String(),
+		pprofLabelSpanName, spanName,
+	))
+	pprof.SetGoroutineLabels(labeled)
+	return context.WithValue(labeled, pprofLabelsKey{}, &pprofLabels{
+		spanId:        spanContext.SpanID(),
+		goroutineId:   otel_get_goroutine_id(),
+		parentContext: parentContext,
+	})
+}
+
+// restorePprofLabels gives the goroutine back the labels it had before the
+// span was started, ending the child spans leaves the labels as they are.
+// The span ended by another goroutine, e.g. by the callback of an async
+// server, leaves them too, they belong to a goroutine unrelated to the span.
+func restorePprofLabels(ctx context.Context, span trace.Span) {
+	labels, ok := ctx.Value(pprofLabelsKey{}).(*pprofLabels)
+	if !ok || labels.spanId != span.SpanContext().SpanID() {
+		return
+	}
+	if labels.goroutineId != otel_get_goroutine_id() {
+		return
+	}
+	pprof.SetGoroutineLabels(labels.parentContext)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumenter
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func buildPprofTestInstrumenter(spanKindExtractor SpanKindExtractor[testRequest]) Instrumenter[testRequest, testResponse] {
+	builder := Builder[testRequest, testResponse]{}
+	builder.Init().
+		SetSpanNameExtractor(testNameExtractor{}).
+		SetSpanKindExtractor(spanKindExtractor)
+	tracer := sdktrace.NewTracerProvider().Tracer("test-tracer")
+	return builder.BuildInstrumenterWithTracer(tracer)
+}
+
+// useTestGoroutineId stands in for the instrumented runtime, reading the id
+// of the goroutine from the header of its stack trace.
+func useTestGoroutineId(t *testing.T) {
+	otel_get_goroutine_id = func() uint64 {
+		var buf [64]byte
+		stack := strings.TrimPrefix(string(buf[:runtime.Stack(buf[:], false)]), "goroutine ")
+		id, _ := strconv.ParseUint(stack[:strings.IndexByte(stack, ' ')], 10, 64)
+		return id
+	}
+	t.Cleanup(func() { otel_get_goroutine_id = nil })
+}
+
+// goroutineLabels returns the goroutine profile, which lists the labels of
+// the goroutines.
+func goroutineLabels(t *testing.T) string {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestPprofLabelsOfServerSpan(t *testing.T) {
+	useTestGoroutineId(t)
+	pprofLabelsEnabled = true
+	defer func() { pprofLabelsEnabled = false }()
+	instrumenter := buildPprofTestInstrumenter(&AlwaysServerExtractor[testRequest]{})
+	ctx := instrumenter.Start(context.Background(), testRequest{})
+	spanContext := trace.SpanContextFromContext(ctx)
+	if value, _ := pprof.Label(ctx, pprofLabelTraceId); value != spanContext.TraceID().String() {
+		t.Fatalf("unexpected trace_id label %q", value)
+	}
+	if value, _ := pprof.Label(ctx, pprofLabelSpanName); value != "test" {
+		t.Fatalf("unexpected span_name label %q", value)
+	}
+
+	// the goroutines started by the request inherit its labels
+	spanId := spanContext.SpanID().String()
+	started, done := make(chan struct{}), make(chan struct{})
+	defer close(done)
+	go func() {
+		close(started)
+		<-done
+	}()
+	<-started
+	if count := strings.Count(goroutineLabels(t), spanId); count != 2 {
+		t.Fatalf("expected the labels on the request and its goroutine, got %d", count)
+	}
+
+	// a child span neither relabels nor restores the goroutine
+	childCtx := instrumenter.Start(ctx, testRequest{})
+	if value, _ := pprof.Label(childCtx, pprofLabelSpanId); value != spanId {
+		t.Fatalf("unexpected span_id label %q of the child span", value)
+	}
+	instrumenter.End(childCtx, testRequest{}, testResponse{}, nil)
+	if count := strings.Count(goroutineLabels(t), spanId); count != 2 {
+		t.Fatalf("expected the labels to be kept until the server span ends, got %d", count)
+	}
+
+	// only the goroutine started by the request keeps them afterwards
+	instrumenter.End(ctx, testRequest{}, testResponse{}, nil)
+	if count := strings.Count(goroutineLabels(t), spanId); count != 1 {
+		t.Fatalf("expected the labels to be restored when the server span ends, got %d", count)
+	}
+}
+
+func TestPprofLabelsOfSpanEndedOnAnotherGoroutine(t *testing.T) {
+	useTestGoroutineId(t)
+	pprofLabelsEnabled = true
+	defer func() { pprofLabelsEnabled = false }()
+	instrumenter := buildPprofTestInstrumenter(&AlwaysServerExtractor[testRequest]{})
+	ctx := instrumenter.Start(context.Background(), testRequest{})
+	defer pprof.SetGoroutineLabels(context.Background())
+	spanId := trace.SpanContextFromContext(ctx).SpanID().String()
+
+	// the goroutine ending the span has labels of its own, which are kept
+	otherCtx := pprof.WithLabels(context.Background(), pprof.Labels("other", "labels"))
+	ended, done := make(chan struct{}), make(chan struct{})
+	defer close(done)
+	go func() {
+		pprof.SetGoroutineLabels(otherCtx)
+		instrumenter.End(ctx, testRequest{}, testResponse{}, nil)
+		close(ended)
+		<-done
+	}()
+	<-ended
+	profile := goroutineLabels(t)
+	if !strings.Contains(profile, `"other":"labels"`) {
+		t.Fatal("expected the labels of the goroutine ending the span to be kept")
+	}
+	if count := strings.Count(profile, spanId); count != 1 {
+		t.Fatalf("expected the labels of the starting goroutine to be kept, got %d", count)
+	}
+}
+
+func TestPprofLabelsOfClientSpan(t *testing.T) {
+	useTestGoroutineId(t)
+	pprofLabelsEnabled = true
+	defer func() { pprofLabelsEnabled = false }()
+	instrumenter := buildPprofTestInstrumenter(&AlwaysClientExtractor[testRequest]{})
+	ctx := instrumenter.Start(context.Background(), testRequest{})
+	defer instrumenter.End(ctx, testRequest{}, testResponse{}, nil)
+	if _, ok := pprof.Label(ctx, pprofLabelTraceId); ok {
+		t.Fatal("expected no labels for a client span")
+	}
+}
+
+func TestPprofLabelsDisabled(t *testing.T) {
+	instrumenter := buildPprofTestInstrumenter(&AlwaysServerExtractor[testRequest]{})
+	ctx := instrumenter.Start(context.Background(), testRequest{})
+	defer instrumenter.End(ctx, testRequest{}, testResponse{}, nil)
+	if _, ok := pprof.Label(ctx, pprofLabelTraceId); ok {
+		t.Fatal("expected no labels when disabled")
+	}
+}
+
+func TestPprofLabelsWithoutInstrumentedRuntime(t *testing.T) {
+	pprofLabelsEnabled = true
+	defer func() { pprofLabelsEnabled = false }()
+	instrumenter := buildPprofTestInstrumenter(&AlwaysServerExtractor[testRequest]{})
+	ctx := instrumenter.Start(context.Background(), testRequest{})
+	defer instrumenter.End(ctx, testRequest{}, testResponse{}, nil)
+	if _, ok := pprof.Label(ctx, pprofLabelTraceId); ok {
+		t.Fatal("expected no labels without the goroutine id")
+	}
+}
@@ -63,6 +63,20 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: HTTP_CLIENT_KEY,
 		ServerKey: HTTP_SERVER_KEY,
 	},
+	// the logical requests of resty and retryablehttp are internal spans
+	// wrapping the net/http client spans, so they never suppress them
+	"loongsuite.instrumentation.resty": {
+		ScopeName: "loongsuite.instrumentation.resty",
+		Category:  CategoryHTTP,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.retryablehttp": {
+		ScopeName: "loongsuite.instrumentation.retryablehttp",
+		Category:  CategoryHTTP,
+		ClientKey: "",
+		ServerKey: "",
+	},
 	"loongsuite.instrumentation.elasticsearch": {
 		ScopeName: "loongsuite.instrumentation.elasticsearch",
 		Category:  CategoryHTTP,
@@ -101,6 +115,12 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: RPC_CLIENT_KEY,
 		ServerKey: RPC_SERVER_KEY,
 	},
+	"loongsuite.instrumentation.aws-sdk": {
+		ScopeName: "loongsuite.instrumentation.aws-sdk",
+		Category:  CategoryRPC,
+		ClientKey: RPC_CLIENT_KEY,
+		ServerKey: "",
+	},
 	"loongsuite.instrumentation.dubbo": {
 		ScopeName: "loongsuite.instrumentation.dubbo",
 		Category:  CategoryRPC,
@@ -119,6 +139,24 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: RPC_CLIENT_KEY,
 		ServerKey: RPC_SERVER_KEY,
 	},
+	"loongsuite.instrumentation.connect": {
+		ScopeName: "loongsuite.instrumentation.connect",
+		Category:  CategoryRPC,
+		ClientKey: RPC_CLIENT_KEY,
+		ServerKey: RPC_SERVER_KEY,
+	},
+	"loongsuite.instrumentation.twirp": {
+		ScopeName: "loongsuite.instrumentation.twirp",
+		Category:  CategoryRPC,
+		ClientKey: RPC_CLIENT_KEY,
+		ServerKey: RPC_SERVER_KEY,
+	},
+	"loongsuite.instrumentation.thrift": {
+		ScopeName: "loongsuite.instrumentation.thrift",
+		Category:  CategoryRPC,
+		ClientKey: RPC_CLIENT_KEY,
+		ServerKey: RPC_SERVER_KEY,
+	},
 
 	// Database
 	"loongsuite.instrumentation.databasesql": {
@@ -175,6 +213,50 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: DB_CLIENT_KEY,
 		ServerKey: "",
 	},
+	"loongsuite.instrumentation.pgx": {
+		ScopeName: "loongsuite.instrumentation.pgx",
+		Category:  CategoryDB,
+		ClientKey: DB_CLIENT_KEY,
+		ServerKey: "",
+	},
+	// the operations of ent, bun and xorm are internal spans wrapping the
+	// database/sql client spans, so they never suppress them
+	"loongsuite.instrumentation.ent": {
+		ScopeName: "loongsuite.instrumentation.ent",
+		Category:  CategoryDB,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.bun": {
+		ScopeName: "loongsuite.instrumentation.bun",
+		Category:  CategoryDB,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.xorm": {
+		ScopeName: "loongsuite.instrumentation.xorm",
+		Category:  CategoryDB,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.milvus": {
+		ScopeName: "loongsuite.instrumentation.milvus",
+		Category:  CategoryDB,
+		ClientKey: DB_CLIENT_KEY,
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.qdrant": {
+		ScopeName: "loongsuite.instrumentation.qdrant",
+		Category:  CategoryDB,
+		ClientKey: DB_CLIENT_KEY,
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.weaviate": {
+		ScopeName: "loongsuite.instrumentation.weaviate",
+		Category:  CategoryDB,
+		ClientKey: DB_CLIENT_KEY,
+		ServerKey: "",
+	},
 
 	// Messaging
 	"loongsuite.instrumentation.amqp091": {
@@ -195,6 +277,18 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: "",
 		ServerKey: "",
 	},
+	"loongsuite.instrumentation.sarama": {
+		ScopeName: "loongsuite.instrumentation.sarama",
+		Category:  CategoryMessaging,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.nats": {
+		ScopeName: "loongsuite.instrumentation.nats",
+		Category:  CategoryMessaging,
+		ClientKey: "",
+		ServerKey: "",
+	},
 
 	// AI/LLM
 	"loongsuite.instrumentation.eino": {
@@ -209,6 +303,24 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: "",
 		ServerKey: "",
 	},
+	"loongsuite.instrumentation.anthropic": {
+		ScopeName: "loongsuite.instrumentation.anthropic",
+		Category:  CategoryAI,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.genai": {
+		ScopeName: "loongsuite.instrumentation.genai",
+		Category:  CategoryAI,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.generative-ai-go": {
+		ScopeName: "loongsuite.instrumentation.generative-ai-go",
+		Category:  CategoryAI,
+		ClientKey: "",
+		ServerKey: "",
+	},
 
 	// Other
 	"loongsuite.instrumentation.sentinel": {
@@ -217,6 +329,18 @@ var InstrumentationRegistry = map[string]*InstrumentationMetadata{
 		ClientKey: "",
 		ServerKey: "",
 	},
+	"loongsuite.instrumentation.gqlgen": {
+		ScopeName: "loongsuite.instrumentation.gqlgen",
+		Category:  CategoryOther,
+		ClientKey: "",
+		ServerKey: "",
+	},
+	"loongsuite.instrumentation.graphql-go": {
+		ScopeName: "loongsuite.instrumentation.graphql-go",
+		Category:  CategoryOther,
+		ClientKey: "",
+		ServerKey: "",
+	},
 }
 
 // GetInstrumentationMetadata returns metadata for a given scope name
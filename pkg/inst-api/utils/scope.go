@@ -58,3 +58,27 @@ const RPCXGO_SERVER_SCOPE_NAME = "loongsuite.instrumentation.rpcx"
 const OLLAMA_SCOPE_NAME = "loongsuite.instrumentation.ollama"
 const CLICKHOUSE_V2_SCOPE_NAME = "loongsuite.instrumentation.clickhouse.v2"
 const OPENAI_SCOPE_NAME = "loongsuite.instrumentation.openai"
+const PGX_SCOPE_NAME = "loongsuite.instrumentation.pgx"
+const SARAMA_PRODUCER_SCOPE_NAME = "loongsuite.instrumentation.sarama"
+const SARAMA_CONSUMER_SCOPE_NAME = "loongsuite.instrumentation.sarama"
+const NATS_SCOPE_NAME = "loongsuite.instrumentation.nats"
+const AWS_SDK_SCOPE_NAME = "loongsuite.instrumentation.aws-sdk"
+const RESTY_SCOPE_NAME = "loongsuite.instrumentation.resty"
+const RETRYABLEHTTP_SCOPE_NAME = "loongsuite.instrumentation.retryablehttp"
+const GQLGEN_SCOPE_NAME = "loongsuite.instrumentation.gqlgen"
+const GRAPHQL_GO_SCOPE_NAME = "loongsuite.instrumentation.graphql-go"
+const CONNECT_CLIENT_SCOPE_NAME = "loongsuite.instrumentation.connect"
+const CONNECT_SERVER_SCOPE_NAME = "loongsuite.instrumentation.connect"
+const TWIRP_CLIENT_SCOPE_NAME = "loongsuite.instrumentation.twirp"
+const TWIRP_SERVER_SCOPE_NAME = "loongsuite.instrumentation.twirp"
+const THRIFT_CLIENT_SCOPE_NAME = "loongsuite.instrumentation.thrift"
+const THRIFT_SERVER_SCOPE_NAME = "loongsuite.instrumentation.thrift"
+const ENT_SCOPE_NAME = "loongsuite.instrumentation.ent"
+const BUN_SCOPE_NAME = "loongsuite.instrumentation.bun"
+const XORM_SCOPE_NAME = "loongsuite.instrumentation.xorm"
+const MILVUS_SCOPE_NAME = "loongsuite.instrumentation.milvus"
+const QDRANT_SCOPE_NAME = "loongsuite.instrumentation.qdrant"
+const WEAVIATE_SCOPE_NAME = "loongsuite.instrumentation.weaviate"
+const ANTHROPIC_SCOPE_NAME = "loongsuite.instrumentation.anthropic"
+const GENAI_SCOPE_NAME = "loongsuite.instrumentation.genai"
+const GENERATIVE_AI_GO_SCOPE_NAME = "loongsuite.instrumentation.generative-ai-go"
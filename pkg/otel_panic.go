@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// panic_flush_timeout sets the milliseconds the flush on an unrecovered panic
+// may take, 0 disables the flush.
+const panic_flush_timeout = "OTEL_INSTRUMENTATION_PANIC_FLUSH_TIMEOUT"
+
+// defaultPanicFlushTimeout bounds the flush on an unrecovered panic, a
+// crashing process must not hang on an unreachable backend.
+const defaultPanicFlushTimeout = time.Second
+
+var panicFlushTimeout = getPanicFlushTimeout()
+
+func getPanicFlushTimeout() time.Duration {
+	timeout, err := strconv.Atoi(os.Getenv(panic_flush_timeout))
+	if err != nil || timeout < 0 {
+		return defaultPanicFlushTimeout
+	}
+	return time.Duration(timeout) * time.Millisecond
+}
+
+// recordPanic runs on the panicking goroutine before the runtime prints the
+// panic and kills the process. The panic is recorded on the span of the
+// goroutine, and the spans still batched are exported while there is time.
+// A panic of the agent here is dropped, the runtime goes on reporting the
+// panic of the application.
+func recordPanic(ctx context.Context, v interface{}) {
+	defer func() {
+		_ = recover()
+	}()
+	if span := trace.SpanFromGLS(); span != nil && span.IsRecording() {
+		err, ok := v.(error)
+		if !ok {
+			err = fmt.Errorf("%v", v)
+		}
+		span.RecordError(err, oteltrace.WithStackTrace(true))
+		span.SetStatus(codes.Error, err.Error())
+		// the spans left on the goroutine would never be ended otherwise
+		for span != nil && span.IsRecording() {
+			span.End()
+			span = trace.SpanFromGLS()
+		}
+	}
+	if traceProvider == nil || panicFlushTimeout == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, panicFlushTimeout)
+	defer cancel()
+	_ = traceProvider.ForceFlush(ctx)
+}
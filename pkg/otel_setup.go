@@ -30,6 +30,7 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/experimental"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/http"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/message"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/rpc"
 	testaccess "github.com/alibaba/loongsuite-go-agent/pkg/testaccess"
 	prometheus_client "github.com/prometheus/client_golang/prometheus"
@@ -52,6 +53,7 @@ import (
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/trace"
 )
@@ -68,6 +70,7 @@ const trace_exporter = "OTEL_TRACES_EXPORTER"
 const prometheus_exporter_port = "OTEL_EXPORTER_PROMETHEUS_PORT"
 const default_prometheus_exporter_port = "9464"
 const metrics_temporality_preference = "OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE"
+const metrics_exemplar_filter = "OTEL_METRICS_EXEMPLAR_FILTER"
 
 const trace_sampler = "OTEL_TRACE_SAMPLER"
 
@@ -91,6 +94,9 @@ func init() {
 	runtime.ExitHook = func() {
 		gracefullyShutdown(ctx)
 	}
+	runtime.PanicHook = func(v interface{}) {
+		recordPanic(ctx, v)
+	}
 	path, err := os.Executable()
 	if err != nil {
 		panic(err)
@@ -221,6 +227,11 @@ func getTemporalitySelector() metric.TemporalitySelector {
 	}
 }
 
+// getExemplarFilter returns the exemplar filter configured by OTEL_METRICS_EXEMPLAR_FILTER.
+func getExemplarFilter() exemplar.Filter {
+	return meter.ExemplarFilter(os.Getenv(metrics_exemplar_filter))
+}
+
 // cumulativeTemporalitySelector returns Cumulative temporality for all instrument kinds
 func cumulativeTemporalitySelector(metric.InstrumentKind) metricdata.Temporality {
 	return metricdata.CumulativeTemporality
@@ -281,6 +292,7 @@ func initMetrics() error {
 	if testaccess.IsInTest() {
 		metricsProvider = metric.NewMeterProvider(
 			metric.WithReader(testaccess.ManualReader),
+			metric.WithExemplarFilter(getExemplarFilter()),
 		)
 	} else {
 		exporterNames := parseExporterNames(os.Getenv(metrics_exporter), "otlp")
@@ -310,7 +322,9 @@ func initMetrics() error {
 		if len(readers) == 0 {
 			metricsProvider = noop.NewMeterProvider()
 		} else {
-			var options []metric.Option
+			// Exemplars link the recorded measurements, e.g. the outliers of the
+			// latency histograms, to the sampled spans they were recorded in.
+			options := []metric.Option{metric.WithExemplarFilter(getExemplarFilter())}
 			for _, reader := range readers {
 				options = append(options, metric.WithReader(reader))
 			}
@@ -329,6 +343,7 @@ func initMetrics() error {
 	rpc.InitRpcMetrics(m)
 	db.InitDbMetrics(m)
 	ai.InitAIMetrics(m)
+	message.InitMessageMetrics(m)
 	experimental.InitNacosExperimentalMetrics(m)
 	experimental.InitSentinelExperimentalMetrics(m)
 	return otelruntime.Start(otelruntime.WithMeterProvider(metricsProvider))
@@ -372,6 +387,8 @@ func serveMetrics() {
 	http2.Handle("/metrics", promhttp.HandlerFor(
 		prometheus_client.DefaultGatherer,
 		promhttp.HandlerOpts{
+			// Exemplars are only exposed to scrapers negotiating the
+			// OpenMetrics format, e.g. Prometheus with exemplar storage enabled.
 			EnableOpenMetrics: true,
 		},
 	))
@@ -15,6 +15,9 @@
 package amqp091
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/message"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
@@ -22,6 +25,8 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+
+	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 type RabbitMQGetter struct {
@@ -80,6 +85,16 @@ func (RabbitMQGetter) GetDestinationPartitionId(request RabbitRequest) string {
 	return ""
 }
 
+// GetErrorType returns the reply code of the AMQP errors, e.g. 404 when the
+// exchange does not exist.
+func (RabbitMQGetter) GetErrorType(request RabbitRequest, response any, err error) string {
+	var amqpErr *amqp.Error
+	if errors.As(err, &amqpErr) {
+		return strconv.Itoa(amqpErr.Code)
+	}
+	return ""
+}
+
 type carrierGetter struct {
 	req RabbitRequest
 }
@@ -116,6 +131,7 @@ func BuildRabbitMQConsumeOtelInstrumenter() *instrumenter.PropagatingFromUpstrea
 	return builder.Init().SetSpanNameExtractor(&message.MessageSpanNameExtractor[RabbitRequest, any]{Getter: RabbitMQGetter{}, OperationName: message.RECEIVE}).
 		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[RabbitRequest]{}).
 		AddAttributesExtractor(&message.MessageAttrsExtractor[RabbitRequest, any, RabbitMQGetter]{Operation: message.RECEIVE}).
+		AddOperationListeners(message.MessagingMetrics("amqp091.consumer", message.RECEIVE)).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.AMQP091_SCOPE_NAME,
 			Version: version.Tag,
@@ -133,6 +149,7 @@ func BuildRabbitMQPublishOtelInstrumenter() *instrumenter.PropagatingToDownstrea
 			Version: version.Tag,
 		}).
 		AddAttributesExtractor(&message.MessageAttrsExtractor[RabbitRequest, any, RabbitMQGetter]{Operation: message.PUBLISH}).
+		AddOperationListeners(message.MessagingMetrics("amqp091.producer", message.PUBLISH)).
 		BuildPropagatingToDownstreamInstrumenter(func(n RabbitRequest) propagation.TextMapCarrier {
 			return &carrierGetter{req: n}
 		}, otel.GetTextMapPropagator())
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anthropic
+
+import "os"
+
+type anthropicInnerEnabler struct {
+	enabled bool
+}
+
+func (a anthropicInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+var anthropicEnabler = anthropicInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ANTHROPIC_ENABLED") != "false"}
+
+const (
+	operationNameChat        = "chat"
+	operationNameCountTokens = "count_tokens"
+)
+
+type anthropicRequest struct {
+	operationName string
+	model         string
+	maxTokens     int64
+	temperature   float64
+	topK          float64
+	topP          float64
+	stopSequences []string
+	inputMessages string
+	isStream      bool
+	inputTokens   int64
+}
+
+type anthropicResponse struct {
+	responseID               string
+	responseModel            string
+	outputTokens             int64
+	cacheReadInputTokens     int64
+	cacheCreationInputTokens int64
+	countedInputTokens       int64
+	stopReasons              []string
+	outputMessages           string
+}
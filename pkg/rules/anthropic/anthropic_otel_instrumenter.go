@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anthropic
+
+import (
+	"context"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const anthropicCountTokensInputTokensKey = attribute.Key("anthropic.count_tokens.input_tokens")
+
+type anthropicAttrsGetter struct{}
+
+func (anthropicAttrsGetter) GetAIOperationName(request anthropicRequest) string {
+	return request.operationName
+}
+
+func (anthropicAttrsGetter) GetAISystem(request anthropicRequest) string {
+	return "anthropic"
+}
+
+func (anthropicAttrsGetter) GetAIRequestModel(request anthropicRequest) string {
+	return request.model
+}
+
+func (anthropicAttrsGetter) GetAIRequestEncodingFormats(request anthropicRequest) []string {
+	return nil
+}
+
+func (anthropicAttrsGetter) GetAIRequestFrequencyPenalty(request anthropicRequest) float64 {
+	return 0
+}
+
+func (anthropicAttrsGetter) GetAIRequestPresencePenalty(request anthropicRequest) float64 {
+	return 0
+}
+
+func (anthropicAttrsGetter) GetAIResponseFinishReasons(request anthropicRequest, response anthropicResponse) []string {
+	return response.stopReasons
+}
+
+func (anthropicAttrsGetter) GetAIResponseModel(request anthropicRequest, response anthropicResponse) string {
+	return response.responseModel
+}
+
+func (anthropicAttrsGetter) GetAIRequestMaxTokens(request anthropicRequest) int64 {
+	return request.maxTokens
+}
+
+func (anthropicAttrsGetter) GetAIUsageInputTokens(request anthropicRequest) int64 {
+	return request.inputTokens
+}
+
+func (anthropicAttrsGetter) GetAIUsageOutputTokens(request anthropicRequest, response anthropicResponse) int64 {
+	return response.outputTokens
+}
+
+func (anthropicAttrsGetter) GetAIRequestStopSequences(request anthropicRequest) []string {
+	return request.stopSequences
+}
+
+func (anthropicAttrsGetter) GetAIRequestTemperature(request anthropicRequest) float64 {
+	return request.temperature
+}
+
+func (anthropicAttrsGetter) GetAIRequestTopK(request anthropicRequest) float64 {
+	return request.topK
+}
+
+func (anthropicAttrsGetter) GetAIRequestTopP(request anthropicRequest) float64 {
+	return request.topP
+}
+
+func (anthropicAttrsGetter) GetAIResponseID(request anthropicRequest, response anthropicResponse) string {
+	return response.responseID
+}
+
+func (anthropicAttrsGetter) GetAIServerAddress(request anthropicRequest) string {
+	return ""
+}
+
+func (anthropicAttrsGetter) GetAIRequestSeed(request anthropicRequest) int64 {
+	return 0
+}
+
+func (anthropicAttrsGetter) GetAIInput(request anthropicRequest) string {
+	return request.inputMessages
+}
+
+func (anthropicAttrsGetter) GetAIOutput(response anthropicResponse) string {
+	return response.outputMessages
+}
+
+// anthropicUsageAttrsExtractor records the prompt caching usage reported by
+// the Messages API and the result of token counting. Counted tokens are not
+// consumed, so they are kept out of gen_ai.usage.input_tokens and thus out of
+// gen_ai.client.token.usage.
+type anthropicUsageAttrsExtractor struct{}
+
+func (a *anthropicUsageAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request anthropicRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (a *anthropicUsageAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request anthropicRequest, response anthropicResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.cacheReadInputTokens > 0 {
+		attributes = append(attributes, ai.GenAIUsageCacheReadInputTokensKey.Int64(response.cacheReadInputTokens))
+	}
+	if response.cacheCreationInputTokens > 0 {
+		attributes = append(attributes, ai.GenAIUsageCacheCreationInputTokensKey.Int64(response.cacheCreationInputTokens))
+	}
+	if response.countedInputTokens > 0 {
+		attributes = append(attributes, anthropicCountTokensInputTokensKey.Int64(response.countedInputTokens))
+	}
+	return attributes, ctx
+}
+
+func BuildAnthropicClientInstrumenter() instrumenter.Instrumenter[anthropicRequest, anthropicResponse] {
+	builder := instrumenter.Builder[anthropicRequest, anthropicResponse]{}
+	getter := anthropicAttrsGetter{}
+	return builder.Init().
+		SetSpanNameExtractor(&ai.AISpanNameExtractor[anthropicRequest, anthropicResponse]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[anthropicRequest]{}).
+		AddAttributesExtractor(&ai.AILLMAttrsExtractor[anthropicRequest, anthropicResponse, anthropicAttrsGetter, anthropicAttrsGetter]{
+			Base: ai.AICommonAttrsExtractor[anthropicRequest, anthropicResponse, anthropicAttrsGetter]{
+				CommonGetter: getter,
+			},
+			LLMGetter: getter,
+		}).
+		AddAttributesExtractor(&anthropicUsageAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ANTHROPIC_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(ai.AIClientMetrics("anthropic-client")).
+		BuildInstrumenter()
+}
+
+var anthropicInstrumenter = BuildAnthropicClientInstrumenter()
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	anthropic "github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/anthropics/anthropic-sdk-go/packages/ssestream"
+)
+
+func newMessageRequest(body anthropic.MessageNewParams, isStream bool) anthropicRequest {
+	request := anthropicRequest{
+		operationName: operationNameChat,
+		model:         string(body.Model),
+		maxTokens:     body.MaxTokens,
+		temperature:   body.Temperature.Value,
+		topK:          float64(body.TopK.Value),
+		topP:          body.TopP.Value,
+		stopSequences: body.StopSequences,
+		isStream:      isStream,
+	}
+	if input, err := json.Marshal(body.Messages); err == nil {
+		request.inputMessages = string(input)
+	}
+	return request
+}
+
+// fillMessageResponse copies the usage, stop reason and content of a
+// (possibly accumulated) message into the request and response.
+func fillMessageResponse(request *anthropicRequest, response *anthropicResponse, message *anthropic.Message) {
+	response.responseID = message.ID
+	response.responseModel = string(message.Model)
+	if message.StopReason != "" {
+		response.stopReasons = []string{string(message.StopReason)}
+	}
+	// Anthropic reports cached prompt tokens apart from input_tokens, while
+	// gen_ai.usage.input_tokens covers the whole prompt.
+	usage := message.Usage
+	request.inputTokens = usage.InputTokens + usage.CacheReadInputTokens + usage.CacheCreationInputTokens
+	response.outputTokens = usage.OutputTokens
+	response.cacheReadInputTokens = usage.CacheReadInputTokens
+	response.cacheCreationInputTokens = usage.CacheCreationInputTokens
+	if output, err := json.Marshal(message.Content); err == nil {
+		response.outputMessages = string(output)
+	}
+}
+
+//go:linkname messageNewOnEnter github.com/anthropics/anthropic-sdk-go.messageNewOnEnter
+func messageNewOnEnter(call api.CallContext, r *anthropic.MessageService, ctx context.Context, body anthropic.MessageNewParams, opts ...option.RequestOption) {
+	if !anthropicEnabler.Enable() {
+		return
+	}
+	request := newMessageRequest(body, false)
+	ctx = anthropicInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname messageNewOnExit github.com/anthropics/anthropic-sdk-go.messageNewOnExit
+func messageNewOnExit(call api.CallContext, message *anthropic.Message, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(anthropicRequest)
+	response := anthropicResponse{}
+	if err == nil && message != nil {
+		fillMessageResponse(&request, &response, message)
+	}
+	anthropicInstrumenter.End(ctx, request, response, err)
+}
+
+//go:linkname messageNewStreamingOnEnter github.com/anthropics/anthropic-sdk-go.messageNewStreamingOnEnter
+func messageNewStreamingOnEnter(call api.CallContext, r *anthropic.MessageService, ctx context.Context, body anthropic.MessageNewParams, opts ...option.RequestOption) {
+	if !anthropicEnabler.Enable() {
+		return
+	}
+	request := newMessageRequest(body, true)
+	ctx = anthropicInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname messageNewStreamingOnExit github.com/anthropics/anthropic-sdk-go.messageNewStreamingOnExit
+func messageNewStreamingOnExit(call api.CallContext, ret interface{}) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(anthropicRequest)
+	stream, ok := ret.(*ssestream.Stream[anthropic.MessageStreamEventUnion])
+	if !ok || stream == nil || stream.Err() != nil {
+		var err error
+		if stream != nil {
+			err = stream.Err()
+		}
+		anthropicInstrumenter.End(ctx, request, anthropicResponse{}, err)
+		return
+	}
+	// The stream type cannot be wrapped, so the span is ended by the decoder
+	// underneath it once the stream is drained or closed.
+	decoderField := reflect.ValueOf(stream).Elem().FieldByName("decoder")
+	if !decoderField.IsValid() || decoderField.IsNil() {
+		anthropicInstrumenter.End(ctx, request, anthropicResponse{}, nil)
+		return
+	}
+	decoderField = reflect.NewAt(decoderField.Type(), unsafe.Pointer(decoderField.UnsafeAddr())).Elem()
+	decoder, ok := decoderField.Interface().(ssestream.Decoder)
+	if !ok {
+		anthropicInstrumenter.End(ctx, request, anthropicResponse{}, nil)
+		return
+	}
+	decoderField.Set(reflect.ValueOf(ssestream.Decoder(newAnthropicStreamDecoder(ctx, request, decoder))))
+}
+
+//go:linkname messageCountTokensOnEnter github.com/anthropics/anthropic-sdk-go.messageCountTokensOnEnter
+func messageCountTokensOnEnter(call api.CallContext, r *anthropic.MessageService, ctx context.Context, body anthropic.MessageCountTokensParams, opts ...option.RequestOption) {
+	if !anthropicEnabler.Enable() {
+		return
+	}
+	request := anthropicRequest{
+		operationName: operationNameCountTokens,
+		model:         string(body.Model),
+	}
+	if input, err := json.Marshal(body.Messages); err == nil {
+		request.inputMessages = string(input)
+	}
+	ctx = anthropicInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname messageCountTokensOnExit github.com/anthropics/anthropic-sdk-go.messageCountTokensOnExit
+func messageCountTokensOnExit(call api.CallContext, count *anthropic.MessageTokensCount, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(anthropicRequest)
+	response := anthropicResponse{}
+	if err == nil && count != nil {
+		response.countedInputTokens = count.InputTokens
+	}
+	anthropicInstrumenter.End(ctx, request, response, err)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	anthropic "github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/packages/ssestream"
+)
+
+// anthropicStreamDecoder accumulates the server-sent events of a streaming
+// message and ends its span on message_stop, on error, at the end of the
+// stream or when the stream is closed, whichever comes first.
+type anthropicStreamDecoder struct {
+	ssestream.Decoder
+	ctx            context.Context
+	request        anthropicRequest
+	message        anthropic.Message
+	firstTokenTime time.Time
+	once           sync.Once
+}
+
+func newAnthropicStreamDecoder(ctx context.Context, request anthropicRequest, decoder ssestream.Decoder) *anthropicStreamDecoder {
+	return &anthropicStreamDecoder{
+		Decoder: decoder,
+		ctx:     ctx,
+		request: request,
+	}
+}
+
+func (d *anthropicStreamDecoder) Next() bool {
+	if !d.Decoder.Next() {
+		d.end(d.Decoder.Err())
+		return false
+	}
+	event := d.Decoder.Event()
+	switch event.Type {
+	case "message_start", "message_delta", "content_block_start", "content_block_delta", "content_block_stop":
+		if event.Type == "content_block_delta" && d.firstTokenTime.IsZero() {
+			d.firstTokenTime = time.Now()
+		}
+		var streamEvent anthropic.MessageStreamEventUnion
+		if err := json.Unmarshal(event.Data, &streamEvent); err == nil {
+			_ = d.message.Accumulate(streamEvent)
+		}
+	case "message_stop":
+		d.end(nil)
+	case "error":
+		d.end(fmt.Errorf("received error while streaming: %s", string(event.Data)))
+	}
+	return true
+}
+
+func (d *anthropicStreamDecoder) Close() error {
+	d.end(nil)
+	return d.Decoder.Close()
+}
+
+func (d *anthropicStreamDecoder) end(err error) {
+	d.once.Do(func() {
+		request := d.request
+		response := anthropicResponse{}
+		fillMessageResponse(&request, &response, &d.message)
+		ctx := d.ctx
+		if !d.firstTokenTime.IsZero() {
+			ctx = context.WithValue(ctx, ai.TimeToFirstTokenKey{}, d.firstTokenTime)
+		}
+		anthropicInstrumenter.End(ctx, request, response, err)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ants
+
+import (
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/gls"
+)
+
+type antsInnerEnabler struct {
+	enabled bool
+}
+
+func (a antsInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+var antsEnabler = antsInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ANTS_ENABLED") != "false"}
+
+// the task runs on a worker started before it, with the context of the
+// goroutine submitting it rather than the one of the worker. ants.Submit and
+// the MultiPool submit to a Pool as well.
+//
+//go:linkname antsPoolSubmitOnEnter github.com/panjf2000/ants/v2.antsPoolSubmitOnEnter
+func antsPoolSubmitOnEnter(call api.CallContext, _ interface{}, task func()) {
+	if !antsEnabler.Enable() || task == nil {
+		return
+	}
+	call.SetParam(1, gls.Wrap(task))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const otelMiddlewareID = "LoongsuiteOtelInitializeMiddleware"
+
+// otelInitializeMiddleware traces an operation of a client, it is added after
+// the service metadata is registered and before the retries, so there is one
+// span for each operation.
+type otelInitializeMiddleware struct{}
+
+func (m *otelInitializeMiddleware) ID() string {
+	return otelMiddlewareID
+}
+
+func (m *otelInitializeMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (out middleware.InitializeOutput, metadata middleware.Metadata, err error) {
+	if !awsEnabler.Enable() {
+		return next.HandleInitialize(ctx, in)
+	}
+	request := &awsRequest{
+		service:   awsmiddleware.GetServiceID(ctx),
+		operation: awsmiddleware.GetOperationName(ctx),
+		region:    awsmiddleware.GetRegion(ctx),
+		input:     in.Parameters,
+	}
+	ctx = awsInstrumenter.Start(ctx, request)
+	if isPublishOperation(request) {
+		injectMessageAttributes(ctx, request)
+	}
+	out, metadata, err = next.HandleInitialize(ctx, in)
+	response := &awsResponse{}
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		response.requestID = requestID
+	}
+	if rawResponse, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && rawResponse != nil {
+		response.statusCode = rawResponse.StatusCode
+	}
+	awsInstrumenter.End(ctx, request, response, err)
+	return out, metadata, err
+}
+
+// addOtelMiddleware is appended to the api options of the config and of the
+// clients, the middleware is added only once for the clients built from an
+// instrumented config.
+func addOtelMiddleware(stack *middleware.Stack) error {
+	if _, ok := stack.Initialize.Get(otelMiddlewareID); ok {
+		return nil
+	}
+	return stack.Initialize.Add(&otelInitializeMiddleware{}, middleware.After)
+}
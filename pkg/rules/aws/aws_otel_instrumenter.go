@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const awsRpcSystem = "aws-api"
+
+var awsEnabler = awsInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_AWS_SDK_ENABLED") != "false"}
+
+var awsInstrumenter = buildAwsInstrumenter()
+
+type awsInnerEnabler struct {
+	enabled bool
+}
+
+func (a awsInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+type awsAttrsGetter struct{}
+
+func (getter awsAttrsGetter) GetSystem(request *awsRequest) string {
+	return awsRpcSystem
+}
+
+func (getter awsAttrsGetter) GetService(request *awsRequest) string {
+	return request.service
+}
+
+func (getter awsAttrsGetter) GetMethod(request *awsRequest) string {
+	return request.operation
+}
+
+func (getter awsAttrsGetter) GetServerAddress(request *awsRequest) string {
+	return ""
+}
+
+// awsSpanNameExtractor names the span as {service}.{operation}, e.g. S3.GetObject.
+type awsSpanNameExtractor struct{}
+
+func (extractor *awsSpanNameExtractor) Extract(request *awsRequest) string {
+	if request.service == "" || request.operation == "" {
+		return "AWS request"
+	}
+	return request.service + "." + request.operation
+}
+
+// awsSpanKindExtractor models the messages sent to SQS and SNS as producer
+// spans, other operations are client spans.
+type awsSpanKindExtractor struct{}
+
+func (extractor *awsSpanKindExtractor) Extract(request *awsRequest) trace.SpanKind {
+	if isPublishOperation(request) {
+		return trace.SpanKindProducer
+	}
+	return trace.SpanKindClient
+}
+
+// awsAttrsExtractor adds the region, the request id and the attributes of
+// the services, i.e. db.* for DynamoDB and messaging.* for SQS and SNS.
+type awsAttrsExtractor struct{}
+
+func (extractor *awsAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *awsRequest) ([]attribute.KeyValue, context.Context) {
+	if request.region != "" {
+		attributes = append(attributes, semconv.CloudRegion(request.region))
+	}
+	attributes = append(attributes, serviceAttributes(request)...)
+	return attributes, parentContext
+}
+
+func (extractor *awsAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request *awsRequest, response *awsResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response == nil {
+		return attributes, ctx
+	}
+	if response.requestID != "" {
+		attributes = append(attributes, semconv.AWSRequestID(response.requestID))
+	}
+	if response.statusCode > 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, ctx
+}
+
+func buildAwsInstrumenter() instrumenter.Instrumenter[*awsRequest, *awsResponse] {
+	builder := instrumenter.Builder[*awsRequest, *awsResponse]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.AWS_SDK_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&awsSpanNameExtractor{}).
+		SetSpanKindExtractor(&awsSpanKindExtractor{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[*awsRequest, *awsResponse, awsAttrsGetter]{}).
+		AddAttributesExtractor(&awsAttrsExtractor{}).
+		AddOperationListeners(rpc.RpcClientMetrics("aws-sdk.client")).
+		BuildInstrumenter()
+}
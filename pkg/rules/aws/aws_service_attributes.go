@@ -0,0 +1,281 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+// The inputs of the operations are read by their field names, so that the
+// rule does not depend on the modules of every service.
+
+const (
+	dynamodbServiceID = "DynamoDB"
+	sqsServiceID      = "SQS"
+	snsServiceID      = "SNS"
+	s3ServiceID       = "S3"
+)
+
+// sqsMaxMessageAttributes is the number of the message attributes allowed by
+// SQS and SNS, the trace context is not injected into a full message.
+const sqsMaxMessageAttributes = 10
+
+var messagingSystemAWSSns = semconv.MessagingSystemKey.String("aws_sns")
+
+func isPublishOperation(request *awsRequest) bool {
+	switch request.service {
+	case sqsServiceID:
+		return request.operation == "SendMessage" || request.operation == "SendMessageBatch"
+	case snsServiceID:
+		return request.operation == "Publish" || request.operation == "PublishBatch"
+	}
+	return false
+}
+
+func structValue(input interface{}) reflect.Value {
+	v := reflect.ValueOf(input)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v
+}
+
+func stringField(v reflect.Value, name string) string {
+	if !v.IsValid() {
+		return ""
+	}
+	f := v.FieldByName(name)
+	if f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	if f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
+
+func mapKeys(v reflect.Value, name string) []string {
+	if !v.IsValid() {
+		return nil
+	}
+	f := v.FieldByName(name)
+	if f.Kind() != reflect.Map || f.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+	keys := make([]string, 0, f.Len())
+	for _, key := range f.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	return keys
+}
+
+func sliceLen(v reflect.Value, name string) int {
+	if !v.IsValid() {
+		return 0
+	}
+	f := v.FieldByName(name)
+	if f.Kind() != reflect.Slice {
+		return 0
+	}
+	return f.Len()
+}
+
+func serviceAttributes(request *awsRequest) []attribute.KeyValue {
+	input := structValue(request.input)
+	switch request.service {
+	case dynamodbServiceID:
+		return dynamodbAttributes(request, input)
+	case sqsServiceID:
+		return sqsAttributes(request, input)
+	case snsServiceID:
+		return snsAttributes(request, input)
+	case s3ServiceID:
+		return s3Attributes(input)
+	}
+	return nil
+}
+
+func dynamodbAttributes(request *awsRequest, input reflect.Value) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemNameAWSDynamoDB,
+		semconv.DBOperationName(request.operation),
+	}
+	tables := mapKeys(input, "RequestItems")
+	if table := stringField(input, "TableName"); table != "" {
+		tables = append(tables, table)
+	}
+	if len(tables) > 0 {
+		attributes = append(attributes, semconv.AWSDynamoDBTableNames(tables...))
+	}
+	if len(tables) == 1 {
+		attributes = append(attributes, semconv.DBCollectionName(tables[0]))
+	}
+	return attributes
+}
+
+// queueName gets the name of the queue from its url, e.g. my-queue of
+// https://sqs.us-east-1.amazonaws.com/123456789012/my-queue.
+func queueName(queueURL string) string {
+	return queueURL[strings.LastIndex(queueURL, "/")+1:]
+}
+
+// topicName gets the name of the topic from its arn, e.g. my-topic of
+// arn:aws:sns:us-east-1:123456789012:my-topic.
+func topicName(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+func sqsAttributes(request *awsRequest, input reflect.Value) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		semconv.MessagingSystemAWSSqs,
+		semconv.MessagingOperationName(request.operation),
+	}
+	if queueURL := stringField(input, "QueueUrl"); queueURL != "" {
+		attributes = append(attributes, semconv.MessagingDestinationName(queueName(queueURL)))
+	}
+	switch request.operation {
+	case "SendMessage":
+		attributes = append(attributes, semconv.MessagingOperationTypeSend)
+	case "SendMessageBatch":
+		attributes = append(attributes, semconv.MessagingOperationTypeSend,
+			semconv.MessagingBatchMessageCount(sliceLen(input, "Entries")))
+	case "ReceiveMessage":
+		attributes = append(attributes, semconv.MessagingOperationTypeReceive)
+	}
+	return attributes
+}
+
+func snsAttributes(request *awsRequest, input reflect.Value) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		messagingSystemAWSSns,
+		semconv.MessagingOperationName(request.operation),
+	}
+	arn := stringField(input, "TopicArn")
+	if arn == "" {
+		arn = stringField(input, "TargetArn")
+	}
+	if arn != "" {
+		attributes = append(attributes, semconv.MessagingDestinationName(topicName(arn)))
+	}
+	switch request.operation {
+	case "Publish":
+		attributes = append(attributes, semconv.MessagingOperationTypePublish)
+	case "PublishBatch":
+		attributes = append(attributes, semconv.MessagingOperationTypePublish,
+			semconv.MessagingBatchMessageCount(sliceLen(input, "PublishBatchRequestEntries")))
+	}
+	return attributes
+}
+
+func s3Attributes(input reflect.Value) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	if bucket := stringField(input, "Bucket"); bucket != "" {
+		attributes = append(attributes, semconv.AWSS3Bucket(bucket))
+	}
+	if key := stringField(input, "Key"); key != "" {
+		attributes = append(attributes, semconv.AWSS3Key(key))
+	}
+	return attributes
+}
+
+// messageAttributesCarrier carries the trace context in the message attributes
+// of SQS and SNS, i.e. map[string]types.MessageAttributeValue of either service.
+type messageAttributesCarrier struct {
+	attributes reflect.Value
+}
+
+func (carrier messageAttributesCarrier) Get(key string) string {
+	value := carrier.attributes.MapIndex(reflect.ValueOf(key))
+	if !value.IsValid() {
+		return ""
+	}
+	return stringField(value, "StringValue")
+}
+
+func (carrier messageAttributesCarrier) Set(key, value string) {
+	entry := reflect.New(carrier.attributes.Type().Elem()).Elem()
+	dataType, stringValue := entry.FieldByName("DataType"), entry.FieldByName("StringValue")
+	if !dataType.IsValid() || !stringValue.IsValid() {
+		return
+	}
+	dataTypeValue := "String"
+	dataType.Set(reflect.ValueOf(&dataTypeValue))
+	stringValue.Set(reflect.ValueOf(&value))
+	carrier.attributes.SetMapIndex(reflect.ValueOf(key), entry)
+}
+
+func (carrier messageAttributesCarrier) Keys() []string {
+	keys := make([]string, 0, carrier.attributes.Len())
+	for _, key := range carrier.attributes.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	return keys
+}
+
+func injectInto(ctx context.Context, message reflect.Value) {
+	attributes := message.FieldByName("MessageAttributes")
+	if !attributes.IsValid() || !attributes.CanSet() || attributes.Kind() != reflect.Map ||
+		attributes.Type().Key().Kind() != reflect.String || attributes.Type().Elem().Kind() != reflect.Struct {
+		return
+	}
+	fields := otel.GetTextMapPropagator().Fields()
+	if attributes.Len()+len(fields) > sqsMaxMessageAttributes {
+		return
+	}
+	if attributes.IsNil() {
+		attributes.Set(reflect.MakeMap(attributes.Type()))
+	}
+	otel.GetTextMapPropagator().Inject(ctx, messageAttributesCarrier{attributes: attributes})
+}
+
+// injectMessageAttributes propagates the trace context to the consumers of
+// the messages sent to SQS or published to SNS.
+func injectMessageAttributes(ctx context.Context, request *awsRequest) {
+	input := structValue(request.input)
+	if !input.IsValid() {
+		return
+	}
+	var entries reflect.Value
+	switch request.operation {
+	case "SendMessage", "Publish":
+		injectInto(ctx, input)
+		return
+	case "SendMessageBatch":
+		entries = input.FieldByName("Entries")
+	case "PublishBatch":
+		entries = input.FieldByName("PublishBatchRequestEntries")
+	}
+	if entries.Kind() != reflect.Slice {
+		return
+	}
+	for i := 0; i < entries.Len(); i++ {
+		injectInto(ctx, entries.Index(i))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"reflect"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+)
+
+//go:linkname loadDefaultConfigOnExit github.com/aws/aws-sdk-go-v2/config.loadDefaultConfigOnExit
+func loadDefaultConfigOnExit(call api.CallContext, cfg awssdk.Config, err error) {
+	if !awsEnabler.Enable() || err != nil {
+		return
+	}
+	cfg.APIOptions = append(cfg.APIOptions, addOtelMiddleware)
+	call.SetReturnVal(0, cfg)
+}
+
+// withOtelMiddleware appends the middleware to the api options of the options
+// of a client, the options are of the Options type of each service.
+func withOtelMiddleware(call api.CallContext, options interface{}) {
+	if !awsEnabler.Enable() || options == nil {
+		return
+	}
+	v := reflect.New(reflect.TypeOf(options)).Elem()
+	v.Set(reflect.ValueOf(options))
+	apiOptions := v.FieldByName("APIOptions")
+	if !apiOptions.IsValid() || apiOptions.Type() != reflect.TypeOf([]func(*middleware.Stack) error{}) {
+		return
+	}
+	// copy the api options, the slice may be shared by the clients of a config
+	fns := append(append([]func(*middleware.Stack) error{}, apiOptions.Interface().([]func(*middleware.Stack) error)...), addOtelMiddleware)
+	apiOptions.Set(reflect.ValueOf(fns))
+	call.SetParam(0, v.Interface())
+}
+
+//go:linkname dynamodbNewOnEnter github.com/aws/aws-sdk-go-v2/service/dynamodb.dynamodbNewOnEnter
+func dynamodbNewOnEnter(call api.CallContext, options interface{}, optFns interface{}) {
+	withOtelMiddleware(call, options)
+}
+
+//go:linkname s3NewOnEnter github.com/aws/aws-sdk-go-v2/service/s3.s3NewOnEnter
+func s3NewOnEnter(call api.CallContext, options interface{}, optFns interface{}) {
+	withOtelMiddleware(call, options)
+}
+
+//go:linkname snsNewOnEnter github.com/aws/aws-sdk-go-v2/service/sns.snsNewOnEnter
+func snsNewOnEnter(call api.CallContext, options interface{}, optFns interface{}) {
+	withOtelMiddleware(call, options)
+}
+
+//go:linkname sqsNewOnEnter github.com/aws/aws-sdk-go-v2/service/sqs.sqsNewOnEnter
+func sqsNewOnEnter(call api.CallContext, options interface{}, optFns interface{}) {
+	withOtelMiddleware(call, options)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beego
+
+import (
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	beecontext "github.com/beego/beego/v2/server/web/context"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// routerPatternKey is the key under which the router of beego stores the
+// pattern of the matched route into the input of the context.
+const routerPatternKey = "RouterPattern"
+
+type beegoInnerEnabler struct {
+	enabled bool
+}
+
+func (b beegoInnerEnabler) Enable() bool {
+	return b.enabled
+}
+
+var beegoEnabler = beegoInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_BEEGO_ENABLED") != "false"}
+
+//go:linkname beegoInputSetDataOnEnter github.com/beego/beego/v2/server/web/context.beegoInputSetDataOnEnter
+func beegoInputSetDataOnEnter(call api.CallContext, input *beecontext.BeegoInput, key, val interface{}) {
+	if !beegoEnabler.Enable() {
+		return
+	}
+	if input == nil || input.Context == nil || input.Context.Request == nil || input.Context.Request.URL == nil {
+		return
+	}
+	if k, ok := key.(string); !ok || k != routerPatternKey {
+		return
+	}
+	pattern, ok := val.(string)
+	if !ok {
+		return
+	}
+	lcs := trace.LocalRootSpanFromGLS()
+	if lcs != nil && pattern != "" && pattern != input.Context.Request.URL.Path {
+		lcs.SetName(pattern)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bun
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type bunInnerEnabler struct {
+	enabled bool
+}
+
+func (b bunInnerEnabler) Enable() bool {
+	return b.enabled
+}
+
+var bunEnabler = bunInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_BUN_ENABLED") != "false"}
+
+type bunAttrsGetter struct {
+}
+
+func (b bunAttrsGetter) GetSystem(request bunRequest) string {
+	return request.system
+}
+
+func (b bunAttrsGetter) GetServerAddress(request bunRequest) string {
+	return ""
+}
+
+func (b bunAttrsGetter) GetServerPort(request bunRequest) int {
+	return 0
+}
+
+// the statement is reported by the nested database/sql span
+func (b bunAttrsGetter) GetStatement(request bunRequest) string {
+	return ""
+}
+
+func (b bunAttrsGetter) GetCollection(request bunRequest) string {
+	return request.table
+}
+
+func (b bunAttrsGetter) GetOperation(request bunRequest) string {
+	return request.operation
+}
+
+func (b bunAttrsGetter) GetParameters(request bunRequest) []any {
+	return nil
+}
+
+func (b bunAttrsGetter) GetDbNamespace(request bunRequest) string {
+	return ""
+}
+
+func (b bunAttrsGetter) GetBatchSize(request bunRequest) int {
+	return 0
+}
+
+// the db.client.request.duration is recorded by the nested database/sql span,
+// so the operations have no metrics of their own
+func BuildBunInstrumenter() instrumenter.Instrumenter[bunRequest, any] {
+	builder := instrumenter.Builder[bunRequest, any]{}
+	getter := bunAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[bunRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[bunRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[bunRequest, any, bunAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[bunRequest, any, bunAttrsGetter]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.BUN_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bun
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/uptrace/bun"
+)
+
+var bunInstrumenter = BuildBunInstrumenter()
+
+type bunQueryHook struct {
+}
+
+func (h bunQueryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	if !bunEnabler.Enable() {
+		return ctx
+	}
+	request := bunRequest{
+		system:    db.SystemName(event.DB.Dialect().Name().String()),
+		operation: strings.ToUpper(event.Operation()),
+	}
+	if event.IQuery != nil {
+		request.table = event.IQuery.GetTableName()
+	}
+	// raw queries have no model
+	if request.table == "" {
+		_, table := db.StatementOperation(event.Query)
+		request.table = db.UnquoteIdentifier(table)
+	}
+	ctx = bunInstrumenter.Start(ctx, request)
+	return context.WithValue(ctx, bunRequestKey{}, request)
+}
+
+func (h bunQueryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	request, ok := ctx.Value(bunRequestKey{}).(bunRequest)
+	if !ok {
+		return
+	}
+	err := event.Err
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	bunInstrumenter.End(ctx, request, nil, err)
+}
+
+// func NewDB(sqldb *sql.DB, dialect schema.Dialect, opts ...DBOption) *DB
+//
+//go:linkname afterNewBunDB github.com/uptrace/bun.afterNewBunDB
+func afterNewBunDB(call api.CallContext, bunDB *bun.DB) {
+	if !bunEnabler.Enable() || bunDB == nil {
+		return
+	}
+	bunDB.AddQueryHook(bunQueryHook{})
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chi
+
+import (
+	"net/http"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+type chiInnerEnabler struct {
+	enabled bool
+}
+
+func (c chiInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var chiEnabler = chiInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CHI_ENABLED") != "false"}
+
+//go:linkname chiRouteHTTPOnEnter github.com/go-chi/chi/v5.chiRouteHTTPOnEnter
+func chiRouteHTTPOnEnter(call api.CallContext, mx *chi.Mux, w http.ResponseWriter, r *http.Request) {
+	if !chiEnabler.Enable() || r == nil {
+		return
+	}
+	call.SetData(r)
+}
+
+// the pattern of the mounted routers is only complete once the innermost
+// router has routed the request
+//
+//go:linkname chiRouteHTTPOnExit github.com/go-chi/chi/v5.chiRouteHTTPOnExit
+func chiRouteHTTPOnExit(call api.CallContext) {
+	r, ok := call.GetData().(*http.Request)
+	if !ok {
+		return
+	}
+	pattern := chi.RouteContext(r.Context()).RoutePattern()
+	lcs := trace.LocalRootSpanFromGLS()
+	if lcs != nil && pattern != "" && r.URL != nil && pattern != r.URL.Path {
+		lcs.SetName(pattern)
+	}
+}
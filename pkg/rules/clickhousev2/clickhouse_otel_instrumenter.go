@@ -32,6 +32,10 @@ func (g clickhouseAttrsGetter) GetServerAddress(clickhouseRequest clickhouseRequ
 	return clickhouseRequest.Addr
 }
 
+func (g clickhouseAttrsGetter) GetServerPort(clickhouseRequest clickhouseRequest) int {
+	return 0
+}
+
 func (g clickhouseAttrsGetter) GetStatement(clickhouseRequest clickhouseRequest) string {
 	return clickhouseRequest.Statement
 }
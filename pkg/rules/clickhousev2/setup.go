@@ -126,7 +126,51 @@ func (oc *OtelCon) PrepareBatch(ctx context.Context, query string, opts ...drive
 		Params:    nil,
 	}
 	clickhouseInstrumenter.StartAndEnd(context.Background(), request, nil, err, startTime, time.Now())
-	return batch, err
+	if err != nil || batch == nil {
+		return batch, err
+	}
+	return &otelBatch{Batch: batch, query: query, opts: oc.opts}, err
+}
+
+// otelBatch records the rows appended to a prepared batch and reports them
+// as a single batch operation when the batch is sent.
+type otelBatch struct {
+	driver.Batch
+	query string
+	opts  *clickhouse.Options
+	rows  int
+}
+
+func (ob *otelBatch) Append(v ...any) error {
+	err := ob.Batch.Append(v...)
+	if err == nil {
+		ob.rows++
+	}
+	return err
+}
+
+func (ob *otelBatch) AppendStruct(v any) error {
+	err := ob.Batch.AppendStruct(v)
+	if err == nil {
+		ob.rows++
+	}
+	return err
+}
+
+func (ob *otelBatch) Send() error {
+	startTime := time.Now()
+	err := ob.Batch.Send()
+	request := clickhouseRequest{
+		Statement: ob.query,
+		DbName:    ob.opts.Auth.Database,
+		User:      ob.opts.Auth.Username,
+		Addr:      strings.Join(ob.opts.Addr, ","),
+		Op:        "INSERT",
+		BatchSize: ob.rows,
+		Params:    nil,
+	}
+	clickhouseInstrumenter.StartAndEnd(context.Background(), request, nil, err, startTime, time.Now())
+	return err
 }
 
 func (oc *OtelCon) Exec(ctx context.Context, query string, args ...any) error {
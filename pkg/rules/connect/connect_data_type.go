@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import "strings"
+
+type connectRequest struct {
+	procedure     string
+	serverAddress string
+}
+
+// connectResponse carries the error of the call, the server spans are ended
+// without an error so that connectStatusCodeExtractor decides their status.
+type connectResponse struct {
+	err error
+}
+
+// service and method split a procedure like /acme.foo.v1.FooService/Bar.
+func (r connectRequest) service() string {
+	procedure := strings.TrimPrefix(r.procedure, "/")
+	if i := strings.LastIndex(procedure, "/"); i >= 0 {
+		return procedure[:i]
+	}
+	return ""
+}
+
+func (r connectRequest) method() string {
+	if i := strings.LastIndex(r.procedure, "/"); i >= 0 {
+		return r.procedure[i+1:]
+	}
+	return r.procedure
+}
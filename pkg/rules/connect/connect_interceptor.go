@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"connectrpc.com/connect"
+)
+
+var (
+	connectClientInstrumenter = BuildConnectClientInstrumenter()
+	connectServerInstrumenter = BuildConnectServerInstrumenter()
+)
+
+// connectInterceptor is installed as the outermost interceptor of every
+// client and handler, serverAddress is only known by the clients.
+type connectInterceptor struct {
+	serverAddress string
+}
+
+var _ connect.Interceptor = connectInterceptor{}
+
+func (i connectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !connectEnabler.Enable() {
+			return next(ctx, req)
+		}
+		spec := req.Spec()
+		if spec.IsClient {
+			request := connectRequest{procedure: spec.Procedure, serverAddress: i.serverAddress}
+			ctx = connectClientInstrumenter.Start(ctx, request)
+			resp, err := next(ctx, req)
+			connectClientInstrumenter.End(ctx, request, connectResponse{err: err}, err)
+			return resp, err
+		}
+		request := connectRequest{procedure: spec.Procedure}
+		ctx = connectServerInstrumenter.Start(ctx, request)
+		resp, err := next(ctx, req)
+		connectServerInstrumenter.End(ctx, request, connectResponse{err: err}, nil)
+		return resp, err
+	}
+}
+
+func (i connectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		if !connectEnabler.Enable() {
+			return next(ctx, spec)
+		}
+		request := connectRequest{procedure: spec.Procedure, serverAddress: i.serverAddress}
+		ctx = connectClientInstrumenter.Start(ctx, request)
+		return &connectStreamingClientConn{
+			StreamingClientConn: next(ctx, spec),
+			ctx:                 ctx,
+			request:             request,
+		}
+	}
+}
+
+func (i connectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !connectEnabler.Enable() {
+			return next(ctx, conn)
+		}
+		request := connectRequest{procedure: conn.Spec().Procedure}
+		ctx = connectServerInstrumenter.Start(ctx, request)
+		err := next(ctx, conn)
+		connectServerInstrumenter.End(ctx, request, connectResponse{err: err}, nil)
+		return err
+	}
+}
+
+// connectStreamingClientConn ends the span of a streaming call when its
+// response is closed, the first error of the stream is recorded.
+type connectStreamingClientConn struct {
+	connect.StreamingClientConn
+	ctx     context.Context
+	request connectRequest
+	mu      sync.Mutex
+	err     error
+	once    sync.Once
+}
+
+func (c *connectStreamingClientConn) recordError(err error) {
+	if err == nil || errors.Is(err, io.EOF) {
+		return
+	}
+	c.mu.Lock()
+	if c.err == nil {
+		c.err = err
+	}
+	c.mu.Unlock()
+}
+
+func (c *connectStreamingClientConn) Send(msg any) error {
+	err := c.StreamingClientConn.Send(msg)
+	c.recordError(err)
+	return err
+}
+
+func (c *connectStreamingClientConn) Receive(msg any) error {
+	err := c.StreamingClientConn.Receive(msg)
+	c.recordError(err)
+	return err
+}
+
+func (c *connectStreamingClientConn) CloseResponse() error {
+	err := c.StreamingClientConn.CloseResponse()
+	c.once.Do(func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		connectClientInstrumenter.End(c.ctx, c.request, connectResponse{err: c.err}, c.err)
+	})
+	return err
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type connectInnerEnabler struct {
+	enabled bool
+}
+
+func (c connectInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var connectEnabler = connectInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CONNECT_ENABLED") != "false"}
+
+type connectAttrsGetter struct {
+}
+
+func (c connectAttrsGetter) GetSystem(request connectRequest) string {
+	return "connect_rpc"
+}
+
+func (c connectAttrsGetter) GetService(request connectRequest) string {
+	return request.service()
+}
+
+func (c connectAttrsGetter) GetMethod(request connectRequest) string {
+	return request.method()
+}
+
+func (c connectAttrsGetter) GetServerAddress(request connectRequest) string {
+	return request.serverAddress
+}
+
+func (c connectAttrsGetter) GetErrorCode(request connectRequest, response connectResponse, err error) string {
+	if response.err == nil {
+		return ""
+	}
+	return connect.CodeOf(response.err).String()
+}
+
+func (c connectAttrsGetter) GetErrorCodeKey(request connectRequest) attribute.Key {
+	return semconv.RPCConnectRPCErrorCodeKey
+}
+
+// connectStatusCodeExtractor follows the grpc semantic conventions: every
+// error fails a client span, while a server span only fails for the codes
+// that are caused by the server rather than by the client.
+type connectStatusCodeExtractor struct {
+	server bool
+}
+
+func (c connectStatusCodeExtractor) Extract(span trace.Span, request connectRequest, response connectResponse, err error) {
+	if response.err == nil {
+		return
+	}
+	if c.server {
+		switch connect.CodeOf(response.err) {
+		case connect.CodeUnknown, connect.CodeDeadlineExceeded, connect.CodeUnimplemented,
+			connect.CodeInternal, connect.CodeUnavailable, connect.CodeDataLoss:
+			span.RecordError(response.err)
+		default:
+			return
+		}
+	}
+	span.SetStatus(codes.Error, response.err.Error())
+}
+
+// the trace context is propagated by the http client and server spans that
+// carry the calls, the connect spans are their parents and children
+func BuildConnectClientInstrumenter() instrumenter.Instrumenter[connectRequest, connectResponse] {
+	builder := instrumenter.Builder[connectRequest, connectResponse]{}
+	getter := connectAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[connectRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[connectRequest]{}).
+		SetSpanStatusExtractor(connectStatusCodeExtractor{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[connectRequest, connectResponse, connectAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CONNECT_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(rpc.RpcClientMetrics("connect.client")).
+		BuildInstrumenter()
+}
+
+func BuildConnectServerInstrumenter() instrumenter.Instrumenter[connectRequest, connectResponse] {
+	builder := instrumenter.Builder[connectRequest, connectResponse]{}
+	getter := connectAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[connectRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[connectRequest]{}).
+		SetSpanStatusExtractor(connectStatusCodeExtractor{server: true}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[connectRequest, connectResponse, connectAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CONNECT_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(rpc.RpcServerMetrics("connect.server")).
+		BuildInstrumenter()
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	"net/url"
+	_ "unsafe"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+)
+
+// func newClientConfig(rawURL string, options []ClientOption) (*clientConfig, *Error)
+//
+//go:linkname connectNewClientConfigOnEnter connectrpc.com/connect.connectNewClientConfigOnEnter
+func connectNewClientConfigOnEnter(call api.CallContext, rawURL string, options []connect.ClientOption) {
+	if !connectEnabler.Enable() {
+		return
+	}
+	interceptor := connectInterceptor{}
+	if u, err := url.Parse(rawURL); err == nil {
+		interceptor.serverAddress = u.Host
+	}
+	// the first interceptor is the outermost one
+	call.SetParam(1, append([]connect.ClientOption{connect.WithInterceptors(interceptor)}, options...))
+}
+
+// func newHandlerConfig(procedure string, streamType StreamType, options []HandlerOption) *handlerConfig
+//
+//go:linkname connectNewHandlerConfigOnEnter connectrpc.com/connect.connectNewHandlerConfigOnEnter
+func connectNewHandlerConfigOnEnter(call api.CallContext, procedure string, streamType connect.StreamType, options []connect.HandlerOption) {
+	if !connectEnabler.Enable() {
+		return
+	}
+	call.SetParam(2, append([]connect.HandlerOption{connect.WithInterceptors(connectInterceptor{})}, options...))
+}
@@ -14,11 +14,32 @@
 
 package databasesql
 
+import (
+	"log"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+)
+
 type databaseSqlRequest struct {
 	opType     string
 	sql        string
 	endpoint   string
 	driverName string
-	dsn        string
+	dsnInfo    db.DSNInfo
 	params     []any
 }
+
+func parseDSN(driverName, dsn string) db.DSNInfo {
+	info, err := db.ParseDSN(driverName, dsn)
+	if err != nil {
+		log.Printf("failed to parse dsn: %v", err)
+	}
+	return info
+}
+
+// dsnInfoOf returns the DSN info stored on a DB, Conn, Tx or Stmt, which is
+// missing when the DB has been opened by sql.OpenDB.
+func dsnInfoOf(v interface{}) db.DSNInfo {
+	info, _ := v.(db.DSNInfo)
+	return info
+}
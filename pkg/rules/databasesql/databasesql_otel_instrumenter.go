@@ -26,13 +26,8 @@ type databaseSqlAttrsGetter struct {
 }
 
 func (d databaseSqlAttrsGetter) GetSystem(request databaseSqlRequest) string {
-	switch request.driverName {
-	case "mysql":
-		return "mysql"
-	case "postgres":
-		fallthrough
-	case "postgresql":
-		return "postgresql"
+	if system := db.SystemName(request.driverName); system != "" {
+		return system
 	}
 	return "database"
 }
@@ -41,6 +36,10 @@ func (d databaseSqlAttrsGetter) GetServerAddress(request databaseSqlRequest) str
 	return request.endpoint
 }
 
+func (d databaseSqlAttrsGetter) GetServerPort(request databaseSqlRequest) int {
+	return request.dsnInfo.Port
+}
+
 func (d databaseSqlAttrsGetter) GetStatement(request databaseSqlRequest) string {
 	// Fetch metadata along with the SQL
 	// Retrieve db collection only, not the params which is an experimental feature that will introduce some overhead.
@@ -67,7 +66,7 @@ func (d databaseSqlAttrsGetter) GetParameters(request databaseSqlRequest) []any
 }
 
 func (d databaseSqlAttrsGetter) GetDbNamespace(request databaseSqlRequest) string {
-	return ""
+	return request.dsnInfo.DBName
 }
 
 func (d databaseSqlAttrsGetter) GetBatchSize(request databaseSqlRequest) int {
@@ -57,14 +57,13 @@ func beforeOpenInstrumentation(call api.CallContext, driverName, dataSourceName
 	if !dbSqlEnabler.Enable() {
 		return
 	}
-	addr, err := parseDSN(driverName, dataSourceName)
-	if err != nil {
-		log.Printf("failed to parse dsn: %v", err)
-	}
-	call.SetData(map[string]string{
-		"endpoint": addr,
+	// The DSN is parsed once here, only the parsed info is kept, the
+	// password is never stored.
+	info := parseDSN(driverName, dataSourceName)
+	call.SetData(map[string]interface{}{
+		"endpoint": info.Host,
 		"driver":   driverName,
-		"dsn":      dataSourceName,
+		"dsnInfo":  info,
 	})
 }
 
@@ -76,22 +75,19 @@ func afterOpenInstrumentation(call api.CallContext, db *sql.DB, err error) {
 	if db == nil {
 		return
 	}
-	data, ok := call.GetData().(map[string]string)
+	data, ok := call.GetData().(map[string]interface{})
 	if !ok {
 		return
 	}
-	endpoint, ok := data["endpoint"]
+	endpoint, ok := data["endpoint"].(string)
 	if ok {
 		db.Endpoint = endpoint
 	}
-	driver, ok := data["driver"]
+	driver, ok := data["driver"].(string)
 	if ok {
 		db.DriverName = driver
 	}
-	dsn, ok := data["dsn"]
-	if ok {
-		db.DSN = dsn
-	}
+	db.DSNInfo = data["dsnInfo"]
 }
 
 //go:linkname beforePingContextInstrumentation database/sql.beforePingContextInstrumentation
@@ -102,7 +98,7 @@ func beforePingContextInstrumentation(call api.CallContext, db *sql.DB, ctx cont
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "ping", "ping", db.Endpoint, db.DriverName, db.DSN)
+	instrumentStart(call, ctx, "ping", "ping", db.Endpoint, db.DriverName, db.DSNInfo)
 }
 
 //go:linkname afterPingContextInstrumentation database/sql.afterPingContextInstrumentation
@@ -121,11 +117,11 @@ func beforePrepareContextInstrumentation(call api.CallContext, db *sql.DB, ctx c
 	if db == nil {
 		return
 	}
-	call.SetData(map[string]string{
+	call.SetData(map[string]interface{}{
 		"endpoint": db.Endpoint,
 		"sql":      query,
 		"driver":   db.DriverName,
-		"dsn":      db.DSN,
+		"dsnInfo":  db.DSNInfo,
 	})
 }
 
@@ -137,16 +133,19 @@ func afterPrepareContextInstrumentation(call api.CallContext, stmt *sql.Stmt, er
 	if stmt == nil {
 		return
 	}
-	callDataMap, ok := call.GetData().(map[string]string)
+	callDataMap, ok := call.GetData().(map[string]interface{})
 	if !ok {
 		return
 	}
+	endpoint, _ := callDataMap["endpoint"].(string)
+	query, _ := callDataMap["sql"].(string)
+	driverName, _ := callDataMap["driver"].(string)
 	stmt.Data = map[string]string{
-		"endpoint": callDataMap["endpoint"],
-		"sql":      callDataMap["sql"],
-		"driver":   callDataMap["driver"],
+		"endpoint": endpoint,
+		"sql":      query,
+		"driver":   driverName,
 	}
-	stmt.DSN = callDataMap["dsn"]
+	stmt.DSNInfo = callDataMap["dsnInfo"]
 }
 
 //go:linkname beforeExecContextInstrumentation database/sql.beforeExecContextInstrumentation
@@ -157,7 +156,7 @@ func beforeExecContextInstrumentation(call api.CallContext, db *sql.DB, ctx cont
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "exec", query, db.Endpoint, db.DriverName, db.DSN, args...)
+	instrumentStart(call, ctx, "exec", query, db.Endpoint, db.DriverName, db.DSNInfo, args...)
 }
 
 //go:linkname afterExecContextInstrumentation database/sql.afterExecContextInstrumentation
@@ -176,7 +175,7 @@ func beforeQueryContextInstrumentation(call api.CallContext, db *sql.DB, ctx con
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "query", query, db.Endpoint, db.DriverName, db.DSN, args...)
+	instrumentStart(call, ctx, "query", query, db.Endpoint, db.DriverName, db.DSNInfo, args...)
 }
 
 //go:linkname afterQueryContextInstrumentation database/sql.afterQueryContextInstrumentation
@@ -195,7 +194,7 @@ func beforeTxInstrumentation(call api.CallContext, db *sql.DB, ctx context.Conte
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "begin", "START TRANSACTION", db.Endpoint, db.DriverName, db.DSN)
+	instrumentStart(call, ctx, "begin", "START TRANSACTION", db.Endpoint, db.DriverName, db.DSNInfo)
 }
 
 //go:linkname afterTxInstrumentation database/sql.afterTxInstrumentation
@@ -216,7 +215,7 @@ func afterTxInstrumentation(call api.CallContext, tx *sql.Tx, err error) {
 	}
 	tx.Endpoint = dbRequest.endpoint
 	tx.DriverName = dbRequest.driverName
-	tx.DSN = dbRequest.dsn
+	tx.DSNInfo = dbRequest.dsnInfo
 	instrumentEnd(call, err)
 }
 
@@ -228,10 +227,10 @@ func beforeConnInstrumentation(call api.CallContext, db *sql.DB, ctx context.Con
 	if db == nil {
 		return
 	}
-	call.SetData(map[string]string{
+	call.SetData(map[string]interface{}{
 		"endpoint": db.Endpoint,
 		"driver":   db.DriverName,
-		"dsn":      db.DSN,
+		"dsnInfo":  db.DSNInfo,
 	})
 }
 
@@ -243,22 +242,19 @@ func afterConnInstrumentation(call api.CallContext, conn *sql.Conn, err error) {
 	if conn == nil {
 		return
 	}
-	data, ok := call.GetData().(map[string]string)
+	data, ok := call.GetData().(map[string]interface{})
 	if !ok {
 		return
 	}
-	endpoint, ok := data["endpoint"]
+	endpoint, ok := data["endpoint"].(string)
 	if ok {
 		conn.Endpoint = endpoint
 	}
-	driverName, ok := data["driver"]
+	driverName, ok := data["driver"].(string)
 	if ok {
 		conn.DriverName = driverName
 	}
-	dsn, ok := data["dsn"]
-	if ok {
-		conn.DSN = dsn
-	}
+	conn.DSNInfo = data["dsnInfo"]
 }
 
 //go:linkname beforeConnPingContextInstrumentation database/sql.beforeConnPingContextInstrumentation
@@ -269,7 +265,7 @@ func beforeConnPingContextInstrumentation(call api.CallContext, conn *sql.Conn,
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "ping", "ping", conn.Endpoint, conn.DriverName, conn.DSN)
+	instrumentStart(call, ctx, "ping", "ping", conn.Endpoint, conn.DriverName, conn.DSNInfo)
 }
 
 //go:linkname afterConnPingContextInstrumentation database/sql.afterConnPingContextInstrumentation
@@ -288,11 +284,11 @@ func beforeConnPrepareContextInstrumentation(call api.CallContext, conn *sql.Con
 	if conn == nil {
 		return
 	}
-	call.SetData(map[string]string{
+	call.SetData(map[string]interface{}{
 		"endpoint": conn.Endpoint,
 		"sql":      query,
 		"driver":   conn.DriverName,
-		"dsn":      conn.DSN,
+		"dsnInfo":  conn.DSNInfo,
 	})
 }
 
@@ -304,16 +300,19 @@ func afterConnPrepareContextInstrumentation(call api.CallContext, stmt *sql.Stmt
 	if stmt == nil {
 		return
 	}
-	callDataMap, ok := call.GetData().(map[string]string)
+	callDataMap, ok := call.GetData().(map[string]interface{})
 	if !ok {
 		return
 	}
+	endpoint, _ := callDataMap["endpoint"].(string)
+	query, _ := callDataMap["sql"].(string)
+	driverName, _ := callDataMap["driver"].(string)
 	stmt.Data = map[string]string{
-		"endpoint": callDataMap["endpoint"],
-		"sql":      callDataMap["sql"],
-		"driver":   callDataMap["driver"],
+		"endpoint": endpoint,
+		"sql":      query,
+		"driver":   driverName,
 	}
-	stmt.DSN = callDataMap["dsn"]
+	stmt.DSNInfo = callDataMap["dsnInfo"]
 }
 
 //go:linkname beforeConnExecContextInstrumentation database/sql.beforeConnExecContextInstrumentation
@@ -324,7 +323,7 @@ func beforeConnExecContextInstrumentation(call api.CallContext, conn *sql.Conn,
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "exec", query, conn.Endpoint, conn.DriverName, conn.DSN, args...)
+	instrumentStart(call, ctx, "exec", query, conn.Endpoint, conn.DriverName, conn.DSNInfo, args...)
 }
 
 //go:linkname afterConnExecContextInstrumentation database/sql.afterConnExecContextInstrumentation
@@ -343,7 +342,7 @@ func beforeConnQueryContextInstrumentation(call api.CallContext, conn *sql.Conn,
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "query", query, conn.Endpoint, conn.DriverName, conn.DSN, args...)
+	instrumentStart(call, ctx, "query", query, conn.Endpoint, conn.DriverName, conn.DSNInfo, args...)
 }
 
 //go:linkname afterConnQueryContextInstrumentation database/sql.afterConnQueryContextInstrumentation
@@ -362,7 +361,7 @@ func beforeConnTxInstrumentation(call api.CallContext, conn *sql.Conn, ctx conte
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "start", "START TRANSACTION", conn.Endpoint, conn.DriverName, conn.DSN)
+	instrumentStart(call, ctx, "start", "START TRANSACTION", conn.Endpoint, conn.DriverName, conn.DSNInfo)
 }
 
 //go:linkname afterConnTxInstrumentation database/sql.afterConnTxInstrumentation
@@ -381,11 +380,11 @@ func beforeTxPrepareContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx
 	if tx == nil {
 		return
 	}
-	call.SetData(map[string]string{
+	call.SetData(map[string]interface{}{
 		"endpoint": tx.Endpoint,
 		"sql":      query,
 		"driver":   tx.DriverName,
-		"dsn":      tx.DSN,
+		"dsnInfo":  tx.DSNInfo,
 	})
 }
 
@@ -397,16 +396,19 @@ func afterTxPrepareContextInstrumentation(call api.CallContext, stmt *sql.Stmt,
 	if stmt == nil {
 		return
 	}
-	callDataMap, ok := call.GetData().(map[string]string)
+	callDataMap, ok := call.GetData().(map[string]interface{})
 	if !ok {
 		return
 	}
+	endpoint, _ := callDataMap["endpoint"].(string)
+	query, _ := callDataMap["sql"].(string)
+	driverName, _ := callDataMap["driver"].(string)
 	stmt.Data = map[string]string{
-		"endpoint": callDataMap["endpoint"],
-		"sql":      callDataMap["sql"],
-		"driver":   callDataMap["driver"],
+		"endpoint": endpoint,
+		"sql":      query,
+		"driver":   driverName,
 	}
-	stmt.DSN = callDataMap["dsn"]
+	stmt.DSNInfo = callDataMap["dsnInfo"]
 }
 
 //go:linkname beforeTxStmtContextInstrumentation database/sql.beforeTxStmtContextInstrumentation
@@ -417,10 +419,10 @@ func beforeTxStmtContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx co
 	if stmt == nil {
 		return
 	}
-	call.SetData(map[string]string{
+	call.SetData(map[string]interface{}{
 		"endpoint": stmt.Data["endpoint"],
 		"driver":   stmt.Data["driver"],
-		"dsn":      stmt.DSN,
+		"dsnInfo":  stmt.DSNInfo,
 	})
 }
 
@@ -432,23 +434,20 @@ func afterTxStmtContextInstrumentation(call api.CallContext, stmt *sql.Stmt) {
 	if stmt == nil {
 		return
 	}
-	data, ok := call.GetData().(map[string]string)
+	data, ok := call.GetData().(map[string]interface{})
 	if !ok {
 		return
 	}
 	stmt.Data = map[string]string{}
-	endpoint, ok := data["endpoint"]
+	endpoint, ok := data["endpoint"].(string)
 	if ok {
 		stmt.Data["endpoint"] = endpoint
 	}
-	driverName, ok := data["driver"]
+	driverName, ok := data["driver"].(string)
 	if ok {
 		stmt.Data["driver"] = driverName
 	}
-	dsn, ok := data["dsn"]
-	if ok {
-		stmt.Data["dsn"] = dsn
-	}
+	stmt.DSNInfo = data["dsnInfo"]
 }
 
 //go:linkname beforeTxExecContextInstrumentation database/sql.beforeTxExecContextInstrumentation
@@ -459,7 +458,7 @@ func beforeTxExecContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx co
 	if tx == nil {
 		return
 	}
-	instrumentStart(call, ctx, "exec", query, tx.Endpoint, tx.DriverName, tx.DSN, args...)
+	instrumentStart(call, ctx, "exec", query, tx.Endpoint, tx.DriverName, tx.DSNInfo, args...)
 }
 
 //go:linkname afterTxExecContextInstrumentation database/sql.afterTxExecContextInstrumentation
@@ -478,7 +477,7 @@ func beforeTxQueryContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx c
 	if tx == nil {
 		return
 	}
-	instrumentStart(call, ctx, "query", query, tx.Endpoint, tx.DriverName, tx.DSN, args...)
+	instrumentStart(call, ctx, "query", query, tx.Endpoint, tx.DriverName, tx.DSNInfo, args...)
 }
 
 //go:linkname afterTxQueryContextInstrumentation database/sql.afterTxQueryContextInstrumentation
@@ -497,7 +496,7 @@ func beforeTxCommitInstrumentation(call api.CallContext, tx *sql.Tx) {
 	if tx == nil {
 		return
 	}
-	instrumentStart(call, context.Background(), "commit", "COMMIT", tx.Endpoint, tx.DriverName, tx.DSN)
+	instrumentStart(call, context.Background(), "commit", "COMMIT", tx.Endpoint, tx.DriverName, tx.DSNInfo)
 }
 
 //go:linkname afterTxCommitInstrumentation database/sql.afterTxCommitInstrumentation
@@ -516,7 +515,7 @@ func beforeTxRollbackInstrumentation(call api.CallContext, tx *sql.Tx) {
 	if tx == nil {
 		return
 	}
-	instrumentStart(call, context.Background(), "rollback", "ROLLBACK", tx.Endpoint, tx.DriverName, tx.DSN)
+	instrumentStart(call, context.Background(), "rollback", "ROLLBACK", tx.Endpoint, tx.DriverName, tx.DSNInfo)
 }
 
 //go:linkname afterTxRollbackInstrumentation database/sql.afterTxRollbackInstrumentation
@@ -535,11 +534,11 @@ func beforeStmtExecContextInstrumentation(call api.CallContext, stmt *sql.Stmt,
 	if stmt == nil {
 		return
 	}
-	sql, endpoint, driverName, dsn := "", "", "", ""
+	sql, endpoint, driverName := "", "", ""
 	if stmt.Data != nil {
-		sql, endpoint, driverName, dsn = stmt.Data["sql"], stmt.Data["endpoint"], stmt.Data["driver"], stmt.DSN
+		sql, endpoint, driverName = stmt.Data["sql"], stmt.Data["endpoint"], stmt.Data["driver"]
 	}
-	instrumentStart(call, ctx, "exec", sql, endpoint, driverName, dsn, args...)
+	instrumentStart(call, ctx, "exec", sql, endpoint, driverName, stmt.DSNInfo, args...)
 }
 
 //go:linkname afterStmtExecContextInstrumentation database/sql.afterStmtExecContextInstrumentation
@@ -558,11 +557,11 @@ func beforeStmtQueryContextInstrumentation(call api.CallContext, stmt *sql.Stmt,
 	if stmt == nil {
 		return
 	}
-	sql, endpoint, driverName, dsn := "", "", "", ""
+	sql, endpoint, driverName := "", "", ""
 	if stmt.Data != nil {
-		sql, endpoint, driverName, dsn = stmt.Data["sql"], stmt.Data["endpoint"], stmt.Data["driver"], stmt.DSN
+		sql, endpoint, driverName = stmt.Data["sql"], stmt.Data["endpoint"], stmt.Data["driver"]
 	}
-	instrumentStart(call, ctx, "query", sql, endpoint, driverName, dsn, args...)
+	instrumentStart(call, ctx, "query", sql, endpoint, driverName, stmt.DSNInfo, args...)
 }
 
 //go:linkname afterStmtQueryContextInstrumentation database/sql.afterStmtQueryContextInstrumentation
@@ -572,13 +571,13 @@ func afterStmtQueryContextInstrumentation(call api.CallContext, rows *sql.Rows,
 	}
 	instrumentEnd(call, err)
 }
-func instrumentStart(call api.CallContext, ctx context.Context, spanName, query, endpoint, driverName, dsn string, args ...any) {
+func instrumentStart(call api.CallContext, ctx context.Context, spanName, query, endpoint, driverName string, dsnInfo interface{}, args ...any) {
 	req := databaseSqlRequest{
 		opType:     calOp(query),
 		sql:        query,
 		endpoint:   endpoint,
 		driverName: driverName,
-		dsn:        dsn,
+		dsnInfo:    dsnInfoOf(dsnInfo),
 		params:     args,
 	}
 	newCtx := databaseSqlInstrumenter.Start(ctx, req)
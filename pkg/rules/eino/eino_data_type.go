@@ -38,6 +38,7 @@ type (
 	retrieverRequestKey struct{}
 	loaderRequestKey    struct{}
 	toolRequestKey      struct{}
+	toolCallRequestKey  struct{}
 	transformRequestKey struct{}
 )
 
@@ -88,6 +89,7 @@ type einoLLMResponse struct {
 	usageTotalTokens      int64
 	responseID            string
 	output                string
+	outputMessage         *schema.Message
 }
 
 type ChatModelConfig struct {
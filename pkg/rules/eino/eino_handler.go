@@ -25,6 +25,7 @@ import (
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/bytedance/sonic"
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components/document"
@@ -34,6 +35,7 @@ import (
 	"github.com/cloudwego/eino/components/prompt"
 	"github.com/cloudwego/eino/components/retriever"
 	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/schema"
 	callbacksutils "github.com/cloudwego/eino/utils/callbacks"
 )
@@ -41,6 +43,7 @@ import (
 var (
 	einoLLMInstrument    = BuildEinoLLMInstrumenter()
 	einoCommonInstrument = BuildEinoCommonInstrumenter()
+	einoToolInstrument   = ai.BuildToolCallInstrumenter(utils.EINO_SCOPE_NAME)
 )
 
 func einoModelCallHandler(config ChatModelConfig) *callbacksutils.ModelCallbackHandler {
@@ -85,6 +88,7 @@ func einoModelCallHandler(config ChatModelConfig) *callbacksutils.ModelCallbackH
 				}
 				if output.Message != nil {
 					response.output = output.Message.Content
+					response.outputMessage = output.Message
 				}
 			}
 			einoLLMInstrument.End(ctx, request, response, nil)
@@ -132,6 +136,7 @@ func einoModelCallHandler(config ChatModelConfig) *callbacksutils.ModelCallbackH
 					if err == nil {
 						response.responseFinishReasons = []string{message.ResponseMeta.FinishReason}
 						response.output = message.Content
+						response.outputMessage = message
 					}
 				}
 				if usage != nil {
@@ -342,34 +347,29 @@ func einoLoaderCallbackHandler() *callbacksutils.LoaderCallbackHandler {
 func einoToolCallbackHandler() *callbacksutils.ToolCallbackHandler {
 	return &callbacksutils.ToolCallbackHandler{
 		OnStart: func(ctx context.Context, info *callbacks.RunInfo, input *tool.CallbackInput) context.Context {
-			request := einoRequest{
-				operationName: OperationNameExecuteTool,
-				input:         make(map[string]any),
+			request := ai.ToolCallRequest{
+				System: "eino",
+				Name:   info.Name,
+				CallID: compose.GetToolCallID(ctx),
+				Type:   "function",
 			}
-			request.input["tool_name"] = info.Name
 			if input != nil {
-				request.input["arguments"] = input.ArgumentsInJSON
+				request.Arguments = input.ArgumentsInJSON
 			}
-			return startCommonInstrumentation(ctx, toolRequestKey{}, request, einoCommonInstrument)
+			ctx = einoToolInstrument.Start(ctx, request)
+			return context.WithValue(ctx, toolCallRequestKey{}, request)
 		},
 		OnEnd: func(ctx context.Context, info *callbacks.RunInfo, output *tool.CallbackOutput) context.Context {
-			request := ctx.Value(toolRequestKey{}).(einoRequest)
-			response := einoResponse{
-				operationName: OperationNameExecuteTool,
-				output:        make(map[string]any),
-			}
+			request := ctx.Value(toolCallRequestKey{}).(ai.ToolCallRequest)
+			response := ai.ToolCallResponse{}
 			if output != nil {
-				response.output["response"] = output.Response
+				response.Result = output.Response
 			}
-			einoCommonInstrument.End(ctx, request, response, nil)
+			einoToolInstrument.End(ctx, request, response, nil)
 			return ctx
 		},
 		OnEndWithStreamOutput: func(ctx context.Context, info *callbacks.RunInfo, output *schema.StreamReader[*tool.CallbackOutput]) context.Context {
-			request := ctx.Value(toolRequestKey{}).(einoRequest)
-			response := einoResponse{
-				operationName: OperationNameExecuteTool,
-				output:        make(map[string]any),
-			}
+			request := ctx.Value(toolCallRequestKey{}).(ai.ToolCallRequest)
 			go func() {
 				defer func() {
 					err := recover()
@@ -398,15 +398,13 @@ func einoToolCallbackHandler() *callbacksutils.ToolCallbackHandler {
 						toolResp += out.Response
 					}
 				}
-				response.output["response"] = toolResp
-				einoCommonInstrument.End(ctx, request, response, nil)
+				einoToolInstrument.End(ctx, request, ai.ToolCallResponse{Result: toolResp}, nil)
 			}()
 			return ctx
 		},
 		OnError: func(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
-			request := ctx.Value(toolRequestKey{}).(einoRequest)
-			response := einoResponse{operationName: OperationNameExecuteTool}
-			einoCommonInstrument.End(ctx, request, response, err)
+			request := ctx.Value(toolCallRequestKey{}).(ai.ToolCallRequest)
+			einoToolInstrument.End(ctx, request, ai.ToolCallResponse{}, err)
 			return ctx
 		},
 	}
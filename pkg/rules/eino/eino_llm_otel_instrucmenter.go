@@ -22,15 +22,19 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"github.com/cloudwego/eino/schema"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	semconv7 "go.opentelemetry.io/otel/semconv/v1.37.0"
 )
 
 var _ ai.CommonAttrsGetter[einoLLMRequest, any] = einoLLMAttrsGetter{}
 
 var _ ai.LLMAttrsGetter[einoLLMRequest, einoLLMResponse] = einoLLMAttrsGetter{}
 
+var _ ai.MessagesAttrsGetter[einoLLMRequest, einoLLMResponse] = einoLLMAttrsGetter{}
+
 type einoLLMAttrsGetter struct{}
 
 func (e einoLLMAttrsGetter) GetAIOperationName(request einoLLMRequest) string {
@@ -93,10 +97,16 @@ func (l LLMExperimentalAttributeExtractor) OnStart(attributes []attribute.KeyVal
 		Key:   semconv.GenAIRequestSeedKey,
 		Value: attribute.Int64Value(l.LLMGetter.GetAIRequestSeed(request)),
 	})
-	for i, in := range request.input {
-		if in != nil && len(in.Content) > 0 {
-			attributes = append(attributes, attribute.String(fmt.Sprintf("gen_ai.prompt.%d.role", i), string(in.Role)))
-			attributes = append(attributes, attribute.String(fmt.Sprintf("gen_ai.prompt.%d.content", i), in.Content))
+	if ai.CaptureMessageContent() {
+		if messages := ai.MarshalMessages(l.LLMGetter.GetAIInputMessages(request)); messages != "" {
+			attributes = append(attributes, attribute.String(string(semconv7.GenAIInputMessagesKey), messages))
+		}
+	} else if ai.RecordMessageContent() {
+		for i, in := range request.input {
+			if in != nil && len(in.Content) > 0 {
+				attributes = append(attributes, attribute.String(fmt.Sprintf("gen_ai.prompt.%d.role", i), string(in.Role)))
+				attributes = append(attributes, attribute.String(fmt.Sprintf("gen_ai.prompt.%d.content", i), ai.RedactContent(in.Content)))
+			}
 		}
 	}
 	if l.Base.AttributesFilter != nil {
@@ -123,8 +133,16 @@ func (l LLMExperimentalAttributeExtractor) OnEnd(attributes []attribute.KeyValue
 	}, attribute.KeyValue{
 		Key:   semconv.GenAIUsageOutputTokensKey,
 		Value: attribute.Int64Value(l.LLMGetter.GetAIUsageOutputTokens(request, response)),
-	}, attribute.String("gen_ai.completion.0.content", response.output),
-		attribute.Int64("gen_ai.usage.total_tokens", response.usageTotalTokens))
+	}, attribute.Int64("gen_ai.usage.total_tokens", response.usageTotalTokens))
+	outputMessages := l.LLMGetter.GetAIOutputMessages(request, response)
+	ai.RecordToolCalls(ctx, outputMessages)
+	if ai.CaptureMessageContent() {
+		if messages := ai.MarshalMessages(outputMessages); messages != "" {
+			attributes = append(attributes, attribute.String(string(semconv7.GenAIOutputMessagesKey), messages))
+		}
+	} else if ai.RecordMessageContent() {
+		attributes = append(attributes, attribute.String("gen_ai.completion.0.content", ai.RedactContent(response.output)))
+	}
 
 	return attributes, ctx
 }
@@ -193,6 +211,53 @@ func (e einoLLMAttrsGetter) GetAIOutput(response einoLLMResponse) string {
 	return response.output
 }
 
+func (e einoLLMAttrsGetter) GetAISystemInstructions(request einoLLMRequest) []ai.MessagePart {
+	return nil
+}
+
+func (e einoLLMAttrsGetter) GetAIInputMessages(request einoLLMRequest) []ai.ChatMessage {
+	messages := make([]ai.ChatMessage, 0, len(request.input))
+	for _, message := range request.input {
+		if message != nil {
+			messages = append(messages, chatMessageFromEino(message))
+		}
+	}
+	return messages
+}
+
+func (e einoLLMAttrsGetter) GetAIOutputMessages(request einoLLMRequest, response einoLLMResponse) []ai.ChatMessage {
+	if response.outputMessage == nil {
+		return nil
+	}
+	message := chatMessageFromEino(response.outputMessage)
+	if response.outputMessage.ResponseMeta != nil {
+		message.FinishReason = response.outputMessage.ResponseMeta.FinishReason
+	}
+	return []ai.ChatMessage{message}
+}
+
+func chatMessageFromEino(message *schema.Message) ai.ChatMessage {
+	chatMessage := ai.ChatMessage{Role: string(message.Role), Name: message.Name, Parts: []ai.MessagePart{}}
+	if message.Role == schema.Tool {
+		chatMessage.Parts = append(chatMessage.Parts, ai.ToolCallResponsePart(message.ToolCallID, message.Content))
+		return chatMessage
+	}
+	if message.Content != "" {
+		chatMessage.Parts = append(chatMessage.Parts, ai.TextPart(message.Content))
+	}
+	for _, part := range message.MultiContent {
+		if part.Type == schema.ChatMessagePartTypeText {
+			chatMessage.Parts = append(chatMessage.Parts, ai.TextPart(part.Text))
+		} else {
+			chatMessage.Parts = append(chatMessage.Parts, ai.MessagePart{Type: string(part.Type)})
+		}
+	}
+	for _, toolCall := range message.ToolCalls {
+		chatMessage.Parts = append(chatMessage.Parts, ai.ToolCallPart(toolCall.ID, toolCall.Function.Name, toolCall.Function.Arguments))
+	}
+	return chatMessage
+}
+
 func BuildEinoLLMInstrumenter() instrumenter.Instrumenter[einoLLMRequest, einoLLMResponse] {
 	builder := instrumenter.Builder[einoLLMRequest, einoLLMResponse]{}
 	return builder.Init().SetSpanNameExtractor(&ai.AISpanNameExtractor[einoLLMRequest, einoLLMResponse]{Getter: einoLLMAttrsGetter{}}).
@@ -34,6 +34,10 @@ func (e elasticSearchGetter) GetServerAddress(request *esRequest) string {
 	return request.address
 }
 
+func (e elasticSearchGetter) GetServerPort(request *esRequest) int {
+	return 0
+}
+
 func (e elasticSearchGetter) GetStatement(request *esRequest) string {
 	return request.request.URL.Path
 }
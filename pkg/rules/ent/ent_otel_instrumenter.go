@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type entInnerEnabler struct {
+	enabled bool
+}
+
+func (e entInnerEnabler) Enable() bool {
+	return e.enabled
+}
+
+var entEnabler = entInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ENT_ENABLED") != "false"}
+
+type entAttrsGetter struct {
+}
+
+func (e entAttrsGetter) GetSystem(request entRequest) string {
+	return request.system
+}
+
+func (e entAttrsGetter) GetServerAddress(request entRequest) string {
+	return ""
+}
+
+func (e entAttrsGetter) GetServerPort(request entRequest) int {
+	return 0
+}
+
+// the statement is reported by the nested database/sql span
+func (e entAttrsGetter) GetStatement(request entRequest) string {
+	return ""
+}
+
+func (e entAttrsGetter) GetCollection(request entRequest) string {
+	return request.table
+}
+
+func (e entAttrsGetter) GetOperation(request entRequest) string {
+	return request.operation
+}
+
+func (e entAttrsGetter) GetParameters(request entRequest) []any {
+	return nil
+}
+
+func (e entAttrsGetter) GetDbNamespace(request entRequest) string {
+	return ""
+}
+
+func (e entAttrsGetter) GetBatchSize(request entRequest) int {
+	return request.batchSize
+}
+
+// the db.client.request.duration is recorded by the nested database/sql span,
+// so the operations have no metrics of their own
+func BuildEntInstrumenter() instrumenter.Instrumenter[entRequest, any] {
+	builder := instrumenter.Builder[entRequest, any]{}
+	getter := entAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[entRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[entRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[entRequest, any, entAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[entRequest, any, entAttrsGetter]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	_ "unsafe"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+)
+
+var entInstrumenter = BuildEntInstrumenter()
+
+// the generated clients run every operation through sqlgraph, whose specs
+// carry the table of the schema
+func entStart(call api.CallContext, ctx context.Context, drv dialect.Driver, operation, table string, batchSize int) {
+	if !entEnabler.Enable() {
+		return
+	}
+	request := entRequest{
+		operation: operation,
+		table:     table,
+		batchSize: batchSize,
+	}
+	if drv != nil {
+		request.system = db.SystemName(drv.Dialect())
+	}
+	ctx = entInstrumenter.Start(ctx, request)
+	call.SetParam(0, ctx)
+	call.SetData(map[string]interface{}{
+		"ctx":     ctx,
+		"request": request,
+	})
+}
+
+func entEnd(call api.CallContext, err error) {
+	if !entEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(entRequest)
+	if !ok {
+		return
+	}
+	entInstrumenter.End(ctx, request, nil, err)
+}
+
+func nodeTable(node *sqlgraph.NodeSpec) string {
+	if node == nil {
+		return ""
+	}
+	return node.Table
+}
+
+//go:linkname entCreateNodeOnEnter entgo.io/ent/dialect/sql/sqlgraph.entCreateNodeOnEnter
+func entCreateNodeOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.CreateSpec) {
+	if spec == nil {
+		return
+	}
+	entStart(call, ctx, drv, "INSERT", spec.Table, 0)
+}
+
+//go:linkname entBatchCreateOnEnter entgo.io/ent/dialect/sql/sqlgraph.entBatchCreateOnEnter
+func entBatchCreateOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.BatchCreateSpec) {
+	if spec == nil || len(spec.Nodes) == 0 || spec.Nodes[0] == nil {
+		return
+	}
+	entStart(call, ctx, drv, "INSERT", spec.Nodes[0].Table, len(spec.Nodes))
+}
+
+//go:linkname entQueryNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.entQueryNodesOnEnter
+func entQueryNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.QuerySpec) {
+	if spec == nil {
+		return
+	}
+	entStart(call, ctx, drv, "SELECT", nodeTable(spec.Node), 0)
+}
+
+//go:linkname entCountNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.entCountNodesOnEnter
+func entCountNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.QuerySpec) {
+	if spec == nil {
+		return
+	}
+	entStart(call, ctx, drv, "SELECT", nodeTable(spec.Node), 0)
+}
+
+//go:linkname entUpdateNodeOnEnter entgo.io/ent/dialect/sql/sqlgraph.entUpdateNodeOnEnter
+func entUpdateNodeOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.UpdateSpec) {
+	if spec == nil {
+		return
+	}
+	entStart(call, ctx, drv, "UPDATE", nodeTable(spec.Node), 0)
+}
+
+//go:linkname entUpdateNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.entUpdateNodesOnEnter
+func entUpdateNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.UpdateSpec) {
+	if spec == nil {
+		return
+	}
+	entStart(call, ctx, drv, "UPDATE", nodeTable(spec.Node), 0)
+}
+
+//go:linkname entDeleteNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.entDeleteNodesOnEnter
+func entDeleteNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.DeleteSpec) {
+	if spec == nil {
+		return
+	}
+	entStart(call, ctx, drv, "DELETE", nodeTable(spec.Node), 0)
+}
+
+// func CreateNode(ctx context.Context, drv dialect.Driver, spec *CreateSpec) error
+//
+//go:linkname entCreateNodeOnExit entgo.io/ent/dialect/sql/sqlgraph.entCreateNodeOnExit
+func entCreateNodeOnExit(call api.CallContext, err error) {
+	entEnd(call, err)
+}
+
+// func BatchCreate(ctx context.Context, drv dialect.Driver, spec *BatchCreateSpec) error
+//
+//go:linkname entBatchCreateOnExit entgo.io/ent/dialect/sql/sqlgraph.entBatchCreateOnExit
+func entBatchCreateOnExit(call api.CallContext, err error) {
+	entEnd(call, err)
+}
+
+// func QueryNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) error
+//
+//go:linkname entQueryNodesOnExit entgo.io/ent/dialect/sql/sqlgraph.entQueryNodesOnExit
+func entQueryNodesOnExit(call api.CallContext, err error) {
+	entEnd(call, err)
+}
+
+// func UpdateNode(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) error
+//
+//go:linkname entUpdateNodeOnExit entgo.io/ent/dialect/sql/sqlgraph.entUpdateNodeOnExit
+func entUpdateNodeOnExit(call api.CallContext, err error) {
+	entEnd(call, err)
+}
+
+// func CountNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int, error)
+//
+//go:linkname entCountNodesOnExit entgo.io/ent/dialect/sql/sqlgraph.entCountNodesOnExit
+func entCountNodesOnExit(call api.CallContext, n int, err error) {
+	entEnd(call, err)
+}
+
+// func UpdateNodes(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) (int, error)
+//
+//go:linkname entUpdateNodesOnExit entgo.io/ent/dialect/sql/sqlgraph.entUpdateNodesOnExit
+func entUpdateNodesOnExit(call api.CallContext, n int, err error) {
+	entEnd(call, err)
+}
+
+// func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int, error)
+//
+//go:linkname entDeleteNodesOnExit entgo.io/ent/dialect/sql/sqlgraph.entDeleteNodesOnExit
+func entDeleteNodesOnExit(call api.CallContext, n int, err error) {
+	entEnd(call, err)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import "os"
+
+type genaiInnerEnabler struct {
+	enabled bool
+}
+
+func (g genaiInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var genaiEnabler = genaiInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GENAI_ENABLED") != "false"}
+
+const (
+	operationNameChat       = "chat"
+	operationNameEmbeddings = "embeddings"
+)
+
+type genaiRequest struct {
+	operationName    string
+	system           string
+	model            string
+	temperature      float64
+	topK             float64
+	topP             float64
+	maxTokens        int64
+	stopSequences    []string
+	seed             int64
+	frequencyPenalty float64
+	presencePenalty  float64
+	inputMessages    string
+	isStream         bool
+	serverAddress    string
+	inputTokens      int64
+	embeddingCount   int
+}
+
+type genaiResponse struct {
+	responseID           string
+	responseModel        string
+	outputTokens         int64
+	cacheReadInputTokens int64
+	finishReasons        []string
+	outputMessages       string
+	embeddingDim         int
+}
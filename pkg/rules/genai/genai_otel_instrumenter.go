@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"context"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type genaiAttrsGetter struct{}
+
+func (genaiAttrsGetter) GetAIOperationName(request genaiRequest) string {
+	return request.operationName
+}
+
+func (genaiAttrsGetter) GetAISystem(request genaiRequest) string {
+	return request.system
+}
+
+func (genaiAttrsGetter) GetAIRequestModel(request genaiRequest) string {
+	return request.model
+}
+
+func (genaiAttrsGetter) GetAIRequestEncodingFormats(request genaiRequest) []string {
+	return nil
+}
+
+func (genaiAttrsGetter) GetAIRequestFrequencyPenalty(request genaiRequest) float64 {
+	return request.frequencyPenalty
+}
+
+func (genaiAttrsGetter) GetAIRequestPresencePenalty(request genaiRequest) float64 {
+	return request.presencePenalty
+}
+
+func (genaiAttrsGetter) GetAIResponseFinishReasons(request genaiRequest, response genaiResponse) []string {
+	return response.finishReasons
+}
+
+func (genaiAttrsGetter) GetAIResponseModel(request genaiRequest, response genaiResponse) string {
+	return response.responseModel
+}
+
+func (genaiAttrsGetter) GetAIRequestMaxTokens(request genaiRequest) int64 {
+	return request.maxTokens
+}
+
+func (genaiAttrsGetter) GetAIUsageInputTokens(request genaiRequest) int64 {
+	return request.inputTokens
+}
+
+func (genaiAttrsGetter) GetAIUsageOutputTokens(request genaiRequest, response genaiResponse) int64 {
+	return response.outputTokens
+}
+
+func (genaiAttrsGetter) GetAIRequestStopSequences(request genaiRequest) []string {
+	return request.stopSequences
+}
+
+func (genaiAttrsGetter) GetAIRequestTemperature(request genaiRequest) float64 {
+	return request.temperature
+}
+
+func (genaiAttrsGetter) GetAIRequestTopK(request genaiRequest) float64 {
+	return request.topK
+}
+
+func (genaiAttrsGetter) GetAIRequestTopP(request genaiRequest) float64 {
+	return request.topP
+}
+
+func (genaiAttrsGetter) GetAIResponseID(request genaiRequest, response genaiResponse) string {
+	return response.responseID
+}
+
+func (genaiAttrsGetter) GetAIServerAddress(request genaiRequest) string {
+	return request.serverAddress
+}
+
+func (genaiAttrsGetter) GetAIRequestSeed(request genaiRequest) int64 {
+	return request.seed
+}
+
+func (genaiAttrsGetter) GetAIInput(request genaiRequest) string {
+	return request.inputMessages
+}
+
+func (genaiAttrsGetter) GetAIOutput(response genaiResponse) string {
+	return response.outputMessages
+}
+
+// genaiUsageAttrsExtractor records the cached prompt tokens of content
+// generation and the shape of embeddings.
+type genaiUsageAttrsExtractor struct{}
+
+func (g *genaiUsageAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request genaiRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (g *genaiUsageAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request genaiRequest, response genaiResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.cacheReadInputTokens > 0 {
+		attributes = append(attributes, ai.GenAIUsageCacheReadInputTokensKey.Int64(response.cacheReadInputTokens))
+	}
+	if request.operationName == operationNameEmbeddings {
+		attributes = append(attributes,
+			attribute.Int("gen_ai.embedding.count", request.embeddingCount),
+			attribute.Int("gen_ai.embedding.dimensions", response.embeddingDim),
+		)
+	}
+	return attributes, ctx
+}
+
+func BuildGenAIClientInstrumenter() instrumenter.Instrumenter[genaiRequest, genaiResponse] {
+	builder := instrumenter.Builder[genaiRequest, genaiResponse]{}
+	getter := genaiAttrsGetter{}
+	return builder.Init().
+		SetSpanNameExtractor(&ai.AISpanNameExtractor[genaiRequest, genaiResponse]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[genaiRequest]{}).
+		AddAttributesExtractor(&ai.AILLMAttrsExtractor[genaiRequest, genaiResponse, genaiAttrsGetter, genaiAttrsGetter]{
+			Base: ai.AICommonAttrsExtractor[genaiRequest, genaiResponse, genaiAttrsGetter]{
+				CommonGetter: getter,
+			},
+			LLMGetter: getter,
+		}).
+		AddAttributesExtractor(&genaiUsageAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GENAI_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(ai.AIClientMetrics("genai-client")).
+		BuildInstrumenter()
+}
+
+var genaiInstrumenter = BuildGenAIClientInstrumenter()
@@ -0,0 +1,277 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	googlegenai "google.golang.org/genai"
+)
+
+// clientConfigOf returns the unexported client config a Models service is
+// bound to.
+func clientConfigOf(m googlegenai.Models) *googlegenai.ClientConfig {
+	apiClient := reflect.ValueOf(m).FieldByName("apiClient")
+	if !apiClient.IsValid() || apiClient.IsNil() {
+		return nil
+	}
+	clientConfig := apiClient.Elem().FieldByName("clientConfig")
+	if !clientConfig.IsValid() || clientConfig.IsNil() {
+		return nil
+	}
+	return (*googlegenai.ClientConfig)(unsafe.Pointer(clientConfig.Pointer()))
+}
+
+func newGenAIRequest(m googlegenai.Models, operationName string, model string, contents []*googlegenai.Content) genaiRequest {
+	request := genaiRequest{
+		operationName: operationName,
+		system:        "gemini",
+		model:         strings.TrimPrefix(model, "models/"),
+	}
+	if cc := clientConfigOf(m); cc != nil {
+		if cc.Backend == googlegenai.BackendVertexAI {
+			request.system = "vertex_ai"
+		}
+		if u, err := url.Parse(cc.HTTPOptions.BaseURL); err == nil {
+			request.serverAddress = u.Host
+		}
+	}
+	if input, err := json.Marshal(contents); err == nil {
+		request.inputMessages = string(input)
+	}
+	return request
+}
+
+func newGenerateContentRequest(m googlegenai.Models, model string, contents []*googlegenai.Content, config *googlegenai.GenerateContentConfig, isStream bool) genaiRequest {
+	request := newGenAIRequest(m, operationNameChat, model, contents)
+	request.isStream = isStream
+	if config == nil {
+		return request
+	}
+	if config.Temperature != nil {
+		request.temperature = float64(*config.Temperature)
+	}
+	if config.TopK != nil {
+		request.topK = float64(*config.TopK)
+	}
+	if config.TopP != nil {
+		request.topP = float64(*config.TopP)
+	}
+	if config.Seed != nil {
+		request.seed = int64(*config.Seed)
+	}
+	if config.FrequencyPenalty != nil {
+		request.frequencyPenalty = float64(*config.FrequencyPenalty)
+	}
+	if config.PresencePenalty != nil {
+		request.presencePenalty = float64(*config.PresencePenalty)
+	}
+	request.maxTokens = int64(config.MaxOutputTokens)
+	request.stopSequences = config.StopSequences
+	return request
+}
+
+// fillGenerateContentResponse copies a generate content response into the
+// request and response. For streams it is called once per chunk, the usage
+// and finish reasons of the last chunks being the final ones.
+func fillGenerateContentResponse(request *genaiRequest, response *genaiResponse, resp *googlegenai.GenerateContentResponse) {
+	if resp.ResponseID != "" {
+		response.responseID = resp.ResponseID
+	}
+	if resp.ModelVersion != "" {
+		response.responseModel = resp.ModelVersion
+	}
+	if usage := resp.UsageMetadata; usage != nil {
+		request.inputTokens = int64(usage.PromptTokenCount)
+		response.outputTokens = int64(usage.CandidatesTokenCount)
+		response.cacheReadInputTokens = int64(usage.CachedContentTokenCount)
+	}
+	var finishReasons []string
+	// A prompt blocked by safety settings has no candidates but a block reason
+	if resp.PromptFeedback != nil && resp.PromptFeedback.BlockReason != "" {
+		finishReasons = append(finishReasons, string(resp.PromptFeedback.BlockReason))
+	}
+	for _, candidate := range resp.Candidates {
+		if candidate != nil && candidate.FinishReason != "" {
+			finishReasons = append(finishReasons, string(candidate.FinishReason))
+		}
+	}
+	if len(finishReasons) > 0 {
+		response.finishReasons = finishReasons
+	}
+}
+
+func outputMessagesOf(resp *googlegenai.GenerateContentResponse) string {
+	var contents []*googlegenai.Content
+	for _, candidate := range resp.Candidates {
+		if candidate != nil && candidate.Content != nil {
+			contents = append(contents, candidate.Content)
+		}
+	}
+	if len(contents) == 0 {
+		return ""
+	}
+	output, err := json.Marshal(contents)
+	if err != nil {
+		return ""
+	}
+	return string(output)
+}
+
+//go:linkname modelsGenerateContentOnEnter google.golang.org/genai.modelsGenerateContentOnEnter
+func modelsGenerateContentOnEnter(call api.CallContext, m googlegenai.Models, ctx context.Context, model string, contents []*googlegenai.Content, config *googlegenai.GenerateContentConfig) {
+	if !genaiEnabler.Enable() {
+		return
+	}
+	request := newGenerateContentRequest(m, model, contents, config, false)
+	ctx = genaiInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname modelsGenerateContentOnExit google.golang.org/genai.modelsGenerateContentOnExit
+func modelsGenerateContentOnExit(call api.CallContext, resp *googlegenai.GenerateContentResponse, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(genaiRequest)
+	response := genaiResponse{}
+	if err == nil && resp != nil {
+		fillGenerateContentResponse(&request, &response, resp)
+		response.outputMessages = outputMessagesOf(resp)
+	}
+	genaiInstrumenter.End(ctx, request, response, err)
+}
+
+//go:linkname modelsGenerateContentStreamOnEnter google.golang.org/genai.modelsGenerateContentStreamOnEnter
+func modelsGenerateContentStreamOnEnter(call api.CallContext, m googlegenai.Models, ctx context.Context, model string, contents []*googlegenai.Content, config *googlegenai.GenerateContentConfig) {
+	if !genaiEnabler.Enable() {
+		return
+	}
+	request := newGenerateContentRequest(m, model, contents, config, true)
+	ctx = genaiInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname modelsGenerateContentStreamOnExit google.golang.org/genai.modelsGenerateContentStreamOnExit
+func modelsGenerateContentStreamOnExit(call api.CallContext, seq interface{}) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(genaiRequest)
+	// The return value of a generic type is handed over as a pointer to it,
+	// through which the sequence is replaced by one ending the span once it
+	// has been consumed.
+	seqPtr, ok := call.GetReturnVal(0).(*iter.Seq2[*googlegenai.GenerateContentResponse, error])
+	if !ok || seqPtr == nil || *seqPtr == nil {
+		genaiInstrumenter.End(ctx, request, genaiResponse{}, nil)
+		return
+	}
+	*seqPtr = wrapGenerateContentStream(ctx, request, *seqPtr)
+}
+
+func wrapGenerateContentStream(ctx context.Context, request genaiRequest, seq iter.Seq2[*googlegenai.GenerateContentResponse, error]) iter.Seq2[*googlegenai.GenerateContentResponse, error] {
+	var once sync.Once
+	return func(yield func(*googlegenai.GenerateContentResponse, error) bool) {
+		response := genaiResponse{}
+		var output strings.Builder
+		var firstTokenTime time.Time
+		var streamErr error
+		defer once.Do(func() {
+			response.outputMessages = output.String()
+			if !firstTokenTime.IsZero() {
+				ctx = context.WithValue(ctx, ai.TimeToFirstTokenKey{}, firstTokenTime)
+			}
+			genaiInstrumenter.End(ctx, request, response, streamErr)
+		})
+		for resp, err := range seq {
+			if err != nil && !errors.Is(err, io.EOF) {
+				streamErr = err
+			}
+			if resp != nil {
+				if firstTokenTime.IsZero() {
+					firstTokenTime = time.Now()
+				}
+				fillGenerateContentResponse(&request, &response, resp)
+				output.WriteString(resp.Text())
+			}
+			if !yield(resp, err) {
+				return
+			}
+		}
+	}
+}
+
+//go:linkname modelsEmbedContentOnEnter google.golang.org/genai.modelsEmbedContentOnEnter
+func modelsEmbedContentOnEnter(call api.CallContext, m googlegenai.Models, ctx context.Context, model string, contents []*googlegenai.Content, config *googlegenai.EmbedContentConfig) {
+	if !genaiEnabler.Enable() {
+		return
+	}
+	request := newGenAIRequest(m, operationNameEmbeddings, model, contents)
+	request.embeddingCount = len(contents)
+	ctx = genaiInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname modelsEmbedContentOnExit google.golang.org/genai.modelsEmbedContentOnExit
+func modelsEmbedContentOnExit(call api.CallContext, resp *googlegenai.EmbedContentResponse, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(genaiRequest)
+	response := genaiResponse{}
+	if err == nil && resp != nil && len(resp.Embeddings) > 0 && resp.Embeddings[0] != nil {
+		response.embeddingDim = len(resp.Embeddings[0].Values)
+	}
+	genaiInstrumenter.End(ctx, request, response, err)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generativeai
+
+import "os"
+
+type generativeAIInnerEnabler struct {
+	enabled bool
+}
+
+func (g generativeAIInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var generativeAIEnabler = generativeAIInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GENERATIVE_AI_GO_ENABLED") != "false"}
+
+const (
+	operationNameChat       = "chat"
+	operationNameEmbeddings = "embeddings"
+)
+
+type generativeAIRequest struct {
+	operationName  string
+	model          string
+	temperature    float64
+	topK           float64
+	topP           float64
+	maxTokens      int64
+	stopSequences  []string
+	inputMessages  string
+	isStream       bool
+	inputTokens    int64
+	embeddingCount int
+}
+
+type generativeAIResponse struct {
+	outputTokens         int64
+	cacheReadInputTokens int64
+	finishReasons        []string
+	outputMessages       string
+	embeddingDim         int
+}
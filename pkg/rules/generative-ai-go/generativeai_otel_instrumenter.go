@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generativeai
+
+import (
+	"context"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type generativeAIAttrsGetter struct{}
+
+func (generativeAIAttrsGetter) GetAIOperationName(request generativeAIRequest) string {
+	return request.operationName
+}
+
+func (generativeAIAttrsGetter) GetAISystem(request generativeAIRequest) string {
+	return "gemini"
+}
+
+func (generativeAIAttrsGetter) GetAIRequestModel(request generativeAIRequest) string {
+	return request.model
+}
+
+func (generativeAIAttrsGetter) GetAIRequestEncodingFormats(request generativeAIRequest) []string {
+	return nil
+}
+
+func (generativeAIAttrsGetter) GetAIRequestFrequencyPenalty(request generativeAIRequest) float64 {
+	return 0
+}
+
+func (generativeAIAttrsGetter) GetAIRequestPresencePenalty(request generativeAIRequest) float64 {
+	return 0
+}
+
+func (generativeAIAttrsGetter) GetAIResponseFinishReasons(request generativeAIRequest, response generativeAIResponse) []string {
+	return response.finishReasons
+}
+
+func (generativeAIAttrsGetter) GetAIResponseModel(request generativeAIRequest, response generativeAIResponse) string {
+	return ""
+}
+
+func (generativeAIAttrsGetter) GetAIRequestMaxTokens(request generativeAIRequest) int64 {
+	return request.maxTokens
+}
+
+func (generativeAIAttrsGetter) GetAIUsageInputTokens(request generativeAIRequest) int64 {
+	return request.inputTokens
+}
+
+func (generativeAIAttrsGetter) GetAIUsageOutputTokens(request generativeAIRequest, response generativeAIResponse) int64 {
+	return response.outputTokens
+}
+
+func (generativeAIAttrsGetter) GetAIRequestStopSequences(request generativeAIRequest) []string {
+	return request.stopSequences
+}
+
+func (generativeAIAttrsGetter) GetAIRequestTemperature(request generativeAIRequest) float64 {
+	return request.temperature
+}
+
+func (generativeAIAttrsGetter) GetAIRequestTopK(request generativeAIRequest) float64 {
+	return request.topK
+}
+
+func (generativeAIAttrsGetter) GetAIRequestTopP(request generativeAIRequest) float64 {
+	return request.topP
+}
+
+func (generativeAIAttrsGetter) GetAIResponseID(request generativeAIRequest, response generativeAIResponse) string {
+	return ""
+}
+
+func (generativeAIAttrsGetter) GetAIServerAddress(request generativeAIRequest) string {
+	return ""
+}
+
+func (generativeAIAttrsGetter) GetAIRequestSeed(request generativeAIRequest) int64 {
+	return 0
+}
+
+func (generativeAIAttrsGetter) GetAIInput(request generativeAIRequest) string {
+	return request.inputMessages
+}
+
+func (generativeAIAttrsGetter) GetAIOutput(response generativeAIResponse) string {
+	return response.outputMessages
+}
+
+// generativeAIUsageAttrsExtractor records the cached prompt tokens of content
+// generation and the shape of embeddings.
+type generativeAIUsageAttrsExtractor struct{}
+
+func (g *generativeAIUsageAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request generativeAIRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (g *generativeAIUsageAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request generativeAIRequest, response generativeAIResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.cacheReadInputTokens > 0 {
+		attributes = append(attributes, ai.GenAIUsageCacheReadInputTokensKey.Int64(response.cacheReadInputTokens))
+	}
+	if request.operationName == operationNameEmbeddings {
+		attributes = append(attributes,
+			attribute.Int("gen_ai.embedding.count", request.embeddingCount),
+			attribute.Int("gen_ai.embedding.dimensions", response.embeddingDim),
+		)
+	}
+	return attributes, ctx
+}
+
+func BuildGenerativeAIClientInstrumenter() instrumenter.Instrumenter[generativeAIRequest, generativeAIResponse] {
+	builder := instrumenter.Builder[generativeAIRequest, generativeAIResponse]{}
+	getter := generativeAIAttrsGetter{}
+	return builder.Init().
+		SetSpanNameExtractor(&ai.AISpanNameExtractor[generativeAIRequest, generativeAIResponse]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[generativeAIRequest]{}).
+		AddAttributesExtractor(&ai.AILLMAttrsExtractor[generativeAIRequest, generativeAIResponse, generativeAIAttrsGetter, generativeAIAttrsGetter]{
+			Base: ai.AICommonAttrsExtractor[generativeAIRequest, generativeAIResponse, generativeAIAttrsGetter]{
+				CommonGetter: getter,
+			},
+			LLMGetter: getter,
+		}).
+		AddAttributesExtractor(&generativeAIUsageAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GENERATIVE_AI_GO_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(ai.AIClientMetrics("generative-ai-go-client")).
+		BuildInstrumenter()
+}
+
+var generativeAIInstrumenter = BuildGenerativeAIClientInstrumenter()
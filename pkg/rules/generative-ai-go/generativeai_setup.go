@@ -0,0 +1,348 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generativeai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"unsafe"
+
+	pb "cloud.google.com/go/ai/generativelanguage/apiv1beta/generativelanguagepb"
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/google/generative-ai-go/genai"
+)
+
+// fieldOf returns a settable view of an unexported field of the struct p
+// points to.
+func fieldOf(p interface{}, name string) reflect.Value {
+	v := reflect.ValueOf(p)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return reflect.Value{}
+	}
+	field := v.Elem().FieldByName(name)
+	if !field.IsValid() {
+		return reflect.Value{}
+	}
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+// modelNameOf returns the name of a generative model, which is only kept
+// in an unexported field.
+func modelNameOf(m *genai.GenerativeModel) string {
+	fullName := fieldOf(m, "fullName")
+	if !fullName.IsValid() || fullName.Kind() != reflect.String {
+		return ""
+	}
+	return strings.TrimPrefix(fullName.String(), "models/")
+}
+
+// chatModelOf returns the generative model a chat session is bound to.
+func chatModelOf(cs *genai.ChatSession) *genai.GenerativeModel {
+	m := fieldOf(cs, "m")
+	if !m.IsValid() {
+		return nil
+	}
+	model, _ := m.Interface().(*genai.GenerativeModel)
+	return model
+}
+
+func newGenerateContentRequest(m *genai.GenerativeModel, contents []*genai.Content, isStream bool) generativeAIRequest {
+	request := generativeAIRequest{
+		operationName: operationNameChat,
+		isStream:      isStream,
+	}
+	if input, err := json.Marshal(contents); err == nil {
+		request.inputMessages = string(input)
+	}
+	if m == nil {
+		return request
+	}
+	request.model = modelNameOf(m)
+	config := m.GenerationConfig
+	if config.Temperature != nil {
+		request.temperature = float64(*config.Temperature)
+	}
+	if config.TopK != nil {
+		request.topK = float64(*config.TopK)
+	}
+	if config.TopP != nil {
+		request.topP = float64(*config.TopP)
+	}
+	if config.MaxOutputTokens != nil {
+		request.maxTokens = int64(*config.MaxOutputTokens)
+	}
+	request.stopSequences = config.StopSequences
+	return request
+}
+
+// chatContentsOf returns the history a chat message is sent with, the
+// message itself being only appended to it by the call.
+func chatContentsOf(cs *genai.ChatSession, parts []genai.Part) []*genai.Content {
+	contents := make([]*genai.Content, 0, len(cs.History)+1)
+	contents = append(contents, cs.History...)
+	return append(contents, &genai.Content{Role: "user", Parts: parts})
+}
+
+// fillGenerateContentResponse copies a generate content response into the
+// request and response. The finish reasons are reported with the names of
+// the underlying API, e.g. STOP or SAFETY.
+func fillGenerateContentResponse(request *generativeAIRequest, response *generativeAIResponse, resp *genai.GenerateContentResponse) {
+	if usage := resp.UsageMetadata; usage != nil {
+		request.inputTokens = int64(usage.PromptTokenCount)
+		response.outputTokens = int64(usage.CandidatesTokenCount)
+		response.cacheReadInputTokens = int64(usage.CachedContentTokenCount)
+	}
+	var finishReasons []string
+	if resp.PromptFeedback != nil {
+		finishReasons = appendBlockReason(finishReasons, resp.PromptFeedback)
+	}
+	var contents []*genai.Content
+	for _, candidate := range resp.Candidates {
+		if candidate == nil {
+			continue
+		}
+		finishReasons = appendFinishReason(finishReasons, candidate)
+		if candidate.Content != nil {
+			contents = append(contents, candidate.Content)
+		}
+	}
+	if len(finishReasons) > 0 {
+		response.finishReasons = finishReasons
+	}
+	if len(contents) > 0 {
+		if output, err := json.Marshal(contents); err == nil {
+			response.outputMessages = string(output)
+		}
+	}
+}
+
+func appendBlockReason(finishReasons []string, feedback *genai.PromptFeedback) []string {
+	if feedback.BlockReason == genai.BlockReasonUnspecified {
+		return finishReasons
+	}
+	return append(finishReasons, pb.GenerateContentResponse_PromptFeedback_BlockReason(feedback.BlockReason).String())
+}
+
+func appendFinishReason(finishReasons []string, candidate *genai.Candidate) []string {
+	if candidate.FinishReason == genai.FinishReasonUnspecified {
+		return finishReasons
+	}
+	return append(finishReasons, pb.Candidate_FinishReason(candidate.FinishReason).String())
+}
+
+// endGenerateContent ends the span of a content generation. A response
+// blocked by the safety settings is returned by the SDK as a BlockedError,
+// which is recorded as the finish reason of the span instead of an error,
+// like the block reasons of the other Gemini SDKs.
+func endGenerateContent(ctx context.Context, request generativeAIRequest, resp *genai.GenerateContentResponse, err error) {
+	response := generativeAIResponse{}
+	var blocked *genai.BlockedError
+	switch {
+	case err == nil && resp != nil:
+		fillGenerateContentResponse(&request, &response, resp)
+	case errors.As(err, &blocked):
+		err = nil
+		if blocked.PromptFeedback != nil {
+			response.finishReasons = appendBlockReason(response.finishReasons, blocked.PromptFeedback)
+		}
+		if blocked.Candidate != nil {
+			response.finishReasons = appendFinishReason(response.finishReasons, blocked.Candidate)
+		}
+	}
+	generativeAIInstrumenter.End(ctx, request, response, err)
+}
+
+// wrapGenerateContentStream hands the stream of responses an iterator reads
+// from over to a generateContentStream, which ends the span once the
+// stream is drained or fails.
+func wrapGenerateContentStream(ctx context.Context, request generativeAIRequest, iter *genai.GenerateContentResponseIterator) {
+	if iter == nil {
+		generativeAIInstrumenter.End(ctx, request, generativeAIResponse{}, nil)
+		return
+	}
+	if iterErr := fieldOf(iter, "err"); iterErr.IsValid() && !iterErr.IsNil() {
+		err, _ := iterErr.Interface().(error)
+		generativeAIInstrumenter.End(ctx, request, generativeAIResponse{}, err)
+		return
+	}
+	scField := fieldOf(iter, "sc")
+	if !scField.IsValid() || scField.IsNil() {
+		generativeAIInstrumenter.End(ctx, request, generativeAIResponse{}, nil)
+		return
+	}
+	sc, ok := scField.Interface().(pb.GenerativeService_StreamGenerateContentClient)
+	if !ok {
+		generativeAIInstrumenter.End(ctx, request, generativeAIResponse{}, nil)
+		return
+	}
+	scField.Set(reflect.ValueOf(pb.GenerativeService_StreamGenerateContentClient(newGenerateContentStream(ctx, request, sc))))
+}
+
+//go:linkname generativeModelGenerateContentOnEnter github.com/google/generative-ai-go/genai.generativeModelGenerateContentOnEnter
+func generativeModelGenerateContentOnEnter(call api.CallContext, m *genai.GenerativeModel, ctx context.Context, parts ...genai.Part) {
+	if !generativeAIEnabler.Enable() {
+		return
+	}
+	request := newGenerateContentRequest(m, []*genai.Content{{Role: "user", Parts: parts}}, false)
+	ctx = generativeAIInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname generativeModelGenerateContentOnExit github.com/google/generative-ai-go/genai.generativeModelGenerateContentOnExit
+func generativeModelGenerateContentOnExit(call api.CallContext, resp *genai.GenerateContentResponse, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(generativeAIRequest)
+	endGenerateContent(ctx, request, resp, err)
+}
+
+//go:linkname generativeModelGenerateContentStreamOnEnter github.com/google/generative-ai-go/genai.generativeModelGenerateContentStreamOnEnter
+func generativeModelGenerateContentStreamOnEnter(call api.CallContext, m *genai.GenerativeModel, ctx context.Context, parts ...genai.Part) {
+	if !generativeAIEnabler.Enable() {
+		return
+	}
+	request := newGenerateContentRequest(m, []*genai.Content{{Role: "user", Parts: parts}}, true)
+	ctx = generativeAIInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname generativeModelGenerateContentStreamOnExit github.com/google/generative-ai-go/genai.generativeModelGenerateContentStreamOnExit
+func generativeModelGenerateContentStreamOnExit(call api.CallContext, iter *genai.GenerateContentResponseIterator) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(generativeAIRequest)
+	wrapGenerateContentStream(ctx, request, iter)
+}
+
+//go:linkname chatSessionSendMessageOnEnter github.com/google/generative-ai-go/genai.chatSessionSendMessageOnEnter
+func chatSessionSendMessageOnEnter(call api.CallContext, cs *genai.ChatSession, ctx context.Context, parts ...genai.Part) {
+	if !generativeAIEnabler.Enable() || cs == nil {
+		return
+	}
+	request := newGenerateContentRequest(chatModelOf(cs), chatContentsOf(cs, parts), false)
+	ctx = generativeAIInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname chatSessionSendMessageOnExit github.com/google/generative-ai-go/genai.chatSessionSendMessageOnExit
+func chatSessionSendMessageOnExit(call api.CallContext, resp *genai.GenerateContentResponse, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(generativeAIRequest)
+	endGenerateContent(ctx, request, resp, err)
+}
+
+//go:linkname chatSessionSendMessageStreamOnEnter github.com/google/generative-ai-go/genai.chatSessionSendMessageStreamOnEnter
+func chatSessionSendMessageStreamOnEnter(call api.CallContext, cs *genai.ChatSession, ctx context.Context, parts ...genai.Part) {
+	if !generativeAIEnabler.Enable() || cs == nil {
+		return
+	}
+	request := newGenerateContentRequest(chatModelOf(cs), chatContentsOf(cs, parts), true)
+	ctx = generativeAIInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname chatSessionSendMessageStreamOnExit github.com/google/generative-ai-go/genai.chatSessionSendMessageStreamOnExit
+func chatSessionSendMessageStreamOnExit(call api.CallContext, iter *genai.GenerateContentResponseIterator) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(generativeAIRequest)
+	wrapGenerateContentStream(ctx, request, iter)
+}
+
+// EmbedContent delegates to EmbedContentWithTitle, so hooking the latter
+// covers both without a duplicate span.
+//
+//go:linkname embeddingModelEmbedContentWithTitleOnEnter github.com/google/generative-ai-go/genai.embeddingModelEmbedContentWithTitleOnEnter
+func embeddingModelEmbedContentWithTitleOnEnter(call api.CallContext, m *genai.EmbeddingModel, ctx context.Context, title string, parts ...genai.Part) {
+	if !generativeAIEnabler.Enable() || m == nil {
+		return
+	}
+	request := generativeAIRequest{
+		operationName:  operationNameEmbeddings,
+		model:          strings.TrimPrefix(m.Name(), "models/"),
+		embeddingCount: 1,
+	}
+	if input, err := json.Marshal([]*genai.Content{{Parts: parts}}); err == nil {
+		request.inputMessages = string(input)
+	}
+	ctx = generativeAIInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{})
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname embeddingModelEmbedContentWithTitleOnExit github.com/google/generative-ai-go/genai.embeddingModelEmbedContentWithTitleOnExit
+func embeddingModelEmbedContentWithTitleOnExit(call api.CallContext, resp *genai.EmbedContentResponse, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, _ := data["request"].(generativeAIRequest)
+	response := generativeAIResponse{}
+	if err == nil && resp != nil && resp.Embedding != nil {
+		response.embeddingDim = len(resp.Embedding.Values)
+	}
+	generativeAIInstrumenter.End(ctx, request, response, err)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generativeai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	pb "cloud.google.com/go/ai/generativelanguage/apiv1beta/generativelanguagepb"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+)
+
+// generateContentStream accumulates the responses of a streaming content
+// generation and ends its span at the end of the stream, on error or once a
+// response is blocked, after which the SDK stops reading the stream.
+type generateContentStream struct {
+	pb.GenerativeService_StreamGenerateContentClient
+	ctx            context.Context
+	request        generativeAIRequest
+	response       generativeAIResponse
+	output         strings.Builder
+	firstTokenTime time.Time
+	once           sync.Once
+}
+
+func newGenerateContentStream(ctx context.Context, request generativeAIRequest, sc pb.GenerativeService_StreamGenerateContentClient) *generateContentStream {
+	return &generateContentStream{
+		GenerativeService_StreamGenerateContentClient: sc,
+		ctx:     ctx,
+		request: request,
+	}
+}
+
+func (s *generateContentStream) Recv() (*pb.GenerateContentResponse, error) {
+	resp, err := s.GenerativeService_StreamGenerateContentClient.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			s.end(nil)
+		} else {
+			s.end(err)
+		}
+		return resp, err
+	}
+	if resp == nil {
+		return resp, err
+	}
+	if s.firstTokenTime.IsZero() {
+		s.firstTokenTime = time.Now()
+	}
+	if usage := resp.GetUsageMetadata(); usage != nil {
+		s.request.inputTokens = int64(usage.GetPromptTokenCount())
+		s.response.outputTokens = int64(usage.GetCandidatesTokenCount())
+		s.response.cacheReadInputTokens = int64(usage.GetCachedContentTokenCount())
+	}
+	var finishReasons []string
+	blocked := false
+	if blockReason := resp.GetPromptFeedback().GetBlockReason(); blockReason != pb.GenerateContentResponse_PromptFeedback_BLOCK_REASON_UNSPECIFIED {
+		finishReasons = append(finishReasons, blockReason.String())
+		blocked = true
+	}
+	for _, candidate := range resp.GetCandidates() {
+		for _, part := range candidate.GetContent().GetParts() {
+			s.output.WriteString(part.GetText())
+		}
+		finishReason := candidate.GetFinishReason()
+		if finishReason == pb.Candidate_FINISH_REASON_UNSPECIFIED {
+			continue
+		}
+		finishReasons = append(finishReasons, finishReason.String())
+		if finishReason == pb.Candidate_SAFETY || finishReason == pb.Candidate_RECITATION {
+			blocked = true
+		}
+	}
+	if len(finishReasons) > 0 {
+		s.response.finishReasons = finishReasons
+	}
+	if blocked {
+		s.end(nil)
+	}
+	return resp, err
+}
+
+func (s *generateContentStream) end(err error) {
+	s.once.Do(func() {
+		s.response.outputMessages = s.output.String()
+		ctx := s.ctx
+		if !s.firstTokenTime.IsZero() {
+			ctx = context.WithValue(ctx, ai.TimeToFirstTokenKey{}, s.firstTokenTime)
+		}
+		generativeAIInstrumenter.End(ctx, s.request, s.response, err)
+	})
+}
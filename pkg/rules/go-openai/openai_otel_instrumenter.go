@@ -19,6 +19,7 @@ import (
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
@@ -159,7 +160,7 @@ func BuildOpenAIClientOtelInstrumenter() instrumenter.Instrumenter[openaiRequest
 			},
 		}).
 		SetInstrumentationScope(instrumentation.Scope{
-			Name:    "loongsuite.instrumentation.openai",
+			Name:    utils.OPENAI_SCOPE_NAME,
 			Version: version.Tag,
 		}).
 		AddOperationListeners(ai.AIClientMetrics("openai-client")).
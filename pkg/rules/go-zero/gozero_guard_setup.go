@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package go_zero
+
+import (
+	"context"
+	"reflect"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/zeromicro/go-zero/core/breaker"
+	"github.com/zeromicro/go-zero/core/load"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The breakers and the shedders of go-zero guard the rest handlers and the
+// zrpc clients and servers, their outcomes are recorded on the span in which
+// they are consulted.
+const (
+	goZeroBreakerNameKey     = attribute.Key("gozero.breaker.name")
+	goZeroBreakerOutcomeKey  = attribute.Key("gozero.breaker.outcome")
+	goZeroSheddingOutcomeKey = attribute.Key("gozero.shedding.outcome")
+	goZeroOutcomeAccepted    = "accepted"
+	goZeroOutcomeRejected    = "rejected"
+	goZeroOutcomeShed        = "shed"
+)
+
+// breakerName reads the name of a loggedThrottle, the breaker is not exported.
+func breakerName(throttle interface{}) string {
+	v := reflect.ValueOf(throttle)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	name := v.FieldByName("name")
+	if !name.IsValid() || name.Kind() != reflect.String {
+		return ""
+	}
+	return name.String()
+}
+
+//go:linkname breakerDoReqOnEnter github.com/zeromicro/go-zero/core/breaker.breakerDoReqOnEnter
+func breakerDoReqOnEnter(call api.CallContext, throttle interface{}, req func() error, fallback interface{}, acceptable interface{}) {
+	if !goZeroEnabler.Enable() {
+		return
+	}
+	call.SetData(breakerName(throttle))
+}
+
+//go:linkname breakerDoReqOnExit github.com/zeromicro/go-zero/core/breaker.breakerDoReqOnExit
+func breakerDoReqOnExit(call api.CallContext, err error) {
+	recordBreakerOutcome(call, err)
+}
+
+// the rest handlers ask the breaker for a promise instead of running the
+// request through it
+//
+//go:linkname breakerAllowOnEnter github.com/zeromicro/go-zero/core/breaker.breakerAllowOnEnter
+func breakerAllowOnEnter(call api.CallContext, throttle interface{}) {
+	if !goZeroEnabler.Enable() {
+		return
+	}
+	call.SetData(breakerName(throttle))
+}
+
+//go:linkname breakerAllowOnExit github.com/zeromicro/go-zero/core/breaker.breakerAllowOnExit
+func breakerAllowOnExit(call api.CallContext, promise breaker.Promise, err error) {
+	recordBreakerOutcome(call, err)
+}
+
+func recordBreakerOutcome(call api.CallContext, err error) {
+	name, ok := call.GetData().(string)
+	if !ok {
+		return
+	}
+	span := trace.SpanFromContext(context.Background())
+	if !span.IsRecording() {
+		return
+	}
+	outcome := goZeroOutcomeAccepted
+	if err == breaker.ErrServiceUnavailable {
+		outcome = goZeroOutcomeRejected
+	}
+	span.SetAttributes(goZeroBreakerNameKey.String(name), goZeroBreakerOutcomeKey.String(outcome))
+}
+
+//go:linkname shedderAllowOnExit github.com/zeromicro/go-zero/core/load.shedderAllowOnExit
+func shedderAllowOnExit(call api.CallContext, promise load.Promise, err error) {
+	if !goZeroEnabler.Enable() {
+		return
+	}
+	span := trace.SpanFromContext(context.Background())
+	if !span.IsRecording() {
+		return
+	}
+	outcome := goZeroOutcomeAccepted
+	if err == load.ErrServiceOverloaded {
+		outcome = goZeroOutcomeShed
+	}
+	span.SetAttributes(goZeroSheddingOutcomeKey.String(outcome))
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package go_zero
+
+import (
+	"net/http"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+//go:linkname patRouterHandleOnEnter github.com/zeromicro/go-zero/rest/router.patRouterHandleOnEnter
+func patRouterHandleOnEnter(call api.CallContext, _ interface{}, method, reqPath string, handler http.Handler) {
+	if !goZeroEnabler.Enable() || handler == nil {
+		return
+	}
+	// the router of go-zero does not keep the matched route in the request,
+	// the route is bound to the handler when it is registered
+	call.SetParam(3, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lcs := trace.LocalRootSpanFromGLS()
+		if lcs != nil && r != nil && r.URL != nil && reqPath != r.URL.Path {
+			lcs.SetName(reqPath)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+}
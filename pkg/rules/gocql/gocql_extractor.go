@@ -14,7 +14,11 @@
 
 package gocql
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+)
 
 const (
 	defaultOp        = "QUERY"
@@ -39,3 +43,14 @@ func extractOpType(statement string) string {
 	}
 	return defaultOp
 }
+
+// extractBatchOpType returns the operation shared by all statements of a batch,
+// which is reported as `BATCH <op>`, or an empty string for a mixed batch,
+// which is reported as `BATCH`.
+func extractBatchOpType(statements []string) string {
+	ops := make([]string, len(statements))
+	for i, statement := range statements {
+		ops[i] = extractOpType(statement)
+	}
+	return db.CommonOperation(ops...)
+}
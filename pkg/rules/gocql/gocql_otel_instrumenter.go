@@ -32,6 +32,10 @@ func (g gogpAttrsGetter) GetServerAddress(gocqlRequest gocqlRequest) string {
 	return gocqlRequest.Addr
 }
 
+func (g gogpAttrsGetter) GetServerPort(gocqlRequest gocqlRequest) int {
+	return 0
+}
+
 func (g gogpAttrsGetter) GetStatement(gocqlRequest gocqlRequest) string {
 	return gocqlRequest.Statement
 }
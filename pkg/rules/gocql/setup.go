@@ -92,7 +92,7 @@ func (o *otelObserver) ObserveBatch(ctx context.Context, batch gocql.ObservedBat
 		Statement: strings.Join(batch.Statements, ", "),
 		DbName:    batch.Keyspace,
 		Addr:      batch.Host.HostnameAndPort(),
-		Op:        "BATCH",
+		Op:        extractBatchOpType(batch.Statements),
 		User:      o.user,
 		BatchSize: len(batch.Statements),
 	}
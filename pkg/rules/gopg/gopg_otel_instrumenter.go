@@ -32,6 +32,10 @@ func (g gogpAttrsGetter) GetServerAddress(gopgRequest gopgRequest) string {
 	return gopgRequest.Addr
 }
 
+func (g gogpAttrsGetter) GetServerPort(gopgRequest gopgRequest) int {
+	return 0
+}
+
 func (g gogpAttrsGetter) GetStatement(gopgRequest gopgRequest) string {
 	return gopgRequest.Statement
 }
@@ -19,6 +19,8 @@ import (
 )
 
 type goRedisRequest struct {
-	cmd      redis.Cmder
-	endpoint string
+	cmd       redis.Cmder
+	endpoint  string
+	operation string
+	batchSize int
 }
@@ -39,6 +39,10 @@ func (d goRedisAttrsGetter) GetServerAddress(request goRedisRequest) string {
 	return request.endpoint
 }
 
+func (d goRedisAttrsGetter) GetServerPort(request goRedisRequest) int {
+	return 0
+}
+
 func (d goRedisAttrsGetter) GetStatement(request goRedisRequest) string {
 	b := make([]byte, 0, 64)
 
@@ -63,6 +67,10 @@ func (d goRedisAttrsGetter) GetStatement(request goRedisRequest) string {
 }
 
 func (d goRedisAttrsGetter) GetOperation(request goRedisRequest) string {
+	if request.batchSize > 0 {
+		// pipelines are reported as `BATCH <command>`, or `BATCH` when commands differ
+		return request.operation
+	}
 	return request.cmd.FullName()
 }
 
@@ -80,7 +88,7 @@ func (d goRedisAttrsGetter) GetDbNamespace(request goRedisRequest) string {
 }
 
 func (d goRedisAttrsGetter) GetBatchSize(request goRedisRequest) int {
-	return 0
+	return request.batchSize
 }
 
 func BuildGoRedisOtelInstrumenter() instrumenter.Instrumenter[goRedisRequest, any] {
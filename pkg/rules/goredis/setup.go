@@ -22,6 +22,7 @@ import (
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
 	"go.opentelemetry.io/otel/trace"
 
 	redis "github.com/redis/go-redis/v9"
@@ -146,9 +147,15 @@ func (o *otRedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.
 			summary += "..."
 		}
 		cmd := redis.NewCmd(ctx, "pipeline", summary)
+		ops := make([]string, len(cmds))
+		for i := range cmds {
+			ops[i] = cmds[i].FullName()
+		}
 		request := goRedisRequest{
-			cmd:      cmd,
-			endpoint: o.Addr,
+			cmd:       cmd,
+			endpoint:  o.Addr,
+			operation: db.CommonOperation(ops...),
+			batchSize: len(cmds),
 		}
 		ctx = goRedisInstrumenter.Start(ctx, request)
 		if err := next(ctx, cmds); err != nil {
@@ -29,8 +29,10 @@ const redisV8Context = "redis-v8-context"
 var pipelineCmd = redis.NewCmd(context.Background(), "pipeline")
 
 type redisv8Data struct {
-	cmd  redis.Cmder
-	Host string
+	cmd       redis.Cmder
+	Host      string
+	Operation string
+	BatchSize int
 }
 
 func redisV8String(b []byte) string {
@@ -34,12 +34,16 @@ func (d goRedisV8AttrsGetter) GetServerAddress(request redisv8Data) string {
 	return request.Host
 }
 
+func (d goRedisV8AttrsGetter) GetServerPort(request redisv8Data) int {
+	return 0
+}
+
 func (d goRedisV8AttrsGetter) GetDbNamespace(request redisv8Data) string {
 	return ""
 }
 
 func (d goRedisV8AttrsGetter) GetBatchSize(request redisv8Data) int {
-	return 0
+	return request.BatchSize
 }
 
 func (d goRedisV8AttrsGetter) GetStatement(request redisv8Data) string {
@@ -70,6 +74,10 @@ func (d goRedisV8AttrsGetter) GetCollection(request redisv8Data) string {
 }
 
 func (d goRedisV8AttrsGetter) GetOperation(request redisv8Data) string {
+	if request.BatchSize > 0 {
+		// pipelines are reported as `BATCH <command>`, or `BATCH` when commands differ
+		return request.Operation
+	}
 	return request.cmd.FullName()
 }
 
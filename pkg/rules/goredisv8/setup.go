@@ -8,6 +8,7 @@ import (
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
 	redis "github.com/go-redis/redis/v8"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -104,20 +105,14 @@ func (o *otRedisV8Hook) AfterProcess(ctx context.Context, cmd redis.Cmder) error
 }
 
 func (o *otRedisV8Hook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
-	request := redisv8Data{
-		cmd:  pipelineCmd,
-		Host: o.Addr,
-	}
+	request := newPipelineRequest(o.Addr, cmds)
 	newCtx := redisv8Instrumenter.Start(ctx, request, redisV8StartOptions...)
 	ctx = context.WithValue(ctx, redisV8Context, newCtx)
 	return ctx, nil
 }
 
 func (o *otRedisV8Hook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
-	request := redisv8Data{
-		cmd:  pipelineCmd,
-		Host: o.Addr,
-	}
+	request := newPipelineRequest(o.Addr, cmds)
 	var tError error
 	hasError := false
 	errSb := strings.Builder{}
@@ -139,3 +134,16 @@ func (o *otRedisV8Hook) AfterProcessPipeline(ctx context.Context, cmds []redis.C
 	}
 	return nil
 }
+
+func newPipelineRequest(addr string, cmds []redis.Cmder) redisv8Data {
+	ops := make([]string, len(cmds))
+	for i := range cmds {
+		ops[i] = cmds[i].FullName()
+	}
+	return redisv8Data{
+		cmd:       pipelineCmd,
+		Host:      addr,
+		Operation: db.CommonOperation(ops...),
+		BatchSize: len(cmds),
+	}
+}
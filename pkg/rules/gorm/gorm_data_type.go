@@ -20,4 +20,5 @@ type gormRequest struct {
 	Operation string
 	User      string
 	System    string
+	BatchSize int
 }
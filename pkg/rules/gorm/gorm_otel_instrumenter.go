@@ -33,6 +33,10 @@ func (g gormAttrsGetter) GetServerAddress(gormRequest gormRequest) string {
 	return gormRequest.Endpoint
 }
 
+func (g gormAttrsGetter) GetServerPort(gormRequest gormRequest) int {
+	return 0
+}
+
 func (g gormAttrsGetter) GetStatement(gormRequest gormRequest) string {
 	return ""
 }
@@ -55,7 +59,7 @@ func (g gormAttrsGetter) GetDbNamespace(gormRequest gormRequest) string {
 }
 
 func (g gormAttrsGetter) GetBatchSize(gormRequest gormRequest) int {
-	return 0
+	return gormRequest.BatchSize
 }
 
 func BuildGormInstrumenter() instrumenter.Instrumenter[gormRequest, interface{}] {
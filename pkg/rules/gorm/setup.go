@@ -17,6 +17,7 @@ package gorm
 import (
 	"context"
 	"os"
+	"reflect"
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
@@ -74,6 +75,7 @@ func beforeCallback(endpoint string, op string) func(db *gorm.DB) {
 			Operation: op,
 			User:      user,
 			System:    system,
+			BatchSize: getBatchSize(db, op),
 		}
 		ctx := gormInstrumenter.Start(context.Background(), request)
 		db.Set(contextKey, ctx)
@@ -103,6 +105,19 @@ func afterCallback(endpoint string) func(db *gorm.DB) {
 	}
 }
 
+// getBatchSize returns the number of records inserted by a create operation,
+// e.g. the size of each chunk written by CreateInBatches.
+func getBatchSize(db *gorm.DB, op string) int {
+	if op != "create" || db.Statement == nil {
+		return 0
+	}
+	switch db.Statement.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		return db.Statement.ReflectValue.Len()
+	}
+	return 0
+}
+
 func getDbInfo(dial gorm.Dialector) (string, string, string, string) {
 	// TODO: support other database
 	res, ok := dial.(*mysql.Dialector)
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gqlgen
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/graphql"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	graphqlFieldNameKey  = attribute.Key("graphql.field.name")
+	graphqlFieldPathKey  = attribute.Key("graphql.field.path")
+	graphqlParentNameKey = attribute.Key("graphql.parent.name")
+	graphqlFieldTypeKey  = attribute.Key("graphql.field.type")
+)
+
+var gqlgenEnabler = gqlgenInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GQLGEN_ENABLED") != "false"}
+
+var gqlgenInstrumenter = buildGqlgenInstrumenter()
+
+var gqlgenResolveInstrumenter = buildGqlgenResolveInstrumenter()
+
+type gqlgenInnerEnabler struct {
+	enabled bool
+}
+
+func (g gqlgenInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+type gqlgenAttrsGetter struct{}
+
+func (getter gqlgenAttrsGetter) GetOperationName(request *gqlgenRequest) string {
+	return request.operationName
+}
+
+func (getter gqlgenAttrsGetter) GetOperationType(request *gqlgenRequest) string {
+	return request.operationType
+}
+
+func (getter gqlgenAttrsGetter) GetDocument(request *gqlgenRequest) string {
+	return request.document
+}
+
+// gqlgenSpanNameExtractor names the parse and validate phases as
+// graphql.parse and graphql.validate, the execution is named after the
+// operation.
+type gqlgenSpanNameExtractor struct {
+	operation graphql.GraphqlSpanNameExtractor[*gqlgenRequest]
+}
+
+func (extractor *gqlgenSpanNameExtractor) Extract(request *gqlgenRequest) string {
+	if request.phase != gqlgenPhaseExecute {
+		return "graphql." + request.phase
+	}
+	return extractor.operation.Extract(request)
+}
+
+type gqlgenResolveSpanNameExtractor struct{}
+
+func (extractor *gqlgenResolveSpanNameExtractor) Extract(request *gqlgenResolveRequest) string {
+	return request.parentType + "." + request.fieldName
+}
+
+type gqlgenResolveAttrsExtractor struct{}
+
+func (extractor *gqlgenResolveAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *gqlgenResolveRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		graphqlFieldNameKey.String(request.fieldName),
+		graphqlFieldPathKey.String(request.path),
+		graphqlParentNameKey.String(request.parentType),
+	)
+	if request.fieldType != "" {
+		attributes = append(attributes, graphqlFieldTypeKey.String(request.fieldType))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *gqlgenResolveAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *gqlgenResolveRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func buildGqlgenInstrumenter() instrumenter.Instrumenter[*gqlgenRequest, interface{}] {
+	builder := instrumenter.Builder[*gqlgenRequest, interface{}]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GQLGEN_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&gqlgenSpanNameExtractor{
+			operation: graphql.GraphqlSpanNameExtractor[*gqlgenRequest]{Getter: gqlgenAttrsGetter{}},
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[*gqlgenRequest]{}).
+		AddAttributesExtractor(&graphql.GraphqlAttrsExtractor[*gqlgenRequest, interface{}, gqlgenAttrsGetter]{}).
+		BuildInstrumenter()
+}
+
+func buildGqlgenResolveInstrumenter() instrumenter.Instrumenter[*gqlgenResolveRequest, interface{}] {
+	builder := instrumenter.Builder[*gqlgenResolveRequest, interface{}]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GQLGEN_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&gqlgenResolveSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[*gqlgenResolveRequest]{}).
+		AddAttributesExtractor(&gqlgenResolveAttrsExtractor{}).
+		BuildInstrumenter()
+}
+
+// renameServerSpan appends the operation name to the name of the http server
+// span, the route of the span is kept as the prefix of its name.
+func renameServerSpan(operationName string) {
+	if operationName == "" {
+		return
+	}
+	lcs := sdktrace.LocalRootSpanFromGLS()
+	readOnlySpan, ok := lcs.(sdktrace.ReadOnlySpan)
+	if !ok || readOnlySpan.SpanKind() != trace.SpanKindServer || strings.HasSuffix(readOnlySpan.Name(), " "+operationName) {
+		return
+	}
+	lcs.SetName(readOnlySpan.Name() + " " + operationName)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gqlgen
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	graphqlsemconv "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// gqlgenTracer is registered to every executor, it traces the execution of
+// the operations and the resolvers of the fields.
+type gqlgenTracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = gqlgenTracer{}
+
+func (gqlgenTracer) ExtensionName() string {
+	return "LoongsuiteTracer"
+}
+
+func (gqlgenTracer) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (gqlgenTracer) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if !gqlgenEnabler.Enable() {
+		return next(ctx)
+	}
+	rc := graphql.GetOperationContext(ctx)
+	request := &gqlgenRequest{
+		phase:         gqlgenPhaseExecute,
+		operationName: rc.OperationName,
+		document:      rc.RawQuery,
+	}
+	if rc.Operation != nil {
+		request.operationType = string(rc.Operation.Operation)
+		request.operationName = rc.Operation.Name
+	}
+	renameServerSpan(request.operationName)
+	ctx = gqlgenInstrumenter.Start(graphqlsemconv.ContextWithResolverBudget(ctx), request)
+	spanCtx := ctx
+	subscription := request.operationType == string(ast.Subscription)
+	var once sync.Once
+	end := func(err error) {
+		once.Do(func() {
+			gqlgenInstrumenter.End(spanCtx, request, nil, err)
+		})
+	}
+	handler := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := handler(ctx)
+		if resp == nil {
+			end(nil)
+			return resp
+		}
+		// subscriptions respond until the handler returns nil
+		if !subscription {
+			var err error
+			if len(resp.Errors) > 0 {
+				err = resp.Errors
+			}
+			end(err)
+		}
+		return resp
+	}
+}
+
+func (gqlgenTracer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	if !gqlgenEnabler.Enable() {
+		return next(ctx)
+	}
+	fc := graphql.GetFieldContext(ctx)
+	// only the fields resolved by methods or resolvers are worth a span
+	if fc == nil || !(fc.IsMethod || fc.IsResolver) {
+		return next(ctx)
+	}
+	path, depth := fieldPath(fc.Path())
+	if !graphqlsemconv.ShouldTraceResolver(ctx, depth) {
+		return next(ctx)
+	}
+	request := &gqlgenResolveRequest{
+		fieldName:  fc.Field.Name,
+		parentType: fc.Object,
+		path:       path,
+	}
+	if fc.Field.Definition != nil && fc.Field.Definition.Type != nil {
+		request.fieldType = fc.Field.Definition.Type.String()
+	}
+	ctx = gqlgenResolveInstrumenter.Start(ctx, request)
+	res, err := next(ctx)
+	gqlgenResolveInstrumenter.End(ctx, request, nil, err)
+	return res, err
+}
+
+// fieldPath renders the path of a field as a dot separated path, the depth
+// of the field does not count the indexes of the lists.
+func fieldPath(path ast.Path) (string, int) {
+	segments := make([]string, 0, len(path))
+	depth := 0
+	for _, element := range path {
+		switch e := element.(type) {
+		case ast.PathName:
+			segments = append(segments, string(e))
+			depth++
+		case ast.PathIndex:
+			segments = append(segments, strconv.Itoa(int(e)))
+		}
+	}
+	return strings.Join(segments, "."), depth
+}
+
+//go:linkname gqlgenExecutorNewOnExit github.com/99designs/gqlgen/graphql/executor.gqlgenExecutorNewOnExit
+func gqlgenExecutorNewOnExit(call api.CallContext, e *executor.Executor) {
+	if !gqlgenEnabler.Enable() || e == nil {
+		return
+	}
+	e.Use(gqlgenTracer{})
+}
+
+type gqlgenOperation struct {
+	ctx    context.Context
+	params *graphql.RawParams
+}
+
+//go:linkname gqlgenCreateOperationContextOnEnter github.com/99designs/gqlgen/graphql/executor.gqlgenCreateOperationContextOnEnter
+func gqlgenCreateOperationContextOnEnter(call api.CallContext, _ *executor.Executor, ctx context.Context, params *graphql.RawParams) {
+	if !gqlgenEnabler.Enable() || ctx == nil || params == nil {
+		return
+	}
+	call.SetData(gqlgenOperation{ctx: ctx, params: params})
+}
+
+//go:linkname gqlgenCreateOperationContextOnExit github.com/99designs/gqlgen/graphql/executor.gqlgenCreateOperationContextOnExit
+func gqlgenCreateOperationContextOnExit(call api.CallContext, rc *graphql.OperationContext, errs gqlerror.List) {
+	operation, ok := call.GetData().(gqlgenOperation)
+	if !ok || rc == nil {
+		return
+	}
+	var err error
+	if len(errs) > 0 {
+		err = errs
+	}
+	operationName := operation.params.OperationName
+	var operationType string
+	if rc.Operation != nil {
+		operationName = rc.Operation.Name
+		operationType = string(rc.Operation.Operation)
+	}
+	newRequest := func(phase string) *gqlgenRequest {
+		return &gqlgenRequest{
+			phase:         phase,
+			operationName: operationName,
+			operationType: operationType,
+			document:      operation.params.Query,
+		}
+	}
+	// parse and validate are timed by gqlgen, their spans are siblings of the
+	// execution span
+	parsing := rc.Stats.Parsing
+	if parsing.Start.IsZero() {
+		return
+	}
+	validation := rc.Stats.Validation
+	var parseErr error
+	if validation.Start.IsZero() {
+		parseErr = err
+	}
+	gqlgenInstrumenter.StartAndEndWithOptions(operation.ctx, newRequest(gqlgenPhaseParse), nil, parseErr, parsing.Start, endTime(parsing), nil, nil)
+	if validation.Start.IsZero() {
+		return
+	}
+	gqlgenInstrumenter.StartAndEndWithOptions(operation.ctx, newRequest(gqlgenPhaseValidate), nil, err, validation.Start, endTime(validation), nil, nil)
+}
+
+func endTime(timing graphql.TraceTiming) time.Time {
+	if timing.End.IsZero() {
+		return time.Now()
+	}
+	return timing.End
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql_go
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/graphql"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	graphqlFieldNameKey  = attribute.Key("graphql.field.name")
+	graphqlFieldPathKey  = attribute.Key("graphql.field.path")
+	graphqlParentNameKey = attribute.Key("graphql.parent.name")
+)
+
+var graphqlGoEnabler = graphqlGoInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GRAPHQL_GO_ENABLED") != "false"}
+
+var graphqlInstrumenter = buildGraphqlInstrumenter()
+
+var graphqlResolveInstrumenter = buildGraphqlResolveInstrumenter()
+
+type graphqlGoInnerEnabler struct {
+	enabled bool
+}
+
+func (g graphqlGoInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+type graphqlAttrsGetter struct{}
+
+func (getter graphqlAttrsGetter) GetOperationName(request *graphqlRequest) string {
+	return request.operationName
+}
+
+func (getter graphqlAttrsGetter) GetOperationType(request *graphqlRequest) string {
+	return request.operationType
+}
+
+func (getter graphqlAttrsGetter) GetDocument(request *graphqlRequest) string {
+	return request.document
+}
+
+// graphqlSpanNameExtractor names the parse and validate phases as
+// graphql.parse and graphql.validate, the execution is named after the
+// operation.
+type graphqlSpanNameExtractor struct {
+	operation graphql.GraphqlSpanNameExtractor[*graphqlRequest]
+}
+
+func (extractor *graphqlSpanNameExtractor) Extract(request *graphqlRequest) string {
+	if request.phase != graphqlPhaseExecute {
+		return "graphql." + request.phase
+	}
+	return extractor.operation.Extract(request)
+}
+
+type graphqlResolveSpanNameExtractor struct{}
+
+func (extractor *graphqlResolveSpanNameExtractor) Extract(request *graphqlResolveRequest) string {
+	return request.parentType + "." + request.fieldName
+}
+
+type graphqlResolveAttrsExtractor struct{}
+
+func (extractor *graphqlResolveAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *graphqlResolveRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		graphqlFieldNameKey.String(request.fieldName),
+		graphqlFieldPathKey.String(request.path),
+		graphqlParentNameKey.String(request.parentType),
+	)
+	return attributes, parentContext
+}
+
+func (extractor *graphqlResolveAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *graphqlResolveRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func buildGraphqlInstrumenter() instrumenter.Instrumenter[*graphqlRequest, interface{}] {
+	builder := instrumenter.Builder[*graphqlRequest, interface{}]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GRAPHQL_GO_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&graphqlSpanNameExtractor{
+			operation: graphql.GraphqlSpanNameExtractor[*graphqlRequest]{Getter: graphqlAttrsGetter{}},
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[*graphqlRequest]{}).
+		AddAttributesExtractor(&graphql.GraphqlAttrsExtractor[*graphqlRequest, interface{}, graphqlAttrsGetter]{}).
+		BuildInstrumenter()
+}
+
+func buildGraphqlResolveInstrumenter() instrumenter.Instrumenter[*graphqlResolveRequest, interface{}] {
+	builder := instrumenter.Builder[*graphqlResolveRequest, interface{}]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GRAPHQL_GO_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&graphqlResolveSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[*graphqlResolveRequest]{}).
+		AddAttributesExtractor(&graphqlResolveAttrsExtractor{}).
+		BuildInstrumenter()
+}
+
+// renameServerSpan appends the operation name to the name of the http server
+// span, the route of the span is kept as the prefix of its name.
+func renameServerSpan(operationName string) {
+	if operationName == "" {
+		return
+	}
+	lcs := sdktrace.LocalRootSpanFromGLS()
+	readOnlySpan, ok := lcs.(sdktrace.ReadOnlySpan)
+	if !ok || readOnlySpan.SpanKind() != trace.SpanKindServer || strings.HasSuffix(readOnlySpan.Name(), " "+operationName) {
+		return
+	}
+	lcs.SetName(readOnlySpan.Name() + " " + operationName)
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql_go
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/graphql"
+	"github.com/graph-gophers/graphql-go/errors"
+)
+
+type graphqlOperationKey struct{}
+
+type graphqlPhase struct {
+	request   *graphqlRequest
+	startTime time.Time
+}
+
+type graphqlSpan struct {
+	ctx     context.Context
+	request *graphqlRequest
+}
+
+type graphqlResolveSpan struct {
+	ctx     context.Context
+	request *graphqlResolveRequest
+}
+
+// operationOf reads the type and the name of an *ast.OperationDefinition, the
+// type is declared in upper case by graphql-go.
+func operationOf(op interface{}) (string, string) {
+	v := reflect.ValueOf(op)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return "", ""
+	}
+	v = v.Elem()
+	operationType := v.FieldByName("Type")
+	name := v.FieldByName("Name")
+	if !operationType.IsValid() || operationType.Kind() != reflect.String || !name.IsValid() {
+		return "", ""
+	}
+	name = name.FieldByName("Name")
+	if !name.IsValid() || name.Kind() != reflect.String {
+		return strings.ToLower(operationType.String()), ""
+	}
+	return strings.ToLower(operationType.String()), name.String()
+}
+
+// singleOperationOf reads the operation of a parsed document, documents with
+// several operations are only resolved at execution.
+func singleOperationOf(doc interface{}) (string, string) {
+	v := reflect.ValueOf(doc)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return "", ""
+	}
+	operations := v.Elem().FieldByName("Operations")
+	if !operations.IsValid() || operations.Kind() != reflect.Slice || operations.Len() != 1 {
+		return "", ""
+	}
+	return operationOf(operations.Index(0).Interface())
+}
+
+func firstError(errs []*errors.QueryError) error {
+	if len(errs) == 0 || errs[0] == nil {
+		return nil
+	}
+	return errs[0]
+}
+
+func endPhase(call api.CallContext, err error) {
+	phase, ok := call.GetData().(graphqlPhase)
+	if !ok {
+		return
+	}
+	endPhaseSpan(phase, err)
+}
+
+func endPhaseSpan(phase graphqlPhase, err error) {
+	// parse and validate run before the execution, their spans are siblings
+	// of the execution span under the span of the caller
+	graphqlInstrumenter.StartAndEndWithOptions(context.Background(), phase.request, nil, err, phase.startTime, time.Now(), nil, nil)
+}
+
+//go:linkname graphqlExecOnEnter github.com/graph-gophers/graphql-go.graphqlExecOnEnter
+func graphqlExecOnEnter(call api.CallContext, _ interface{}, ctx context.Context, queryString string, operationName string, variables map[string]interface{}, res interface{}) {
+	if !graphqlGoEnabler.Enable() || ctx == nil {
+		return
+	}
+	call.SetParam(1, context.WithValue(ctx, graphqlOperationKey{}, queryString))
+}
+
+//go:linkname graphqlParseOnEnter github.com/graph-gophers/graphql-go/internal/query.graphqlParseOnEnter
+func graphqlParseOnEnter(call api.CallContext, queryString string) {
+	if !graphqlGoEnabler.Enable() {
+		return
+	}
+	call.SetData(graphqlPhase{
+		request:   &graphqlRequest{phase: graphqlPhaseParse, document: queryString},
+		startTime: time.Now(),
+	})
+}
+
+//go:linkname graphqlParseOnExit github.com/graph-gophers/graphql-go/internal/query.graphqlParseOnExit
+func graphqlParseOnExit(call api.CallContext, doc interface{}, err *errors.QueryError) {
+	phase, ok := call.GetData().(graphqlPhase)
+	if !ok {
+		return
+	}
+	phase.request.operationType, phase.request.operationName = singleOperationOf(doc)
+	var parseErr error
+	if err != nil {
+		parseErr = err
+	}
+	endPhaseSpan(phase, parseErr)
+}
+
+func validateOnEnter(call api.CallContext, doc interface{}) {
+	if !graphqlGoEnabler.Enable() {
+		return
+	}
+	request := &graphqlRequest{phase: graphqlPhaseValidate}
+	request.operationType, request.operationName = singleOperationOf(doc)
+	call.SetData(graphqlPhase{request: request, startTime: time.Now()})
+}
+
+//go:linkname graphqlValidateOnEnter github.com/graph-gophers/graphql-go/internal/validation.graphqlValidateOnEnter
+func graphqlValidateOnEnter(call api.CallContext, s interface{}, doc interface{}, variables map[string]interface{}, maxDepth int) {
+	validateOnEnter(call, doc)
+}
+
+//go:linkname graphqlValidateOnExit github.com/graph-gophers/graphql-go/internal/validation.graphqlValidateOnExit
+func graphqlValidateOnExit(call api.CallContext, errs []*errors.QueryError) {
+	endPhase(call, firstError(errs))
+}
+
+//go:linkname graphqlValidateWithOverlapLimitOnEnter github.com/graph-gophers/graphql-go/internal/validation.graphqlValidateWithOverlapLimitOnEnter
+func graphqlValidateWithOverlapLimitOnEnter(call api.CallContext, s interface{}, doc interface{}, variables map[string]interface{}, maxDepth int, overlapPairLimit int) {
+	validateOnEnter(call, doc)
+}
+
+//go:linkname graphqlValidateWithOverlapLimitOnExit github.com/graph-gophers/graphql-go/internal/validation.graphqlValidateWithOverlapLimitOnExit
+func graphqlValidateWithOverlapLimitOnExit(call api.CallContext, errs []*errors.QueryError) {
+	endPhase(call, firstError(errs))
+}
+
+//go:linkname graphqlExecuteOnEnter github.com/graph-gophers/graphql-go/internal/exec.graphqlExecuteOnEnter
+func graphqlExecuteOnEnter(call api.CallContext, _ interface{}, ctx context.Context, s interface{}, op interface{}) {
+	if !graphqlGoEnabler.Enable() || ctx == nil {
+		return
+	}
+	request := &graphqlRequest{phase: graphqlPhaseExecute}
+	request.operationType, request.operationName = operationOf(op)
+	if document, ok := ctx.Value(graphqlOperationKey{}).(string); ok {
+		request.document = document
+	}
+	renameServerSpan(request.operationName)
+	ctx = graphqlInstrumenter.Start(graphql.ContextWithResolverBudget(ctx), request)
+	call.SetParam(1, ctx)
+	call.SetData(graphqlSpan{ctx: ctx, request: request})
+}
+
+//go:linkname graphqlExecuteOnExit github.com/graph-gophers/graphql-go/internal/exec.graphqlExecuteOnExit
+func graphqlExecuteOnExit(call api.CallContext, data []byte, errs []*errors.QueryError) {
+	span, ok := call.GetData().(graphqlSpan)
+	if !ok {
+		return
+	}
+	graphqlInstrumenter.End(span.ctx, span.request, nil, firstError(errs))
+}
+
+// fieldPath renders a *pathSegment as a dot separated path, the unexported
+// fields are only read through reflection.
+func fieldPath(path interface{}) (string, int) {
+	var segments []string
+	depth := 0
+	for v := reflect.ValueOf(path); v.Kind() == reflect.Ptr && !v.IsNil(); v = v.Elem().FieldByName("parent") {
+		value := v.Elem().FieldByName("value")
+		if !value.IsValid() {
+			break
+		}
+		if value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+		switch value.Kind() {
+		case reflect.String:
+			segments = append(segments, value.String())
+			depth++
+		case reflect.Int:
+			segments = append(segments, strconv.FormatInt(value.Int(), 10))
+		}
+	}
+	for i, j := 0, len(segments)-1; i < j; i, j = i+1, j-1 {
+		segments[i], segments[j] = segments[j], segments[i]
+	}
+	return strings.Join(segments, "."), depth
+}
+
+//go:linkname graphqlExecFieldSelectionOnEnter github.com/graph-gophers/graphql-go/internal/exec.graphqlExecFieldSelectionOnEnter
+func graphqlExecFieldSelectionOnEnter(call api.CallContext, ctx context.Context, r interface{}, s interface{}, f interface{}, path interface{}, applyLimiter bool) {
+	if !graphqlGoEnabler.Enable() || ctx == nil {
+		return
+	}
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	field := v.Elem().FieldByName("field")
+	if !field.IsValid() || field.Kind() != reflect.Ptr || field.IsNil() {
+		return
+	}
+	field = field.Elem()
+	// only the fields resolved by methods run asynchronously, plain struct
+	// fields are not worth a span
+	if async := field.FieldByName("Async"); !async.IsValid() || !async.Bool() {
+		return
+	}
+	request := &graphqlResolveRequest{
+		fieldName:  field.FieldByName("Name").String(),
+		parentType: field.FieldByName("TypeName").String(),
+	}
+	var depth int
+	request.path, depth = fieldPath(path)
+	if !graphql.ShouldTraceResolver(ctx, depth) {
+		return
+	}
+	ctx = graphqlResolveInstrumenter.Start(ctx, request)
+	call.SetParam(0, ctx)
+	call.SetData(graphqlResolveSpan{ctx: ctx, request: request})
+}
+
+//go:linkname graphqlExecFieldSelectionOnExit github.com/graph-gophers/graphql-go/internal/exec.graphqlExecFieldSelectionOnExit
+func graphqlExecFieldSelectionOnExit(call api.CallContext) {
+	span, ok := call.GetData().(graphqlResolveSpan)
+	if !ok {
+		return
+	}
+	graphqlResolveInstrumenter.End(span.ctx, span.request, nil, nil)
+}
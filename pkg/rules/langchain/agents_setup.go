@@ -33,32 +33,34 @@ func doActionOnEnter(call api.CallContext,
 	nameToTool map[string]tools.Tool,
 	action schema.AgentAction,
 ) {
-	request := langChainRequest{
-		operationName: MAgentAction,
-		system:        "langchain",
-		spanKind:      ai.GenAISpanKindTool,
-		input: map[string]interface{}{
-			"tool":    action.Tool,
-			"tool-id": action.ToolID,
-			"log":     action.Log,
-		},
+	request := ai.ToolCallRequest{
+		System:    "langchain",
+		Name:      action.Tool,
+		CallID:    action.ToolID,
+		Type:      "function",
+		Arguments: action.ToolInput,
 	}
-	langCtx := langChainCommonInstrument.Start(ctx, request)
+	if tool, ok := nameToTool[action.Tool]; ok && tool != nil {
+		request.Description = tool.Description()
+	}
+	langCtx := langChainToolInstrument.Start(ctx, request)
 	data := make(map[string]interface{})
 	data["ctx"] = langCtx
+	data["request"] = request
 	call.SetData(data)
 }
 
 //go:linkname doActionOnExit github.com/tmc/langchaingo/agents.doActionOnExit
 func doActionOnExit(call api.CallContext, steps []schema.AgentStep, err error) {
 	data := call.GetData().(map[string]interface{})
-	request := langChainRequest{
-		operationName: MAgentAction,
-		system:        "langchain",
-	}
 	ctx, ok := data["ctx"].(context.Context)
 	if !ok {
 		return
 	}
-	langChainCommonInstrument.End(ctx, request, nil, err)
+	request, _ := data["request"].(ai.ToolCallRequest)
+	response := ai.ToolCallResponse{}
+	if err == nil && len(steps) > 0 {
+		response.Result = steps[len(steps)-1].Observation
+	}
+	langChainToolInstrument.End(ctx, request, response, err)
 }
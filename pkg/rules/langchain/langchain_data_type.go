@@ -14,7 +14,10 @@
 
 package langchain
 
-import "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+import (
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	"github.com/tmc/langchaingo/llms"
+)
 
 type langChainRequest struct {
 	operationName string
@@ -40,6 +43,7 @@ type langChainLLMRequest struct {
 	serverAddress    string
 	seed             int64
 	input            string
+	messages         []llms.MessageContent
 }
 type langChainLLMResponse struct {
 	responseFinishReasons []string
@@ -47,4 +51,5 @@ type langChainLLMResponse struct {
 	usageOutputTokens     int64
 	responseID            string
 	output                string
+	choices               []*llms.ContentChoice
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"github.com/tmc/langchaingo/llms"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"strings"
 )
@@ -14,6 +15,7 @@ type aiLLMRequest struct {
 
 var _ ai.LLMAttrsGetter[langChainLLMRequest, langChainLLMResponse] = aiLLMRequest{}
 var _ ai.CommonAttrsGetter[langChainLLMRequest, any] = aiLLMRequest{}
+var _ ai.MessagesAttrsGetter[langChainLLMRequest, langChainLLMResponse] = aiLLMRequest{}
 
 func (aiLLMRequest) GetAIOperationName(request langChainLLMRequest) string {
 	return request.operationName
@@ -77,6 +79,73 @@ func (aiLLMRequest) GetAIOutput(response langChainLLMResponse) string {
 	return response.output
 }
 
+func (aiLLMRequest) GetAISystemInstructions(request langChainLLMRequest) []ai.MessagePart {
+	return nil
+}
+
+func (aiLLMRequest) GetAIInputMessages(request langChainLLMRequest) []ai.ChatMessage {
+	messages := make([]ai.ChatMessage, 0, len(request.messages))
+	for _, message := range request.messages {
+		chatMessage := ai.ChatMessage{Role: chatMessageRole(message.Role), Parts: []ai.MessagePart{}}
+		for _, part := range message.Parts {
+			switch p := part.(type) {
+			case llms.TextContent:
+				chatMessage.Parts = append(chatMessage.Parts, ai.TextPart(p.Text))
+			case llms.ToolCall:
+				chatMessage.Parts = append(chatMessage.Parts, toolCallPart(p))
+			case llms.ToolCallResponse:
+				chatMessage.Parts = append(chatMessage.Parts, ai.ToolCallResponsePart(p.ToolCallID, p.Content))
+			case llms.ImageURLContent:
+				chatMessage.Parts = append(chatMessage.Parts, ai.MessagePart{Type: "image_url"})
+			case llms.BinaryContent:
+				chatMessage.Parts = append(chatMessage.Parts, ai.MessagePart{Type: "binary"})
+			}
+		}
+		messages = append(messages, chatMessage)
+	}
+	return messages
+}
+
+func (aiLLMRequest) GetAIOutputMessages(request langChainLLMRequest, response langChainLLMResponse) []ai.ChatMessage {
+	messages := make([]ai.ChatMessage, 0, len(response.choices))
+	for _, choice := range response.choices {
+		if choice == nil {
+			continue
+		}
+		chatMessage := ai.ChatMessage{Role: "assistant", Parts: []ai.MessagePart{}, FinishReason: choice.StopReason}
+		if choice.Content != "" {
+			chatMessage.Parts = append(chatMessage.Parts, ai.TextPart(choice.Content))
+		}
+		for _, toolCall := range choice.ToolCalls {
+			chatMessage.Parts = append(chatMessage.Parts, toolCallPart(toolCall))
+		}
+		messages = append(messages, chatMessage)
+	}
+	return messages
+}
+
+// chatMessageRole maps the message types of langchaingo to the roles of the
+// GenAI semantic conventions.
+func chatMessageRole(messageType llms.ChatMessageType) string {
+	switch messageType {
+	case llms.ChatMessageTypeAI:
+		return "assistant"
+	case llms.ChatMessageTypeHuman, llms.ChatMessageTypeGeneric:
+		return "user"
+	case llms.ChatMessageTypeFunction, llms.ChatMessageTypeTool:
+		return "tool"
+	default:
+		return string(messageType)
+	}
+}
+
+func toolCallPart(toolCall llms.ToolCall) ai.MessagePart {
+	if toolCall.FunctionCall == nil {
+		return ai.ToolCallPart(toolCall.ID, "", nil)
+	}
+	return ai.ToolCallPart(toolCall.ID, toolCall.FunctionCall.Name, toolCall.FunctionCall.Arguments)
+}
+
 func (aiLLMRequest) GetAIUsageOutputTokens(request langChainLLMRequest, response langChainLLMResponse) int64 {
 	return response.usageOutputTokens
 }
@@ -98,6 +167,7 @@ func BuildLangchainLLMOtelInstrumenter() instrumenter.Instrumenter[langChainLLMR
 		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[langChainLLMRequest]{}).
 		AddAttributesExtractor(&ai.AILLMAttrsExtractor[langChainLLMRequest, langChainLLMResponse, aiLLMRequest, aiLLMRequest]{}).
 		AddAttributesExtractor(&ai.GenAISpanKindAttrsExtractor[langChainLLMRequest, langChainLLMResponse, aiLLMRequest]{Getter: aiLLMRequest{}}).
+		AddOperationListeners(ai.AIClientMetrics("langchain-llm")).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.LANGCHAIN_SCOPE_NAME,
 			Version: version.Tag,
@@ -74,19 +74,12 @@ func openaiGenerateContentOnExit(call api.CallContext, resp *llms.ContentRespons
 			})
 		}
 		response.responseFinishReasons = finishReasons
+		response.choices = resp.Choices
 		if outputJSON, err := json.Marshal(outputContents); err == nil {
 			response.output = string(outputJSON)
 		}
-		if totalTokensAny, ok1 := resp.Choices[0].GenerationInfo["TotalTokens"]; ok1 {
-			if totalTokens, ok2 := totalTokensAny.(int); ok2 {
-				response.usageOutputTokens = int64(totalTokens)
-			}
-		}
-		if reasoningTokensAny, ok1 := resp.Choices[0].GenerationInfo["ReasoningTokens"]; ok1 {
-			if totalTokens, ok2 := reasoningTokensAny.(int); ok2 {
-				request.usageInputTokens = int64(totalTokens)
-			}
-		}
+		request.usageInputTokens = generationTokens(resp.Choices[0].GenerationInfo, "PromptTokens", "InputTokens")
+		response.usageOutputTokens = generationTokens(resp.Choices[0].GenerationInfo, "CompletionTokens", "OutputTokens")
 	}
 
 	langChainLLMInstrument.End(ctx, request, response, nil)
@@ -136,19 +129,33 @@ func ollamaGenerateContentOnExit(call api.CallContext, resp *llms.ContentRespons
 			})
 		}
 		response.responseFinishReasons = finishReasons
+		response.choices = resp.Choices
 		// Serialize output messages
 		if outputJSON, err := json.Marshal(outputContents); err == nil {
 			response.output = string(outputJSON)
 		}
-		if totalTokensAny, ok1 := resp.Choices[0].GenerationInfo["TotalTokens"]; ok1 {
-			if totalTokens, ok2 := totalTokensAny.(int); ok2 {
-				response.usageOutputTokens = int64(totalTokens)
-			}
-		}
+		request.usageInputTokens = generationTokens(resp.Choices[0].GenerationInfo, "PromptTokens", "InputTokens")
+		response.usageOutputTokens = generationTokens(resp.Choices[0].GenerationInfo, "CompletionTokens", "OutputTokens")
 	}
 	langChainLLMInstrument.End(ctx, request, response, nil)
 }
 
+// generationTokens reads a token count from the generation info, whose keys
+// depend on the provider.
+func generationTokens(info map[string]any, keys ...string) int64 {
+	for _, key := range keys {
+		switch tokens := info[key].(type) {
+		case int:
+			return int64(tokens)
+		case int32:
+			return int64(tokens)
+		case int64:
+			return tokens
+		}
+	}
+	return 0
+}
+
 func LLMBaseOnEnter(call api.CallContext,
 	ctx context.Context, req *langChainLLMRequest, messages []llms.MessageContent, options ...llms.CallOption,
 ) {
@@ -173,6 +180,7 @@ func LLMBaseOnEnter(call api.CallContext,
 	if inputJSON, err := json.Marshal(messages); err == nil {
 		req.input = string(inputJSON)
 	}
+	req.messages = messages
 
 	langCtx := langChainLLMInstrument.Start(ctx, *req)
 	data := make(map[string]interface{})
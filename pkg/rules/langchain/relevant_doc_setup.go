@@ -38,6 +38,8 @@ func getRelevantDocumentsOnEnter(call api.CallContext,
 		spanKind:      ai.GenAISpanKindRetriever,
 	}
 	langCtx := langChainCommonInstrument.Start(ctx, request)
+	// the vector store searches nest under the retriever span
+	call.SetParam(1, langCtx)
 	data := make(map[string]interface{})
 	data["ctx"] = langCtx
 	call.SetData(data)
@@ -16,12 +16,14 @@ package langchain
 
 import (
 	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 )
 
 const (
 	MLlmGenerateSingle = "llmGenerateSingle"
 	MAgentExecutor     = "agentExecutor"
-	MAgentAction       = "agentAction"
 	MChains            = "chains"
 	MEmbedSingle       = "singleEmbed"
 	MEmbedBatch        = "batchedEmbed"
@@ -39,3 +41,5 @@ func (l langChainInnerEnabler) Enable() bool {
 var langChainEnabler = langChainInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_LANGCHAIN_ENABLED") != "false"}
 
 var langChainCommonInstrument = BuildCommonLangchainOtelInstrumenter()
+
+var langChainToolInstrument = ai.BuildToolCallInstrumenter(utils.LANGCHAIN_SCOPE_NAME)
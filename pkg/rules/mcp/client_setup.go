@@ -19,11 +19,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
 	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/mcp"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:linkname clientOnEnter github.com/mark3labs/mcp-go/client.clientOnEnter
@@ -31,10 +33,22 @@ func clientOnEnter(call api.CallContext, c *client.Client,
 	ctx context.Context,
 	method string,
 	params interface{}) {
-	clientEnter(call, ctx, method, params)
+	clientEnter(call, c.OtelSession, ctx, method, params)
+}
+
+// The SDK passes the headers of the request from 0.43.0 on.
+//
+//go:linkname clientWithHeaderOnEnter github.com/mark3labs/mcp-go/client.clientWithHeaderOnEnter
+func clientWithHeaderOnEnter(call api.CallContext, c *client.Client,
+	ctx context.Context,
+	method string,
+	params interface{},
+	header http.Header) {
+	clientEnter(call, c.OtelSession, ctx, method, params)
 }
 
 func clientEnter(call api.CallContext,
+	session interface{},
 	ctx context.Context,
 	method string,
 	params interface{}) {
@@ -47,13 +61,20 @@ func clientEnter(call api.CallContext,
 		methodType:    method,
 		input:         map[string]any{},
 		output:        map[string]any{},
+		meta:          map[string]any{},
 	}
 	//var subRequest *mcp.Request
 	if err := handleClientRequest(method, &request, params); err != nil {
 		fmt.Println("handleClientRequest", "未匹配")
 		return
 	}
-	Ctx := ClientInstrumenter.Start(ctx, request)
+	parentCtx := ctx
+	if s, ok := session.(*mcpSession); ok && !trace.SpanContextFromContext(ctx).IsValid() {
+		parentCtx = trace.ContextWithSpan(ctx, trace.SpanFromContext(s.ctx))
+	}
+	Ctx := ClientInstrumenter.Start(parentCtx, request)
+	call.SetParam(1, Ctx)
+	call.SetParam(3, withMeta(params, request.meta))
 	data := make(map[string]interface{})
 	data["ctx"] = Ctx
 	data["mcp_client_request"] = request
@@ -78,9 +99,47 @@ func clientExit(call api.CallContext, j *json.RawMessage, err error) {
 	if !ok {
 		return
 	}
+	if request.methodType == string(mcp.MethodToolsCall) && err == nil && j != nil {
+		request.toolResult = *j
+	}
 	ClientInstrumenter.End(ctx, request, nil, err)
 }
 
+// withMeta returns the params with the trace context added to their _meta
+// object. The params are turned into a generic object as the SDK does for
+// its own metadata, the ones which are not an object are kept.
+func withMeta(params interface{}, carrier map[string]any) interface{} {
+	if len(carrier) == 0 {
+		return params
+	}
+	fields := map[string]json.RawMessage{}
+	if params != nil {
+		encoded, err := json.Marshal(params)
+		if err != nil {
+			return params
+		}
+		if err := json.Unmarshal(encoded, &fields); err != nil || fields == nil {
+			return params
+		}
+	}
+	meta := map[string]any{}
+	if raw, ok := fields["_meta"]; ok {
+		_ = json.Unmarshal(raw, &meta)
+		if meta == nil {
+			meta = map[string]any{}
+		}
+	}
+	for k, v := range carrier {
+		meta[k] = v
+	}
+	encodedMeta, err := json.Marshal(meta)
+	if err != nil {
+		return params
+	}
+	fields["_meta"] = encodedMeta
+	return fields
+}
+
 func handleClientRequest(method string, request *mcpRequest, message interface{}) error {
 	switch method {
 	case string(mcp.MethodToolsCall):
@@ -94,6 +153,7 @@ func handleClientRequest(method string, request *mcpRequest, message interface{}
 			if request != nil {
 				request.operationName = "execute_tool"
 				request.methodName = msg.Name
+				request.toolArguments = msg.Arguments
 			}
 		}
 		return nil
@@ -22,10 +22,35 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
 	"github.com/mark3labs/mcp-go/mcp"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 )
 
+// mcpMetaCarrier carries the trace context in the _meta object of a request,
+// as the HTTP headers do for the other protocols.
+type mcpMetaCarrier map[string]any
+
+func (c mcpMetaCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+func (c mcpMetaCarrier) Set(key, value string) {
+	if c != nil {
+		c[key] = value
+	}
+}
+
+func (c mcpMetaCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
 type aiCommonRequest struct {
 }
 
@@ -36,6 +61,38 @@ func (aiCommonRequest) GetAISystem(request mcpRequest) string {
 	return request.system
 }
 
+// mcpToolCallGetter reports the tools/call requests to the shared
+// execute_tool attributes.
+type mcpToolCallGetter struct {
+}
+
+var _ ai.ToolCallAttrsGetter[mcpRequest, any] = mcpToolCallGetter{}
+
+func (mcpToolCallGetter) GetAIToolName(request mcpRequest) string {
+	if request.methodType != string(mcp.MethodToolsCall) {
+		return ""
+	}
+	return request.methodName
+}
+func (mcpToolCallGetter) GetAIToolCallID(request mcpRequest) string {
+	if request.methodType != string(mcp.MethodToolsCall) {
+		return ""
+	}
+	return request.CallId
+}
+func (mcpToolCallGetter) GetAIToolType(request mcpRequest) string {
+	return ""
+}
+func (mcpToolCallGetter) GetAIToolDescription(request mcpRequest) string {
+	return ""
+}
+func (mcpToolCallGetter) GetAIToolCallArguments(request mcpRequest) any {
+	return request.toolArguments
+}
+func (mcpToolCallGetter) GetAIToolCallResult(request mcpRequest, response any) any {
+	return request.toolResult
+}
+
 type LExperimentalAttributeExtractor struct {
 	Base ai.AICommonAttrsExtractor[mcpRequest, any, aiCommonRequest]
 }
@@ -43,20 +100,11 @@ type LExperimentalAttributeExtractor struct {
 func (l LExperimentalAttributeExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request mcpRequest) ([]attribute.KeyValue, context.Context) {
 	attributes, parentContext = l.Base.OnStart(attributes, parentContext, request)
 	var val attribute.Value
-	if request.methodType == string(mcp.MethodToolsCall) {
-		attributes = append(attributes, attribute.KeyValue{
-			Key:   "gen_ai.tool.name",
-			Value: attribute.StringValue(request.methodName),
-		}, attribute.KeyValue{
-			Key:   "gen_ai.tool.call.id",
-			Value: attribute.StringValue(request.CallId),
-		})
-	}
 	if request.input != nil {
 		for k, v := range request.input {
 			switch v.(type) {
 			case string:
-				val = attribute.StringValue(v.(string))
+				val = attribute.StringValue(ai.RedactContent(v.(string)))
 			case int:
 				val = attribute.IntValue(v.(int))
 			case int64:
@@ -88,7 +136,7 @@ func (l LExperimentalAttributeExtractor) OnEnd(attributes []attribute.KeyValue,
 		for k, v := range request.output {
 			switch v.(type) {
 			case string:
-				val = attribute.StringValue(v.(string))
+				val = attribute.StringValue(ai.RedactContent(v.(string)))
 			case int:
 				val = attribute.IntValue(v.(int))
 			case int64:
@@ -118,17 +166,37 @@ func BuildServerCommonOtelInstrumenter() instrumenter.Instrumenter[mcpRequest, a
 	return builder.Init().SetSpanNameExtractor(&ai.AISpanNameExtractor[mcpRequest, any]{Getter: aiCommonRequest{}}).
 		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[mcpRequest]{}).
 		AddAttributesExtractor(&LExperimentalAttributeExtractor{}).
+		AddAttributesExtractor(&ai.AIToolCallAttrsExtractor[mcpRequest, any, mcpToolCallGetter]{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.MCP_SCOPE_NAME,
 			Version: version.Tag,
 		}).
-		BuildInstrumenter()
+		BuildPropagatingFromUpstreamInstrumenter(func(request mcpRequest) propagation.TextMapCarrier {
+			return mcpMetaCarrier(request.meta)
+		}, otel.GetTextMapPropagator())
 }
 func BuildClientCommonOtelInstrumenter() instrumenter.Instrumenter[mcpRequest, any] {
 	builder := instrumenter.Builder[mcpRequest, any]{}
 	return builder.Init().SetSpanNameExtractor(&ai.AISpanNameExtractor[mcpRequest, any]{Getter: aiCommonRequest{}}).
 		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[mcpRequest]{}).
 		AddAttributesExtractor(&LExperimentalAttributeExtractor{}).
+		AddAttributesExtractor(&ai.AIToolCallAttrsExtractor[mcpRequest, any, mcpToolCallGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.MCP_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildPropagatingToDownstreamInstrumenter(func(request mcpRequest) propagation.TextMapCarrier {
+			return mcpMetaCarrier(request.meta)
+		}, otel.GetTextMapPropagator())
+}
+
+// BuildClientSessionOtelInstrumenter builds the instrumenter of the session
+// span, the parent of the requests sent without a span of their own.
+func BuildClientSessionOtelInstrumenter() instrumenter.Instrumenter[mcpRequest, any] {
+	builder := instrumenter.Builder[mcpRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(&ai.AISpanNameExtractor[mcpRequest, any]{Getter: aiCommonRequest{}}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[mcpRequest]{}).
+		AddAttributesExtractor(&LExperimentalAttributeExtractor{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.MCP_SCOPE_NAME,
 			Version: version.Tag,
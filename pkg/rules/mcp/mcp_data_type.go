@@ -14,6 +14,8 @@
 
 package mcp
 
+import "context"
+
 type mcpRequest struct {
 	operationName string
 	system        string
@@ -22,4 +24,23 @@ type mcpRequest struct {
 	CallId        string
 	input         map[string]any
 	output        map[string]any
+	toolArguments any
+	toolResult    any
+	// meta is the _meta object of the request, it carries the trace context
+	meta map[string]any
+}
+
+// mcpSession is the span covering a client session, from initialize to close.
+type mcpSession struct {
+	ctx     context.Context
+	request mcpRequest
 }
+
+// mcpServerCall follows a message through the server, from HandleMessage
+// to the hooks and the handlers sharing its context.
+type mcpServerCall struct {
+	meta map[string]any
+	ctx  context.Context
+}
+
+type mcpServerCallKey struct{}
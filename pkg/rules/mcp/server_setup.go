@@ -16,14 +16,49 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// The typed requests of the older SDKs drop the _meta fields they don't know,
+// the trace context is read from the raw message every transport hands over.
+//
+//go:linkname handleMessageOnEnter github.com/mark3labs/mcp-go/server.handleMessageOnEnter
+func handleMessageOnEnter(call api.CallContext, s *server.MCPServer,
+	ctx context.Context, message json.RawMessage) {
+	if ctx == nil {
+		return
+	}
+	var envelope struct {
+		Params struct {
+			Meta map[string]any `json:"_meta"`
+		} `json:"params"`
+	}
+	// the malformed messages are answered by the server itself
+	_ = json.Unmarshal(message, &envelope)
+	call.SetParam(1, context.WithValue(ctx, mcpServerCallKey{}, &mcpServerCall{meta: envelope.Params.Meta}))
+}
+
+//go:linkname sendNotificationToClientOnEnter github.com/mark3labs/mcp-go/server.sendNotificationToClientOnEnter
+func sendNotificationToClientOnEnter(call api.CallContext, s *server.MCPServer,
+	ctx context.Context, method string, params map[string]any) {
+	if method != mcpMethodProgress || ctx == nil {
+		return
+	}
+	serverCall, ok := ctx.Value(mcpServerCallKey{}).(*mcpServerCall)
+	if !ok || serverCall.ctx == nil {
+		return
+	}
+	trace.SpanFromContext(serverCall.ctx).AddEvent(mcpMethodProgress,
+		trace.WithAttributes(progressAttributes(params)...))
+}
+
 //go:linkname hookBeforeAnyOnEnter github.com/mark3labs/mcp-go/server.hookBeforeAnyOnEnter
 func hookBeforeAnyOnEnter(call api.CallContext, c *server.Hooks,
 	ctx context.Context, id any, method mcp.MCPMethod, message any) {
@@ -43,7 +78,14 @@ func hookBeforeAnyOnEnter(call api.CallContext, c *server.Hooks,
 	if subRequest == nil {
 		return
 	}
+	serverCall, _ := ctx.Value(mcpServerCallKey{}).(*mcpServerCall)
+	if serverCall != nil {
+		request.meta = serverCall.meta
+	}
 	Ctx := ServerInstrumenter.Start(ctx, request)
+	if serverCall != nil {
+		serverCall.ctx = Ctx
+	}
 	//subRequest.OtelRequest = request
 	subRequest.OtelContext = Ctx
 }
@@ -59,6 +101,9 @@ func hookOnSuccessOnEnter(call api.CallContext, c *server.Hooks,
 	if subRequest.OtelContext == nil {
 		return
 	}
+	if method == mcp.MethodToolsCall {
+		request.toolResult = result
+	}
 	ctx, ok := subRequest.OtelContext.(context.Context)
 	if !ok {
 		return
@@ -91,6 +136,7 @@ func getSubRequest(method mcp.MCPMethod, request *mcpRequest, message any) *mcp.
 			if request != nil {
 				request.operationName = "execute_tool"
 				request.methodName = msg.Params.Name
+				request.toolArguments = msg.Params.Arguments
 			}
 			return &msg.Request
 		}
@@ -16,3 +16,4 @@ package mcp
 
 var ServerInstrumenter = BuildServerCommonOtelInstrumenter()
 var ClientInstrumenter = BuildClientCommonOtelInstrumenter()
+var ClientSessionInstrumenter = BuildClientSessionOtelInstrumenter()
@@ -24,6 +24,7 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
 	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/mcp"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:linkname clientSseOnEnter github.com/mark3labs/mcp-go/client.clientSseOnEnter
@@ -31,7 +32,7 @@ func clientSseOnEnter(call api.CallContext, c *client.SSEMCPClient,
 	ctx context.Context,
 	method string,
 	params interface{}) {
-	clientOnEnter(call, ctx, method, params)
+	clientOnEnter(call, c.OtelSession, ctx, method, params)
 }
 
 //go:linkname clientStdioOnEnter github.com/mark3labs/mcp-go/client.clientStdioOnEnter
@@ -39,10 +40,11 @@ func clientStdioOnEnter(call api.CallContext, c *client.StdioMCPClient,
 	ctx context.Context,
 	method string,
 	params interface{}) {
-	clientOnEnter(call, ctx, method, params)
+	clientOnEnter(call, c.OtelSession, ctx, method, params)
 }
 
 func clientOnEnter(call api.CallContext,
+	session interface{},
 	ctx context.Context,
 	method string,
 	params interface{}) {
@@ -55,13 +57,20 @@ func clientOnEnter(call api.CallContext,
 		methodType:    method,
 		input:         map[string]any{},
 		output:        map[string]any{},
+		meta:          map[string]any{},
 	}
 	//var subRequest *mcp.Request
 	if err := handleClientRequest(method, &request, params); err != nil {
 		fmt.Println("handleClientRequest", "未匹配")
 		return
 	}
-	Ctx := ClientInstrumenter.Start(ctx, request)
+	parentCtx := ctx
+	if s, ok := session.(*mcpSession); ok && !trace.SpanContextFromContext(ctx).IsValid() {
+		parentCtx = trace.ContextWithSpan(ctx, trace.SpanFromContext(s.ctx))
+	}
+	Ctx := ClientInstrumenter.Start(parentCtx, request)
+	call.SetParam(1, Ctx)
+	call.SetParam(3, withMeta(params, request.meta))
 	data := make(map[string]interface{})
 	data["ctx"] = Ctx
 	data["mcp_client_request"] = request
@@ -90,9 +99,47 @@ func clientOnExit(call api.CallContext, j *json.RawMessage, err error) {
 	if !ok {
 		return
 	}
+	if request.methodType == string(mcp.MethodToolsCall) && err == nil && j != nil {
+		request.toolResult = *j
+	}
 	ClientInstrumenter.End(ctx, request, nil, err)
 }
 
+// withMeta returns the params with the trace context added to their _meta
+// object. The params are turned into a generic object as the SDK does for
+// its own metadata, the ones which are not an object are kept.
+func withMeta(params interface{}, carrier map[string]any) interface{} {
+	if len(carrier) == 0 {
+		return params
+	}
+	fields := map[string]json.RawMessage{}
+	if params != nil {
+		encoded, err := json.Marshal(params)
+		if err != nil {
+			return params
+		}
+		if err := json.Unmarshal(encoded, &fields); err != nil || fields == nil {
+			return params
+		}
+	}
+	meta := map[string]any{}
+	if raw, ok := fields["_meta"]; ok {
+		_ = json.Unmarshal(raw, &meta)
+		if meta == nil {
+			meta = map[string]any{}
+		}
+	}
+	for k, v := range carrier {
+		meta[k] = v
+	}
+	encodedMeta, err := json.Marshal(meta)
+	if err != nil {
+		return params
+	}
+	fields["_meta"] = encodedMeta
+	return fields
+}
+
 func handleClientRequest(method string, request *mcpRequest, message interface{}) error {
 	switch method {
 	case string(mcp.MethodToolsCall):
@@ -106,6 +153,7 @@ func handleClientRequest(method string, request *mcpRequest, message interface{}
 			if request != nil {
 				request.operationName = "execute_tool"
 				request.methodName = msg.Name
+				request.toolArguments = msg.Arguments
 			}
 		}
 		return nil
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcp0_20_0
+
+import (
+	"context"
+	"fmt"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const mcpMethodProgress = "notifications/progress"
+
+//go:linkname clientSseInitializeOnEnter github.com/mark3labs/mcp-go/client.clientSseInitializeOnEnter
+func clientSseInitializeOnEnter(call api.CallContext, c *client.SSEMCPClient,
+	ctx context.Context, request mcp.InitializeRequest) {
+	if ctx == nil || c.OtelSession != nil {
+		return
+	}
+	session := startSession(ctx, request)
+	c.OtelSession = session
+	c.OnNotification(func(notification mcp.JSONRPCNotification) {
+		recordNotification(session, notification)
+	})
+	// the initialize request nests under the session span
+	call.SetParam(1, session.ctx)
+	call.SetKeyData("client", c)
+	call.SetKeyData("session", session)
+}
+
+//go:linkname clientStdioInitializeOnEnter github.com/mark3labs/mcp-go/client.clientStdioInitializeOnEnter
+func clientStdioInitializeOnEnter(call api.CallContext, c *client.StdioMCPClient,
+	ctx context.Context, request mcp.InitializeRequest) {
+	if ctx == nil || c.OtelSession != nil {
+		return
+	}
+	session := startSession(ctx, request)
+	c.OtelSession = session
+	c.OnNotification(func(notification mcp.JSONRPCNotification) {
+		recordNotification(session, notification)
+	})
+	call.SetParam(1, session.ctx)
+	call.SetKeyData("client", c)
+	call.SetKeyData("session", session)
+}
+
+//go:linkname clientSseInitializeOnExit github.com/mark3labs/mcp-go/client.clientSseInitializeOnExit
+func clientSseInitializeOnExit(call api.CallContext, result *mcp.InitializeResult, err error) {
+	clientInitializeOnExit(call, result, err)
+}
+
+//go:linkname clientStdioInitializeOnExit github.com/mark3labs/mcp-go/client.clientStdioInitializeOnExit
+func clientStdioInitializeOnExit(call api.CallContext, result *mcp.InitializeResult, err error) {
+	clientInitializeOnExit(call, result, err)
+}
+
+func clientInitializeOnExit(call api.CallContext, result *mcp.InitializeResult, err error) {
+	session, ok := call.GetKeyData("session").(*mcpSession)
+	if !ok {
+		return
+	}
+	if err != nil {
+		// a failed handshake ends the session it started
+		switch c := call.GetKeyData("client").(type) {
+		case *client.SSEMCPClient:
+			c.OtelSession = nil
+		case *client.StdioMCPClient:
+			c.OtelSession = nil
+		}
+		ClientSessionInstrumenter.End(session.ctx, session.request, nil, err)
+		return
+	}
+	initializedSession(session, result)
+}
+
+//go:linkname clientSseCloseOnEnter github.com/mark3labs/mcp-go/client.clientSseCloseOnEnter
+func clientSseCloseOnEnter(call api.CallContext, c *client.SSEMCPClient) {
+	session, ok := c.OtelSession.(*mcpSession)
+	if !ok {
+		return
+	}
+	c.OtelSession = nil
+	ClientSessionInstrumenter.End(session.ctx, session.request, nil, nil)
+}
+
+//go:linkname clientStdioCloseOnEnter github.com/mark3labs/mcp-go/client.clientStdioCloseOnEnter
+func clientStdioCloseOnEnter(call api.CallContext, c *client.StdioMCPClient) {
+	session, ok := c.OtelSession.(*mcpSession)
+	if !ok {
+		return
+	}
+	c.OtelSession = nil
+	ClientSessionInstrumenter.End(session.ctx, session.request, nil, nil)
+}
+
+func startSession(ctx context.Context, request mcp.InitializeRequest) *mcpSession {
+	sessionRequest := mcpRequest{
+		operationName: "mcp.session",
+		system:        "mcp",
+		methodType:    string(mcp.MethodInitialize),
+		input: map[string]any{
+			"client_info_name":    request.Params.ClientInfo.Name,
+			"client_info_version": request.Params.ClientInfo.Version,
+		},
+		output: map[string]any{},
+	}
+	return &mcpSession{
+		ctx:     ClientSessionInstrumenter.Start(ctx, sessionRequest),
+		request: sessionRequest,
+	}
+}
+
+func initializedSession(session *mcpSession, result *mcp.InitializeResult) {
+	if result == nil {
+		return
+	}
+	session.request.output["protocol_version"] = result.ProtocolVersion
+	session.request.output["server_info_name"] = result.ServerInfo.Name
+	session.request.output["server_info_version"] = result.ServerInfo.Version
+}
+
+func recordNotification(session *mcpSession, notification mcp.JSONRPCNotification) {
+	if notification.Method != mcpMethodProgress {
+		return
+	}
+	trace.SpanFromContext(session.ctx).AddEvent(mcpMethodProgress,
+		trace.WithAttributes(progressAttributes(notification.Params.AdditionalFields)...))
+}
+
+// progressAttributes describes a progress notification, the ones sent by the
+// server and the ones received by the client alike.
+func progressAttributes(params map[string]any) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{attribute.String("mcp.method.name", mcpMethodProgress)}
+	if token, ok := params["progressToken"]; ok && token != nil {
+		attributes = append(attributes, attribute.String("mcp.progress.token", fmt.Sprintf("%v", token)))
+	}
+	if progress, ok := toFloat(params["progress"]); ok {
+		attributes = append(attributes, attribute.Float64("mcp.progress.value", progress))
+	}
+	if total, ok := toFloat(params["total"]); ok {
+		attributes = append(attributes, attribute.Float64("mcp.progress.total", total))
+	}
+	if message, ok := params["message"].(string); ok && message != "" {
+		attributes = append(attributes, attribute.String("mcp.progress.message", ai.RedactContent(message)))
+	}
+	return attributes
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	}
+	return 0, false
+}
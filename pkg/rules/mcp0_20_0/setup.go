@@ -16,3 +16,4 @@ package mcp0_20_0
 
 var ServerInstrumenter = BuildServerCommonOtelInstrumenter()
 var ClientInstrumenter = BuildClientCommonOtelInstrumenter()
+var ClientSessionInstrumenter = BuildClientSessionOtelInstrumenter()
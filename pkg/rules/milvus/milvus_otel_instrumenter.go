@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvus
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type milvusInnerEnabler struct {
+	enabled bool
+}
+
+func (m milvusInnerEnabler) Enable() bool {
+	return m.enabled
+}
+
+var milvusEnabler = milvusInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_MILVUS_ENABLED") != "false"}
+
+type milvusAttrsGetter struct {
+}
+
+var _ db.DbClientAttrsGetter[milvusRequest] = milvusAttrsGetter{}
+var _ db.VectorDbAttrsGetter[milvusRequest, milvusResponse] = milvusAttrsGetter{}
+
+func (m milvusAttrsGetter) GetSystem(request milvusRequest) string {
+	return db.SystemMilvus
+}
+
+func (m milvusAttrsGetter) GetServerAddress(request milvusRequest) string {
+	host, _, err := net.SplitHostPort(request.address)
+	if err != nil {
+		return request.address
+	}
+	return host
+}
+
+func (m milvusAttrsGetter) GetServerPort(request milvusRequest) int {
+	_, port, err := net.SplitHostPort(request.address)
+	if err != nil {
+		return 0
+	}
+	p, _ := strconv.Atoi(port)
+	return p
+}
+
+// the filter expressions are not reported, they may carry sensitive values
+func (m milvusAttrsGetter) GetStatement(request milvusRequest) string {
+	return ""
+}
+
+func (m milvusAttrsGetter) GetOperation(request milvusRequest) string {
+	return request.operation
+}
+
+func (m milvusAttrsGetter) GetCollection(request milvusRequest) string {
+	return request.collection
+}
+
+func (m milvusAttrsGetter) GetParameters(request milvusRequest) []any {
+	return nil
+}
+
+func (m milvusAttrsGetter) GetDbNamespace(request milvusRequest) string {
+	return ""
+}
+
+func (m milvusAttrsGetter) GetBatchSize(request milvusRequest) int {
+	return 0
+}
+
+func (m milvusAttrsGetter) GetTopK(request milvusRequest) int {
+	return request.topK
+}
+
+func (m milvusAttrsGetter) GetVectorDimension(request milvusRequest) int {
+	return request.dimension
+}
+
+func (m milvusAttrsGetter) GetResultCount(request milvusRequest, response milvusResponse) int {
+	return response.resultCount
+}
+
+func BuildMilvusInstrumenter() instrumenter.Instrumenter[milvusRequest, milvusResponse] {
+	builder := instrumenter.Builder[milvusRequest, milvusResponse]{}
+	getter := milvusAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[milvusRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[milvusRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[milvusRequest, milvusResponse, milvusAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[milvusRequest, milvusResponse, milvusAttrsGetter]{Getter: getter}}).
+		AddAttributesExtractor(&db.VectorDbAttrsExtractor[milvusRequest, milvusResponse, milvusAttrsGetter]{Getter: getter}).
+		AddOperationListeners(db.DbClientMetrics("vector.milvus")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.MILVUS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvus
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+var milvusInstrumenter = BuildMilvusInstrumenter()
+
+// startMilvusOperation starts the span and passes its context to the callee,
+// so the gRPC client span of the request nests under it
+func startMilvusOperation(call api.CallContext, ctx context.Context, grpcClient *client.GrpcClient, request milvusRequest) {
+	if grpcClient != nil && grpcClient.Conn != nil {
+		request.address = grpcClient.Conn.Target()
+	}
+	newCtx := milvusInstrumenter.Start(ctx, request)
+	call.SetParam(1, newCtx)
+	call.SetKeyData("ctx", newCtx)
+	call.SetKeyData("request", request)
+}
+
+func endMilvusOperation(call api.CallContext, resultCount int, err error) {
+	newCtx, ok := call.GetKeyData("ctx").(context.Context)
+	if !ok {
+		return
+	}
+	request := call.GetKeyData("request").(milvusRequest)
+	milvusInstrumenter.End(newCtx, request, milvusResponse{resultCount: resultCount}, err)
+}
+
+func columnsDimension(columns []entity.Column) int {
+	for _, column := range columns {
+		if vector, ok := column.(interface{ Dim() int }); ok {
+			return vector.Dim()
+		}
+	}
+	return 0
+}
+
+//go:linkname beforeMilvusSearch github.com/milvus-io/milvus-sdk-go/v2/client.beforeMilvusSearch
+func beforeMilvusSearch(call api.CallContext, c *client.GrpcClient, ctx context.Context, collName string, partitions []string,
+	expr string, outputFields []string, vectors []entity.Vector, vectorField string, metricType entity.MetricType, topK int, sp entity.SearchParam, opts ...client.SearchQueryOptionFunc) {
+	if !milvusEnabler.Enable() || ctx == nil {
+		return
+	}
+	request := milvusRequest{operation: "search", collection: collName, topK: topK}
+	if len(vectors) > 0 && vectors[0] != nil {
+		request.dimension = vectors[0].Dim()
+	}
+	startMilvusOperation(call, ctx, c, request)
+}
+
+//go:linkname afterMilvusSearch github.com/milvus-io/milvus-sdk-go/v2/client.afterMilvusSearch
+func afterMilvusSearch(call api.CallContext, results []client.SearchResult, err error) {
+	count := 0
+	for _, result := range results {
+		count += result.ResultCount
+	}
+	endMilvusOperation(call, count, err)
+}
+
+//go:linkname beforeMilvusQuery github.com/milvus-io/milvus-sdk-go/v2/client.beforeMilvusQuery
+func beforeMilvusQuery(call api.CallContext, c *client.GrpcClient, ctx context.Context, collectionName string, partitionNames []string, expr string, outputFields []string, opts ...client.SearchQueryOptionFunc) {
+	if !milvusEnabler.Enable() || ctx == nil {
+		return
+	}
+	startMilvusOperation(call, ctx, c, milvusRequest{operation: "query", collection: collectionName})
+}
+
+//go:linkname afterMilvusQuery github.com/milvus-io/milvus-sdk-go/v2/client.afterMilvusQuery
+func afterMilvusQuery(call api.CallContext, resultSet client.ResultSet, err error) {
+	// every column of the result set has a value per entity
+	count := 0
+	if len(resultSet) > 0 && resultSet[0] != nil {
+		count = resultSet[0].Len()
+	}
+	endMilvusOperation(call, count, err)
+}
+
+//go:linkname beforeMilvusInsert github.com/milvus-io/milvus-sdk-go/v2/client.beforeMilvusInsert
+func beforeMilvusInsert(call api.CallContext, c *client.GrpcClient, ctx context.Context, collName string, partitionName string, columns ...entity.Column) {
+	if !milvusEnabler.Enable() || ctx == nil {
+		return
+	}
+	startMilvusOperation(call, ctx, c, milvusRequest{operation: "insert", collection: collName, dimension: columnsDimension(columns)})
+}
+
+//go:linkname afterMilvusInsert github.com/milvus-io/milvus-sdk-go/v2/client.afterMilvusInsert
+func afterMilvusInsert(call api.CallContext, ids entity.Column, err error) {
+	endMilvusOperation(call, -1, err)
+}
+
+//go:linkname beforeMilvusUpsert github.com/milvus-io/milvus-sdk-go/v2/client.beforeMilvusUpsert
+func beforeMilvusUpsert(call api.CallContext, c *client.GrpcClient, ctx context.Context, collName string, partitionName string, columns ...entity.Column) {
+	if !milvusEnabler.Enable() || ctx == nil {
+		return
+	}
+	startMilvusOperation(call, ctx, c, milvusRequest{operation: "upsert", collection: collName, dimension: columnsDimension(columns)})
+}
+
+//go:linkname afterMilvusUpsert github.com/milvus-io/milvus-sdk-go/v2/client.afterMilvusUpsert
+func afterMilvusUpsert(call api.CallContext, ids entity.Column, err error) {
+	endMilvusOperation(call, -1, err)
+}
+
+//go:linkname beforeMilvusDelete github.com/milvus-io/milvus-sdk-go/v2/client.beforeMilvusDelete
+func beforeMilvusDelete(call api.CallContext, c *client.GrpcClient, ctx context.Context, collName string, partitionName string, expr string) {
+	if !milvusEnabler.Enable() || ctx == nil {
+		return
+	}
+	startMilvusOperation(call, ctx, c, milvusRequest{operation: "delete", collection: collName})
+}
+
+//go:linkname afterMilvusDelete github.com/milvus-io/milvus-sdk-go/v2/client.afterMilvusDelete
+func afterMilvusDelete(call api.CallContext, err error) {
+	endMilvusOperation(call, -1, err)
+}
+
+//go:linkname beforeMilvusDeleteByPks github.com/milvus-io/milvus-sdk-go/v2/client.beforeMilvusDeleteByPks
+func beforeMilvusDeleteByPks(call api.CallContext, c *client.GrpcClient, ctx context.Context, collName string, partitionName string, ids entity.Column) {
+	if !milvusEnabler.Enable() || ctx == nil {
+		return
+	}
+	startMilvusOperation(call, ctx, c, milvusRequest{operation: "delete", collection: collName})
+}
+
+//go:linkname afterMilvusDeleteByPks github.com/milvus-io/milvus-sdk-go/v2/client.afterMilvusDeleteByPks
+func afterMilvusDeleteByPks(call api.CallContext, err error) {
+	endMilvusOperation(call, -1, err)
+}
@@ -24,6 +24,7 @@ import (
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -40,6 +41,11 @@ func (m mongoInnerEnabler) Enable() bool {
 
 var mongoEnabler = mongoInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_MONGO_ENABLED") != "false"}
 
+type mongoSpan struct {
+	ctx     context.Context
+	request mongoRequest
+}
+
 //go:linkname mongoOnEnter go.mongodb.org/mongo-driver/mongo.mongoOnEnter
 func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 	if !mongoEnabler.Enable() {
@@ -65,24 +71,22 @@ func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 						host = startedEvent.ConnectionID[0:infoSplit]
 					}
 				}
-				mongoRequest := mongoRequest{
+				request := mongoRequest{
 					CommandName: startedEvent.CommandName,
 					Host:        host,
+					BatchSize:   getBatchSize(startedEvent.CommandName, startedEvent.Command),
 				}
-				newCtx := mongoInstrumenter.Start(ctx, mongoRequest)
-				syncMap.Store(fmt.Sprintf("%d", startedEvent.RequestID), newCtx)
+				newCtx := mongoInstrumenter.Start(ctx, request)
+				syncMap.Store(fmt.Sprintf("%d", startedEvent.RequestID), mongoSpan{ctx: newCtx, request: request})
 			},
 			Succeeded: func(ctx context.Context, succeededEvent *event.CommandSucceededEvent) {
 				if configuredMonitor != nil {
 					configuredMonitor.Succeeded(ctx, succeededEvent)
 				}
-				if newCtx, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", succeededEvent.RequestID)); ok && newCtx != nil {
-					newContext, ok := newCtx.(context.Context)
+				if span, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", succeededEvent.RequestID)); ok && span != nil {
+					mongoSpan, ok := span.(mongoSpan)
 					if ok {
-						mongoInstrumenter.End(newContext, mongoRequest{
-							CommandName: succeededEvent.CommandName,
-							Host:        host,
-						}, nil, nil)
+						mongoInstrumenter.End(mongoSpan.ctx, mongoSpan.request, nil, nil)
 					}
 				}
 			},
@@ -90,16 +94,42 @@ func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 				if configuredMonitor != nil {
 					configuredMonitor.Failed(ctx, failedEvent)
 				}
-				if newCtx, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", failedEvent.RequestID)); ok && newCtx != nil {
-					newContext, ok := newCtx.(context.Context)
+				if span, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", failedEvent.RequestID)); ok && span != nil {
+					mongoSpan, ok := span.(mongoSpan)
 					if ok {
-						mongoInstrumenter.End(newContext, mongoRequest{
-							CommandName: failedEvent.CommandName,
-							Host:        host,
-						}, nil, errors.New(failedEvent.Failure))
+						mongoInstrumenter.End(mongoSpan.ctx, mongoSpan.request, nil, errors.New(failedEvent.Failure))
 					}
 				}
 			},
 		}
 	}
 }
+
+// getBatchSize returns the number of write operations carried by a write
+// command, e.g. the documents of an insert issued by InsertMany or BulkWrite.
+func getBatchSize(commandName string, command bson.Raw) int {
+	var key string
+	switch commandName {
+	case "insert":
+		key = "documents"
+	case "update":
+		key = "updates"
+	case "delete":
+		key = "deletes"
+	default:
+		return 0
+	}
+	value, err := command.LookupErr(key)
+	if err != nil {
+		return 0
+	}
+	array, ok := value.ArrayOK()
+	if !ok {
+		return 0
+	}
+	values, err := array.Values()
+	if err != nil {
+		return 0
+	}
+	return len(values)
+}
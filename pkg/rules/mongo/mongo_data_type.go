@@ -17,4 +17,5 @@ package mongo
 type mongoRequest struct {
 	CommandName string
 	Host        string
+	BatchSize   int
 }
@@ -34,6 +34,10 @@ func (m mongoAttrsGetter) GetServerAddress(request mongoRequest) string {
 	return request.Host
 }
 
+func (m mongoAttrsGetter) GetServerPort(request mongoRequest) int {
+	return 0
+}
+
 func (m mongoAttrsGetter) GetStatement(request mongoRequest) string {
 	return request.CommandName
 }
@@ -52,7 +56,7 @@ func (m mongoAttrsGetter) GetParameters(request mongoRequest) []any {
 }
 
 func (m mongoAttrsGetter) GetBatchSize(request mongoRequest) int {
-	return 0
+	return request.BatchSize
 }
 
 func (m mongoAttrsGetter) GetDbNamespace(request mongoRequest) string {
@@ -70,7 +74,7 @@ func (m *mongoSpanKindExtractor) Extract(request mongoRequest) trace.SpanKind {
 }
 
 func (m *mongoSpanNameExtractor) Extract(request mongoRequest) string {
-	return request.CommandName
+	return db.BatchOperationName(request.CommandName, request.BatchSize)
 }
 
 func BuildMongoOtelInstrumenter() instrumenter.Instrumenter[mongoRequest, interface{}] {
@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import "github.com/nats-io/nats.go"
+
+type natsRequest struct {
+	subject  string
+	reply    string
+	queue    string
+	header   nats.Header
+	bodySize int
+	// batchSize is the number of messages of a fetched batch
+	batchSize int
+	// server is set for the messages of a request/reply exchange
+	server bool
+	// stream, consumer, sequence and ack are only set for JetStream
+	stream    string
+	consumer  string
+	sequence  uint64
+	ack       string
+	duplicate bool
+}
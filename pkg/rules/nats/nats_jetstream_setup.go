@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:linkname jetStreamPublishMsgOnEnter github.com/nats-io/nats.go/jetstream.jetStreamPublishMsgOnEnter
+func jetStreamPublishMsgOnEnter(call api.CallContext, _ interface{}, ctx context.Context, m *nats.Msg, opts ...jetstream.PublishOpt) {
+	if !natsEnabler.Enable() || ctx == nil || m == nil {
+		return
+	}
+	if m.Header == nil {
+		m.Header = nats.Header{}
+	}
+	request := &natsRequest{
+		subject:  m.Subject,
+		header:   m.Header,
+		bodySize: len(m.Data),
+	}
+	ctx = publishInstrumenter.Start(ctx, request)
+	// the request to the stream is issued with the publish span in context
+	call.SetParam(1, ctx)
+	call.SetData(natsSpan{ctx: ctx, request: request})
+}
+
+//go:linkname jetStreamPublishMsgOnExit github.com/nats-io/nats.go/jetstream.jetStreamPublishMsgOnExit
+func jetStreamPublishMsgOnExit(call api.CallContext, ack *jetstream.PubAck, err error) {
+	span, ok := call.GetData().(natsSpan)
+	if !ok {
+		return
+	}
+	if ack != nil {
+		span.request.stream = ack.Stream
+		span.request.sequence = ack.Sequence
+		span.request.duplicate = ack.Duplicate
+	}
+	publishInstrumenter.End(span.ctx, span.request, nil, err)
+}
+
+func newJetStreamRequest(msg jetstream.Msg, consumer string) *natsRequest {
+	request := &natsRequest{
+		subject:  msg.Subject(),
+		header:   msg.Headers(),
+		bodySize: len(msg.Data()),
+		consumer: consumer,
+	}
+	if meta, err := msg.Metadata(); err == nil {
+		request.stream = meta.Stream
+		request.sequence = meta.Sequence.Stream
+		if request.consumer == "" {
+			request.consumer = meta.Consumer
+		}
+	}
+	return request
+}
+
+func consumerName(consumer interface{}) string {
+	if c, ok := consumer.(jetstream.Consumer); ok {
+		if info := c.CachedInfo(); info != nil {
+			return info.Name
+		}
+	}
+	return ""
+}
+
+//go:linkname jetStreamConsumeOnEnter github.com/nats-io/nats.go/jetstream.jetStreamConsumeOnEnter
+func jetStreamConsumeOnEnter(call api.CallContext, consumer interface{}, handler jetstream.MessageHandler, opts ...jetstream.PullConsumeOpt) {
+	if !natsEnabler.Enable() || handler == nil {
+		return
+	}
+	name := consumerName(consumer)
+	call.SetParam(1, jetstream.MessageHandler(func(msg jetstream.Msg) {
+		if !natsEnabler.Enable() || msg == nil {
+			handler(msg)
+			return
+		}
+		request := newJetStreamRequest(msg, name)
+		ctx := processInstrumenter.Start(context.Background(), request, remoteStartOptions(request.header)...)
+		processSpans.Store(msg, request)
+		handler(msg)
+		processSpans.Delete(msg)
+		processInstrumenter.End(ctx, request, nil, nil)
+	}))
+}
+
+//go:linkname jetStreamMsgAckReplyOnEnter github.com/nats-io/nats.go/jetstream.jetStreamMsgAckReplyOnEnter
+func jetStreamMsgAckReplyOnEnter(call api.CallContext, msg interface{}, ctx context.Context, ackType interface{}, sync bool, opts interface{}) {
+	recordAck(msg, ackTypeBytes(ackType))
+}
+
+type jetStreamFetch struct {
+	parentCtx context.Context
+	consumer  string
+	startTime time.Time
+}
+
+// otelMessageBatch forwards the fetched messages and records the receive span
+// of the batch once the fetch completes.
+type otelMessageBatch struct {
+	jetstream.MessageBatch
+	msgs chan jetstream.Msg
+}
+
+func (b *otelMessageBatch) Messages() <-chan jetstream.Msg {
+	return b.msgs
+}
+
+func (b *otelMessageBatch) forward(fetch jetStreamFetch) {
+	defer close(b.msgs)
+	request := &natsRequest{consumer: fetch.consumer}
+	var links []trace.Link
+	for msg := range b.MessageBatch.Messages() {
+		if request.batchSize == 0 {
+			first := newJetStreamRequest(msg, fetch.consumer)
+			request.subject, request.stream, request.consumer = first.subject, first.stream, first.consumer
+		}
+		request.batchSize++
+		if link, ok := producerLink(msg.Headers()); ok {
+			links = append(links, link)
+		}
+		b.msgs <- msg
+	}
+	if request.batchSize == 0 {
+		return
+	}
+	receiveInstrumenter.StartAndEndWithOptions(fetch.parentCtx, request, nil, b.MessageBatch.Error(), fetch.startTime, time.Now(),
+		[]trace.SpanStartOption{trace.WithLinks(links...)}, nil)
+}
+
+//go:linkname jetStreamFetchOnEnter github.com/nats-io/nats.go/jetstream.jetStreamFetchOnEnter
+func jetStreamFetchOnEnter(call api.CallContext, consumer interface{}, req interface{}) {
+	if !natsEnabler.Enable() {
+		return
+	}
+	// the batch is forwarded by another goroutine, keep the context of the caller
+	parentCtx := trace.ContextWithSpan(context.Background(), trace.SpanFromContext(context.Background()))
+	call.SetData(jetStreamFetch{parentCtx: parentCtx, consumer: consumerName(consumer), startTime: time.Now()})
+}
+
+//go:linkname jetStreamFetchOnExit github.com/nats-io/nats.go/jetstream.jetStreamFetchOnExit
+func jetStreamFetchOnExit(call api.CallContext, batch jetstream.MessageBatch, err error) {
+	fetch, ok := call.GetData().(jetStreamFetch)
+	if !ok || batch == nil || err != nil {
+		return
+	}
+	otelBatch := &otelMessageBatch{
+		MessageBatch: batch,
+		msgs:         make(chan jetstream.Msg, cap(batch.Messages())),
+	}
+	go otelBatch.forward(fetch)
+	call.SetReturnVal(0, otelBatch)
+}
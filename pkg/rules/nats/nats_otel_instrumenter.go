@@ -0,0 +1,302 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const natsSystem = "nats"
+
+// request is not a messaging operation of the semantic conventions, it only
+// names the client span of a request/reply exchange.
+const natsRequestOperation message.MessageOperation = "request"
+
+const (
+	natsJetStreamStreamKey    = attribute.Key("messaging.nats.jetstream.stream")
+	natsJetStreamSequenceKey  = attribute.Key("messaging.nats.jetstream.sequence")
+	natsJetStreamAckKey       = attribute.Key("messaging.nats.jetstream.ack")
+	natsJetStreamDuplicateKey = attribute.Key("messaging.nats.jetstream.duplicate")
+)
+
+var natsEnabler = natsInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_NATS_ENABLED") != "false"}
+
+var (
+	publishInstrumenter = buildNatsPublishInstrumenter()
+	requestInstrumenter = buildNatsRequestInstrumenter()
+	processInstrumenter = buildNatsProcessInstrumenter()
+	receiveInstrumenter = buildNatsReceiveInstrumenter()
+)
+
+type natsInnerEnabler struct {
+	enabled bool
+}
+
+func (n natsInnerEnabler) Enable() bool {
+	return n.enabled
+}
+
+type natsHeaderCarrier struct {
+	header nats.Header
+}
+
+func (carrier natsHeaderCarrier) Get(key string) string {
+	if carrier.header == nil {
+		return ""
+	}
+	return carrier.header.Get(key)
+}
+
+func (carrier natsHeaderCarrier) Set(key, value string) {
+	if carrier.header == nil {
+		return
+	}
+	carrier.header.Set(key, value)
+}
+
+func (carrier natsHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.header))
+	for key := range carrier.header {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type natsAttrsGetter struct{}
+
+func (getter natsAttrsGetter) GetSystem(request *natsRequest) string {
+	return natsSystem
+}
+
+func (getter natsAttrsGetter) GetDestination(request *natsRequest) string {
+	return request.subject
+}
+
+func (getter natsAttrsGetter) GetDestinationTemplate(request *natsRequest) string {
+	return ""
+}
+
+// IsTemporaryDestination reports the inbox subjects which replies are sent to.
+func (getter natsAttrsGetter) IsTemporaryDestination(request *natsRequest) bool {
+	return strings.HasPrefix(request.subject, nats.InboxPrefix)
+}
+
+func (getter natsAttrsGetter) IsAnonymousDestination(request *natsRequest) bool {
+	return false
+}
+
+func (getter natsAttrsGetter) GetConversationId(request *natsRequest) string {
+	return ""
+}
+
+func (getter natsAttrsGetter) GetMessageBodySize(request *natsRequest) int64 {
+	return int64(request.bodySize)
+}
+
+func (getter natsAttrsGetter) GetMessageEnvelopSize(request *natsRequest) int64 {
+	return 0
+}
+
+func (getter natsAttrsGetter) GetMessageId(request *natsRequest, response any) string {
+	if request.header == nil {
+		return ""
+	}
+	return request.header.Get(nats.MsgIdHdr)
+}
+
+func (getter natsAttrsGetter) GetClientId(request *natsRequest) string {
+	return ""
+}
+
+func (getter natsAttrsGetter) GetBatchMessageCount(request *natsRequest, response any) int64 {
+	if request.batchSize > 0 {
+		return int64(request.batchSize)
+	}
+	return 1
+}
+
+func (getter natsAttrsGetter) GetMessageHeader(request *natsRequest, name string) []string {
+	if request.header == nil {
+		return []string{}
+	}
+	return request.header.Values(name)
+}
+
+func (getter natsAttrsGetter) GetDestinationPartitionId(request *natsRequest) string {
+	return ""
+}
+
+// natsErrorTypes are the errors of the client told apart by error.type, the
+// client reports its errors as sentinel values.
+var natsErrorTypes = []struct {
+	err       error
+	errorType string
+}{
+	{nats.ErrTimeout, "timeout"},
+	{nats.ErrNoResponders, "no_responders"},
+	{nats.ErrConnectionClosed, "connection_closed"},
+	{nats.ErrInvalidConnection, "invalid_connection"},
+	{nats.ErrMaxPayload, "max_payload"},
+	{nats.ErrBadSubject, "bad_subject"},
+}
+
+func (getter natsAttrsGetter) GetErrorType(request *natsRequest, response any, err error) string {
+	for _, e := range natsErrorTypes {
+		if errors.Is(err, e.err) {
+			return e.errorType
+		}
+	}
+	return ""
+}
+
+// natsAttrsExtractor adds the queue group and the JetStream attributes, the
+// ack outcome is only known once the operation ends.
+type natsAttrsExtractor struct{}
+
+func (extractor *natsAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *natsRequest) ([]attribute.KeyValue, context.Context) {
+	if request.queue != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.queue))
+	}
+	if request.consumer != "" {
+		attributes = append(attributes, semconv.MessagingDestinationSubscriptionName(request.consumer))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *natsAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request *natsRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if request.stream != "" {
+		attributes = append(attributes, natsJetStreamStreamKey.String(request.stream))
+	}
+	if request.sequence > 0 {
+		attributes = append(attributes, natsJetStreamSequenceKey.Int64(int64(request.sequence)))
+	}
+	if request.ack != "" {
+		attributes = append(attributes, natsJetStreamAckKey.String(request.ack))
+	}
+	if request.duplicate {
+		attributes = append(attributes, natsJetStreamDuplicateKey.Bool(true))
+	}
+	return attributes, ctx
+}
+
+// natsProcessSpanKindExtractor models the handling of a request as the server
+// side of the request/reply exchange.
+type natsProcessSpanKindExtractor struct{}
+
+func (extractor *natsProcessSpanKindExtractor) Extract(request *natsRequest) trace.SpanKind {
+	if request.server {
+		return trace.SpanKindServer
+	}
+	return trace.SpanKindConsumer
+}
+
+func natsHeaderCarrierGetter(request *natsRequest) propagation.TextMapCarrier {
+	return natsHeaderCarrier{header: request.header}
+}
+
+func buildNatsPublishInstrumenter() instrumenter.Instrumenter[*natsRequest, any] {
+	builder := instrumenter.Builder[*natsRequest, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[*natsRequest, any]{
+			Getter:        natsAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[*natsRequest]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[*natsRequest, any, natsAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&natsAttrsExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("nats.publish", message.PUBLISH)).
+		BuildPropagatingToDownstreamInstrumenter(natsHeaderCarrierGetter, otel.GetTextMapPropagator())
+}
+
+func buildNatsRequestInstrumenter() instrumenter.Instrumenter[*natsRequest, any] {
+	builder := instrumenter.Builder[*natsRequest, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[*natsRequest, any]{
+			Getter:        natsAttrsGetter{},
+			OperationName: natsRequestOperation,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*natsRequest]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[*natsRequest, any, natsAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&natsAttrsExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("nats.request", message.PUBLISH)).
+		BuildPropagatingToDownstreamInstrumenter(natsHeaderCarrierGetter, otel.GetTextMapPropagator())
+}
+
+func buildNatsProcessInstrumenter() instrumenter.Instrumenter[*natsRequest, any] {
+	builder := instrumenter.Builder[*natsRequest, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[*natsRequest, any]{
+			Getter:        natsAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&natsProcessSpanKindExtractor{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[*natsRequest, any, natsAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&natsAttrsExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("nats.process", message.PROCESS)).
+		BuildPropagatingFromUpstreamInstrumenter(natsHeaderCarrierGetter, otel.GetTextMapPropagator())
+}
+
+func buildNatsReceiveInstrumenter() instrumenter.Instrumenter[*natsRequest, any] {
+	builder := instrumenter.Builder[*natsRequest, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[*natsRequest, any]{
+			Getter:        natsAttrsGetter{},
+			OperationName: message.RECEIVE,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[*natsRequest]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[*natsRequest, any, natsAttrsGetter]{
+			Operation: message.RECEIVE,
+		}).
+		AddAttributesExtractor(&natsAttrsExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("nats.receive", message.RECEIVE)).
+		BuildInstrumenter()
+}
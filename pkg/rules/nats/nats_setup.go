@@ -0,0 +1,343 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// jetStreamPrefix is the prefix of the JetStream API and ack subjects, the
+// requests of the client library to them are not traced.
+const jetStreamPrefix = "$JS."
+
+const headerLine = "NATS/1.0\r\n"
+
+type natsSpan struct {
+	ctx     context.Context
+	request *natsRequest
+}
+
+// processSpans holds the requests of the messages being processed, so that
+// the ack of a JetStream message is recorded on its process span.
+var processSpans sync.Map
+
+// inNatsClientSpan reports whether a publish or request span of this
+// instrumentation is in progress, e.g. the request issued by a JetStream
+// publish, so that the nested operation is not traced twice.
+func inNatsClientSpan(ctx context.Context) bool {
+	span, ok := trace.SpanFromContext(ctx).(sdktrace.ReadOnlySpan)
+	if !ok || span.InstrumentationScope().Name != utils.NATS_SCOPE_NAME {
+		return false
+	}
+	return span.SpanKind() == trace.SpanKindProducer || span.SpanKind() == trace.SpanKindClient
+}
+
+func isInbox(nc *nats.Conn, subject string) bool {
+	prefix := nats.InboxPrefix
+	if nc != nil && nc.Opts.InboxPrefix != "" {
+		prefix = nc.Opts.InboxPrefix
+	}
+	return strings.HasPrefix(subject, prefix)
+}
+
+func decodeHeader(hdr []byte) nats.Header {
+	if len(hdr) == 0 {
+		return nats.Header{}
+	}
+	header, err := nats.DecodeHeadersMsg(hdr)
+	if err != nil {
+		return nil
+	}
+	return header
+}
+
+func encodeHeader(header nats.Header) []byte {
+	var b bytes.Buffer
+	b.WriteString(headerLine)
+	for key, values := range header {
+		for _, value := range values {
+			b.WriteString(key)
+			b.WriteString(": ")
+			b.WriteString(value)
+			b.WriteString("\r\n")
+		}
+	}
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
+
+// remoteStartOptions starts a new trace when the message does not carry one,
+// as the goroutines delivering the messages are spawned by the subscriber
+// and carry its stale context.
+func remoteStartOptions(header nats.Header) []trace.SpanStartOption {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), natsHeaderCarrier{header: header})
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	return []trace.SpanStartOption{trace.WithNewRoot()}
+}
+
+func producerLink(header nats.Header) (trace.Link, bool) {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), natsHeaderCarrier{header: header})
+	spanContext := trace.SpanContextFromContext(ctx)
+	return trace.Link{SpanContext: spanContext}, spanContext.IsValid()
+}
+
+func ackOutcome(ackType []byte) string {
+	switch {
+	case bytes.HasPrefix(ackType, []byte("+ACK")):
+		return "ack"
+	case bytes.HasPrefix(ackType, []byte("-NAK")):
+		return "nak"
+	case bytes.HasPrefix(ackType, []byte("+WPI")):
+		return "in_progress"
+	case bytes.HasPrefix(ackType, []byte("+TERM")):
+		return "term"
+	}
+	return ""
+}
+
+func recordAck(msg interface{}, ackType []byte) {
+	if value, ok := processSpans.Load(msg); ok {
+		value.(*natsRequest).ack = ackOutcome(ackType)
+	}
+}
+
+func startPublish(call api.CallContext, nc *nats.Conn, subj, reply string, hdr, data []byte, hdrIdx int) {
+	if !natsEnabler.Enable() || nc == nil {
+		return
+	}
+	if strings.HasPrefix(subj, jetStreamPrefix) || inNatsClientSpan(context.Background()) {
+		return
+	}
+	request := &natsRequest{
+		subject:  subj,
+		reply:    reply,
+		header:   decodeHeader(hdr),
+		bodySize: len(data),
+	}
+	ctx := publishInstrumenter.Start(context.Background(), request)
+	if request.header != nil && nc.HeadersSupported() {
+		call.SetParam(hdrIdx, encodeHeader(request.header))
+	}
+	call.SetData(natsSpan{ctx: ctx, request: request})
+}
+
+func endPublish(call api.CallContext, err error) {
+	span, ok := call.GetData().(natsSpan)
+	if !ok {
+		return
+	}
+	publishInstrumenter.End(span.ctx, span.request, nil, err)
+}
+
+// The hooks of the nats.go package are linked by its symbol prefix, in which
+// the dot of the last path element is escaped.
+//
+//go:linkname natsPublishOnEnter github.com/nats-io/nats%2ego.natsPublishOnEnter
+func natsPublishOnEnter(call api.CallContext, nc *nats.Conn, subj, reply string, hdr, data []byte) {
+	startPublish(call, nc, subj, reply, hdr, data, 3)
+}
+
+//go:linkname natsPublishOnExit github.com/nats-io/nats%2ego.natsPublishOnExit
+func natsPublishOnExit(call api.CallContext, err error) {
+	endPublish(call, err)
+}
+
+// natsPublishValidateReplyOnEnter is the publish hook since v1.48.0, where
+// the reply subject validation flag is added before the header.
+//
+//go:linkname natsPublishValidateReplyOnEnter github.com/nats-io/nats%2ego.natsPublishValidateReplyOnEnter
+func natsPublishValidateReplyOnEnter(call api.CallContext, nc *nats.Conn, subj, reply string, validateReply bool, hdr, data []byte) {
+	startPublish(call, nc, subj, reply, hdr, data, 4)
+}
+
+//go:linkname natsPublishValidateReplyOnExit github.com/nats-io/nats%2ego.natsPublishValidateReplyOnExit
+func natsPublishValidateReplyOnExit(call api.CallContext, err error) {
+	endPublish(call, err)
+}
+
+func startRequest(call api.CallContext, ctx context.Context, nc *nats.Conn, subj string, hdr, data []byte, hdrIdx int) {
+	if !natsEnabler.Enable() || nc == nil {
+		return
+	}
+	if strings.HasPrefix(subj, jetStreamPrefix) || inNatsClientSpan(ctx) {
+		return
+	}
+	request := &natsRequest{
+		subject:  subj,
+		header:   decodeHeader(hdr),
+		bodySize: len(data),
+	}
+	ctx = requestInstrumenter.Start(ctx, request)
+	if request.header != nil && nc.HeadersSupported() {
+		call.SetParam(hdrIdx, encodeHeader(request.header))
+	}
+	call.SetData(natsSpan{ctx: ctx, request: request})
+}
+
+//go:linkname natsRequestOnEnter github.com/nats-io/nats%2ego.natsRequestOnEnter
+func natsRequestOnEnter(call api.CallContext, nc *nats.Conn, subj string, hdr, data []byte, timeout time.Duration) {
+	startRequest(call, context.Background(), nc, subj, hdr, data, 2)
+}
+
+//go:linkname natsRequestWithContextOnEnter github.com/nats-io/nats%2ego.natsRequestWithContextOnEnter
+func natsRequestWithContextOnEnter(call api.CallContext, nc *nats.Conn, ctx context.Context, subj string, hdr, data []byte) {
+	if ctx == nil {
+		return
+	}
+	startRequest(call, ctx, nc, subj, hdr, data, 3)
+}
+
+func endRequest(call api.CallContext, msg *nats.Msg, err error) {
+	span, ok := call.GetData().(natsSpan)
+	if !ok {
+		return
+	}
+	requestInstrumenter.End(span.ctx, span.request, msg, err)
+}
+
+//go:linkname natsRequestOnExit github.com/nats-io/nats%2ego.natsRequestOnExit
+func natsRequestOnExit(call api.CallContext, msg *nats.Msg, err error) {
+	endRequest(call, msg, err)
+}
+
+//go:linkname natsRequestWithContextOnExit github.com/nats-io/nats%2ego.natsRequestWithContextOnExit
+func natsRequestWithContextOnExit(call api.CallContext, msg *nats.Msg, err error) {
+	endRequest(call, msg, err)
+}
+
+func wrapMsgHandler(nc *nats.Conn, subj, queue string, cb nats.MsgHandler) nats.MsgHandler {
+	// the inboxes receive replies and the pulled JetStream messages, which
+	// are traced by the request and the JetStream consumer respectively
+	if cb == nil || isInbox(nc, subj) {
+		return cb
+	}
+	return func(msg *nats.Msg) {
+		if !natsEnabler.Enable() || msg == nil {
+			cb(msg)
+			return
+		}
+		request := &natsRequest{
+			subject:  msg.Subject,
+			reply:    msg.Reply,
+			queue:    queue,
+			header:   msg.Header,
+			bodySize: len(msg.Data),
+			server:   msg.Reply != "" && !strings.HasPrefix(msg.Reply, jetStreamPrefix),
+		}
+		ctx := processInstrumenter.Start(context.Background(), request, remoteStartOptions(msg.Header)...)
+		processSpans.Store(msg, request)
+		cb(msg)
+		processSpans.Delete(msg)
+		processInstrumenter.End(ctx, request, nil, nil)
+	}
+}
+
+//go:linkname natsSubscribeOnEnter github.com/nats-io/nats%2ego.natsSubscribeOnEnter
+func natsSubscribeOnEnter(call api.CallContext, nc *nats.Conn, subj string, cb nats.MsgHandler) {
+	if !natsEnabler.Enable() {
+		return
+	}
+	call.SetParam(2, wrapMsgHandler(nc, subj, "", cb))
+}
+
+//go:linkname natsQueueSubscribeOnEnter github.com/nats-io/nats%2ego.natsQueueSubscribeOnEnter
+func natsQueueSubscribeOnEnter(call api.CallContext, nc *nats.Conn, subj, queue string, cb nats.MsgHandler) {
+	if !natsEnabler.Enable() {
+		return
+	}
+	call.SetParam(3, wrapMsgHandler(nc, subj, queue, cb))
+}
+
+//go:linkname natsNextMsgOnEnter github.com/nats-io/nats%2ego.natsNextMsgOnEnter
+func natsNextMsgOnEnter(call api.CallContext, sub *nats.Subscription, timeout time.Duration) {
+	if !natsEnabler.Enable() {
+		return
+	}
+	call.SetData(time.Now())
+}
+
+//go:linkname natsNextMsgWithContextOnEnter github.com/nats-io/nats%2ego.natsNextMsgWithContextOnEnter
+func natsNextMsgWithContextOnEnter(call api.CallContext, sub *nats.Subscription, ctx context.Context) {
+	if !natsEnabler.Enable() {
+		return
+	}
+	call.SetData(time.Now())
+}
+
+// recordReceive records the receive span of a sync subscription, the span is
+// linked to the producer as the message is processed by the caller after the
+// span ends.
+func recordReceive(call api.CallContext, msg *nats.Msg, err error) {
+	startTime, ok := call.GetData().(time.Time)
+	if !ok || msg == nil {
+		return
+	}
+	// the replies of the old style requests are received by a sync subscription
+	if inNatsClientSpan(context.Background()) {
+		return
+	}
+	request := &natsRequest{
+		subject:  msg.Subject,
+		reply:    msg.Reply,
+		header:   msg.Header,
+		bodySize: len(msg.Data),
+	}
+	if msg.Sub != nil {
+		request.queue = msg.Sub.Queue
+	}
+	var options []trace.SpanStartOption
+	if link, ok := producerLink(msg.Header); ok {
+		options = append(options, trace.WithLinks(link))
+	}
+	receiveInstrumenter.StartAndEndWithOptions(context.Background(), request, nil, err, startTime, time.Now(), options, nil)
+}
+
+//go:linkname natsNextMsgOnExit github.com/nats-io/nats%2ego.natsNextMsgOnExit
+func natsNextMsgOnExit(call api.CallContext, msg *nats.Msg, err error) {
+	recordReceive(call, msg, err)
+}
+
+//go:linkname natsNextMsgWithContextOnExit github.com/nats-io/nats%2ego.natsNextMsgWithContextOnExit
+func natsNextMsgWithContextOnExit(call api.CallContext, msg *nats.Msg, err error) {
+	recordReceive(call, msg, err)
+}
+
+//go:linkname natsMsgAckReplyOnEnter github.com/nats-io/nats%2ego.natsMsgAckReplyOnEnter
+func natsMsgAckReplyOnEnter(call api.CallContext, msg *nats.Msg, ackType []byte, sync bool, opts ...nats.AckOpt) {
+	if msg == nil {
+		return
+	}
+	recordAck(msg, ackType)
+}
+
+// ackTypeBytes converts the unexported ack type of the jetstream package.
+func ackTypeBytes(ackType interface{}) []byte {
+	return []byte(fmt.Sprintf("%s", ackType))
+}
@@ -26,6 +26,7 @@ type ollamaRequest struct {
 	model         string
 	messages      []api.Message
 	prompt        string
+	system        string
 
 	promptTokens     int
 	completionTokens int
@@ -65,22 +66,13 @@ type streamingState struct {
 	promptEvalCount int
 	evalCount       int
 	totalDuration   time.Duration
-
-	streamingCost *StreamingCostState
 }
 
-func newStreamingState(modelID string) *streamingState {
-	state := &streamingState{
+func newStreamingState() *streamingState {
+	return &streamingState{
 		startTime:     time.Now(),
 		lastChunkTime: time.Now(),
 	}
-
-	calculator := costCalculator
-	if calculator != nil && calculator.IsEnabled() {
-		state.streamingCost = calculator.NewStreamingCostState(modelID)
-	}
-
-	return state
 }
 
 func (s *streamingState) recordChunk(content string, evalCount int) {
@@ -96,10 +88,6 @@ func (s *streamingState) recordChunk(content string, evalCount int) {
 	if evalCount > 0 {
 		s.evalCount = evalCount
 		s.runningTokenCount = evalCount
-
-		if s.streamingCost != nil {
-			s.streamingCost.UpdateStreamingCost(evalCount)
-		}
 	}
 
 	s.lastChunkTime = time.Now()
@@ -115,10 +103,6 @@ func (s *streamingState) finalize(promptEvalCount, evalCount int, totalDuration
 	if totalDuration > 0 && evalCount > 0 {
 		s.tokenRate = float64(evalCount) / totalDuration.Seconds()
 	}
-
-	if s.streamingCost != nil && promptEvalCount > 0 {
-		s.streamingCost.SetInputTokens(promptEvalCount)
-	}
 }
 
 func (s *streamingState) getTTFTMillis() int64 {
@@ -142,14 +126,14 @@ type ollamaResponse struct {
 	promptTokens     int
 	completionTokens int
 
-	content string
+	content    string
+	toolCalls  []api.ToolCall
+	doneReason string
 
 	err error
 
 	streamingMetrics *streamingState
 
-	costMetrics *CostMetrics
-
 	embeddings   [][]float64
 	modelInfo    map[string]interface{}
 	modelList    []interface{}
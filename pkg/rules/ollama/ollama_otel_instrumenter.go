@@ -24,6 +24,7 @@ import (
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	ollamaapi "github.com/ollama/ollama/api"
 )
 
 type ollamaAttrsGetter struct{}
@@ -91,6 +92,50 @@ func (o ollamaAttrsGetter) GetAIOutput(response ollamaResponse) string { // Chan
 	return response.content
 }
 
+func (o ollamaAttrsGetter) GetAISystemInstructions(request ollamaRequest) []ai.MessagePart {
+	if request.system == "" {
+		return nil
+	}
+	return []ai.MessagePart{ai.TextPart(request.system)}
+}
+
+func (o ollamaAttrsGetter) GetAIInputMessages(request ollamaRequest) []ai.ChatMessage {
+	if request.prompt != "" {
+		return []ai.ChatMessage{{Role: "user", Parts: []ai.MessagePart{ai.TextPart(request.prompt)}}}
+	}
+	messages := make([]ai.ChatMessage, 0, len(request.messages))
+	for _, message := range request.messages {
+		messages = append(messages, chatMessageFromOllama(message.Role, message.Content, message.ToolCalls))
+	}
+	return messages
+}
+
+func (o ollamaAttrsGetter) GetAIOutputMessages(request ollamaRequest, response ollamaResponse) []ai.ChatMessage {
+	if response.content == "" && len(response.toolCalls) == 0 {
+		return nil
+	}
+	message := chatMessageFromOllama("assistant", response.content, response.toolCalls)
+	message.FinishReason = response.doneReason
+	return []ai.ChatMessage{message}
+}
+
+// chatMessageFromOllama converts a message of the Ollama API, whose tool
+// calls and results carry no ID.
+func chatMessageFromOllama(role string, content string, toolCalls []ollamaapi.ToolCall) ai.ChatMessage {
+	message := ai.ChatMessage{Role: role, Parts: []ai.MessagePart{}}
+	if role == "tool" {
+		message.Parts = append(message.Parts, ai.ToolCallResponsePart("", content))
+		return message
+	}
+	if content != "" {
+		message.Parts = append(message.Parts, ai.TextPart(content))
+	}
+	for _, toolCall := range toolCalls {
+		message.Parts = append(message.Parts, ai.ToolCallPart("", toolCall.Function.Name, toolCall.Function.Arguments))
+	}
+	return message
+}
+
 func (o ollamaAttrsGetter) GetAIResponseModel(request ollamaRequest, response ollamaResponse) string {
 	return request.model
 }
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ollama
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type SLOConfig struct {
+	LatencyThreshold   time.Duration
+	ErrorRateThreshold float64
+	P50Target          time.Duration
+	P95Target          time.Duration
+	P99Target          time.Duration
+	WindowSize         time.Duration
+	EvaluationInterval time.Duration
+}
+
+type SLOTracker struct {
+	config         *SLOConfig
+	latencies      []time.Duration
+	errors         []bool
+	mu             sync.RWMutex
+	lastEvaluation time.Time
+	p50            time.Duration
+	p95            time.Duration
+	p99            time.Duration
+	errorRate      float64
+	violations     int
+	totalRequests  int64
+}
+
+var sloTracker *SLOTracker
+
+func init() {
+	sloTracker = &SLOTracker{
+		config: &SLOConfig{
+			LatencyThreshold:   2 * time.Second,
+			ErrorRateThreshold: 0.01,
+			P50Target:          500 * time.Millisecond,
+			P95Target:          1500 * time.Millisecond,
+			P99Target:          3000 * time.Millisecond,
+			WindowSize:         5 * time.Minute,
+			EvaluationInterval: 1 * time.Minute,
+		},
+		latencies:      make([]time.Duration, 0, 1000),
+		errors:         make([]bool, 0, 1000),
+		lastEvaluation: time.Now(),
+	}
+}
+
+func (st *SLOTracker) RecordRequest(latency time.Duration, isError bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.latencies = append(st.latencies, latency)
+	st.errors = append(st.errors, isError)
+	atomic.AddInt64(&st.totalRequests, 1)
+
+	if len(st.latencies) > 10000 {
+		st.latencies = st.latencies[5000:]
+		st.errors = st.errors[5000:]
+	}
+
+	if time.Since(st.lastEvaluation) > st.config.EvaluationInterval {
+		st.evaluate()
+	}
+}
+
+func (st *SLOTracker) evaluate() {
+	if len(st.latencies) == 0 {
+		return
+	}
+
+	sortedLatencies := make([]time.Duration, len(st.latencies))
+	copy(sortedLatencies, st.latencies)
+
+	for i := 0; i < len(sortedLatencies); i++ {
+		for j := i + 1; j < len(sortedLatencies); j++ {
+			if sortedLatencies[i] > sortedLatencies[j] {
+				sortedLatencies[i], sortedLatencies[j] = sortedLatencies[j], sortedLatencies[i]
+			}
+		}
+	}
+
+	st.p50 = sortedLatencies[len(sortedLatencies)*50/100]
+	st.p95 = sortedLatencies[len(sortedLatencies)*95/100]
+	st.p99 = sortedLatencies[len(sortedLatencies)*99/100]
+
+	errorCount := 0
+	for _, e := range st.errors {
+		if e {
+			errorCount++
+		}
+	}
+	st.errorRate = float64(errorCount) / float64(len(st.errors))
+
+	if st.p50 > st.config.P50Target || st.p95 > st.config.P95Target ||
+		st.p99 > st.config.P99Target || st.errorRate > st.config.ErrorRateThreshold {
+		st.violations++
+	}
+
+	st.lastEvaluation = time.Now()
+}
+
+func (st *SLOTracker) GetMetrics() map[string]interface{} {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return map[string]interface{}{
+		"p50_ms":         st.p50.Milliseconds(),
+		"p95_ms":         st.p95.Milliseconds(),
+		"p99_ms":         st.p99.Milliseconds(),
+		"error_rate":     st.errorRate,
+		"violations":     st.violations,
+		"total_requests": atomic.LoadInt64(&st.totalRequests),
+		"slo_compliance": st.getCompliance(),
+	}
+}
+
+func (st *SLOTracker) getCompliance() float64 {
+	if st.totalRequests == 0 {
+		return 100.0
+	}
+	return (1.0 - float64(st.violations)/float64(st.totalRequests)) * 100.0
+}
+
+func (st *SLOTracker) IsPerformanceBottleneck(latency time.Duration) bool {
+	return latency > st.config.LatencyThreshold
+}
+
+func (st *SLOTracker) DetectQualityDegradation() bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	if len(st.errors) < 100 {
+		return false
+	}
+
+	recentErrors := st.errors[len(st.errors)-100:]
+	recentErrorCount := 0
+	for _, e := range recentErrors {
+		if e {
+			recentErrorCount++
+		}
+	}
+
+	recentErrorRate := float64(recentErrorCount) / 100.0
+	return recentErrorRate > st.config.ErrorRateThreshold*2
+}
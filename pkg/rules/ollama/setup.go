@@ -149,6 +149,7 @@ func clientGenerateOnEnter(call api.CallContext, c *ollamaapi.Client, ctx contex
 		operationType:    "generate",
 		model:            req.Model,
 		prompt:           req.Prompt,
+		system:           req.System,
 		isStreaming:      isStreaming,
 		serverAddress:    extractServerAddress(c),
 		temperature:      temp,
@@ -164,7 +165,7 @@ func clientGenerateOnEnter(call api.CallContext, c *ollamaapi.Client, ctx contex
 	call.SetParam(1, ctx)
 	var streamState *streamingState
 	if isStreaming {
-		streamState = newStreamingState(req.Model)
+		streamState = newStreamingState()
 	}
 	var finalResponse ollamaapi.GenerateResponse
 	var wrappedFn ollamaapi.GenerateResponseFunc = func(resp ollamaapi.GenerateResponse) error {
@@ -228,6 +229,7 @@ func clientGenerateOnExit(call api.CallContext, err error) {
 				ollamaResp.completionTokens = respPtr.EvalCount
 				ollamaResp.content = respPtr.Response
 			}
+			ollamaResp.doneReason = respPtr.DoneReason
 
 			reqPtr.promptTokens = ollamaResp.promptTokens
 			reqPtr.completionTokens = ollamaResp.completionTokens
@@ -265,7 +267,7 @@ func clientChatOnEnter(call api.CallContext, c *ollamaapi.Client, ctx context.Co
 	call.SetParam(1, ctx)
 	var streamState *streamingState
 	if isStreaming {
-		streamState = newStreamingState(req.Model)
+		streamState = newStreamingState()
 	}
 	var finalResponse ollamaapi.ChatResponse
 	var wrappedFn ollamaapi.ChatResponseFunc = func(resp ollamaapi.ChatResponse) error {
@@ -328,7 +330,9 @@ func clientChatOnExit(call api.CallContext, err error) {
 					ollamaResp.promptTokens = respPtr.PromptEvalCount
 				ollamaResp.completionTokens = respPtr.EvalCount
 				ollamaResp.content = respPtr.Message.Content
+				ollamaResp.toolCalls = respPtr.Message.ToolCalls
 			}
+			ollamaResp.doneReason = respPtr.DoneReason
 
 			reqPtr.promptTokens = ollamaResp.promptTokens
 			reqPtr.completionTokens = ollamaResp.completionTokens
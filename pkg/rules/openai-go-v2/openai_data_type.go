@@ -55,6 +55,7 @@ type openaiResponse struct {
 	usageInputTokens  int64
 	usageOutputTokens int64
 	usageTotalTokens  int64
+	usageCachedTokens int64
 	responseID        string
 	outputMessages    string
 	choiceCount       int
@@ -19,6 +19,7 @@ import (
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
@@ -110,6 +111,24 @@ func (openaiLLMRequest) GetAIOutput(response openaiResponse) string {
 	return response.outputMessages
 }
 
+func (openaiLLMRequest) GetAISystemInstructions(request openaiRequest) []ai.MessagePart {
+	return nil
+}
+
+func (openaiLLMRequest) GetAIInputMessages(request openaiRequest) []ai.ChatMessage {
+	return ai.ChatMessagesFromOpenAI([]byte(request.inputMessages))
+}
+
+func (openaiLLMRequest) GetAIOutputMessages(request openaiRequest, response openaiResponse) []ai.ChatMessage {
+	messages := ai.ChatMessagesFromOpenAI([]byte(response.outputMessages))
+	if len(messages) == len(response.finishReasons) {
+		for i := range messages {
+			messages[i].FinishReason = response.finishReasons[i]
+		}
+	}
+	return messages
+}
+
 // OpenAIExperimentalAttributeExtractor adds OpenAI-specific experimental attributes
 type OpenAIExperimentalAttributeExtractor struct {
 	Base ai.AILLMAttrsExtractor[openaiRequest, openaiResponse, openaiCommonRequest, openaiLLMRequest]
@@ -129,10 +148,15 @@ func (o OpenAIExperimentalAttributeExtractor) OnEnd(attributes []attribute.KeyVa
 			Value: attribute.Int64Value(response.usageTotalTokens),
 		})
 	}
+	if response.usageCachedTokens > 0 {
+		attributes = append(attributes, ai.GenAIUsageCacheReadInputTokensKey.Int64(response.usageCachedTokens))
+	}
 
 	return attributes, ctx
 }
 
+var openaiToolInstrument = ai.BuildToolCallInstrumenter(utils.OPENAI_SCOPE_NAME)
+
 // BuildOpenAIClientOtelInstrumenter builds the OpenAI client instrumenter
 func BuildOpenAIClientOtelInstrumenter() instrumenter.Instrumenter[openaiRequest, openaiResponse] {
 	builder := instrumenter.Builder[openaiRequest, openaiResponse]{}
@@ -151,7 +175,7 @@ func BuildOpenAIClientOtelInstrumenter() instrumenter.Instrumenter[openaiRequest
 			},
 		}).
 		SetInstrumentationScope(instrumentation.Scope{
-			Name:    "loongsuite.instrumentation.openai",
+			Name:    utils.OPENAI_SCOPE_NAME,
 			Version: version.Tag,
 		}).
 		AddOperationListeners(ai.AIClientMetrics("openai-client")).
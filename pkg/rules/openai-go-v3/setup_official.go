@@ -23,6 +23,7 @@ import (
 	_ "unsafe"
 
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/ai"
 )
 
 // Hooks for github.com/openai/openai-go (official OpenAI SDK)
@@ -48,6 +49,7 @@ func newChatCompletionOnEnter(call api.CallContext, r *openai.ChatCompletionServ
 	input, err := json.Marshal(body.Messages)
 	if err == nil {
 		request.inputMessages = string(input)
+		ai.ReportToolCallResults(ctx, openaiToolInstrument, "openai", ai.ChatMessagesFromOpenAI(input))
 	}
 	recorder := NewAIMetricsRecorder()
 	instrumentedCtx := recorder.Start(ctx, request)
@@ -85,6 +87,7 @@ func newChatCompletionOnExit(call api.CallContext, resp *openai.ChatCompletion,
 		response.usageInputTokens = resp.Usage.PromptTokens
 		request.inputTokens = response.usageInputTokens
 		response.usageOutputTokens = resp.Usage.CompletionTokens
+		response.usageCachedTokens = resp.Usage.PromptTokensDetails.CachedTokens
 		response.choiceCount = len(resp.Choices)
 		var messages []openai.ChatCompletionMessage
 		for _, choice := range resp.Choices {
@@ -120,6 +123,7 @@ func officialNewChatCompletionStreamOnEnter(call api.CallContext, r *openai.Chat
 	input, err := json.Marshal(body.Messages)
 	if err == nil {
 		request.inputMessages = string(input)
+		ai.ReportToolCallResults(ctx, openaiToolInstrument, "openai", ai.ChatMessagesFromOpenAI(input))
 	}
 	recorder := NewAIMetricsRecorder()
 	instrumentedCtx := recorder.Start(ctx, request)
@@ -0,0 +1,30 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+// pgxRequest is shared by the start and the end of a traced call, the
+// statements of a batch are only known once each queued query is sent.
+type pgxRequest struct {
+	operation  string
+	statement  string
+	collection string
+	host       string
+	port       int
+	dbName     string
+	batchSize  int
+	params     []any
+	// operations of the queued queries of a batch
+	operations []string
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+import (
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type pgxAttrsGetter struct{}
+
+func (p pgxAttrsGetter) GetSystem(_ *pgxRequest) string {
+	return "postgresql"
+}
+
+func (p pgxAttrsGetter) GetServerAddress(request *pgxRequest) string {
+	return request.host
+}
+
+func (p pgxAttrsGetter) GetServerPort(request *pgxRequest) int {
+	return request.port
+}
+
+func (p pgxAttrsGetter) GetStatement(request *pgxRequest) string {
+	return request.statement
+}
+
+func (p pgxAttrsGetter) GetCollection(request *pgxRequest) string {
+	return request.collection
+}
+
+func (p pgxAttrsGetter) GetOperation(request *pgxRequest) string {
+	return request.operation
+}
+
+func (p pgxAttrsGetter) GetParameters(request *pgxRequest) []any {
+	return request.params
+}
+
+func (p pgxAttrsGetter) GetDbNamespace(request *pgxRequest) string {
+	return request.dbName
+}
+
+func (p pgxAttrsGetter) GetBatchSize(request *pgxRequest) int {
+	return request.batchSize
+}
+
+func BuildPgxOtelInstrumenter() instrumenter.Instrumenter[*pgxRequest, any] {
+	builder := instrumenter.Builder[*pgxRequest, any]{}
+	getter := pgxAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[*pgxRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*pgxRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*pgxRequest, any, pgxAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[*pgxRequest, any, pgxAttrsGetter]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.PGX_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(db.DbClientMetrics("pgx")).
+		BuildInstrumenter()
+}
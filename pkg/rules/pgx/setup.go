@@ -0,0 +1,293 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type pgxInnerEnabler struct {
+	enabled bool
+}
+
+func (p pgxInnerEnabler) Enable() bool {
+	return p.enabled
+}
+
+var pgxEnabler = pgxInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_PGX_ENABLED") != "false"}
+
+var pgxInstrumenter = BuildPgxOtelInstrumenter()
+
+var pgxSpanNameExtractor = db.DBSpanNameExtractor[*pgxRequest]{Getter: pgxAttrsGetter{}}
+
+type pgxRequestKey struct{}
+
+// otelTracer traces the queries of a connection, the tracer configured by the
+// user is kept as inner and called within the span.
+type otelTracer struct {
+	inner  pgx.QueryTracer
+	host   string
+	port   int
+	dbName string
+}
+
+var (
+	_ pgx.QueryTracer    = (*otelTracer)(nil)
+	_ pgx.BatchTracer    = (*otelTracer)(nil)
+	_ pgx.CopyFromTracer = (*otelTracer)(nil)
+	_ pgx.PrepareTracer  = (*otelTracer)(nil)
+	_ pgx.ConnectTracer  = (*otelTracer)(nil)
+)
+
+func (t *otelTracer) newRequest(statement string) *pgxRequest {
+	operation, collection := db.StatementOperation(statement)
+	return &pgxRequest{
+		operation:  operation,
+		statement:  statement,
+		collection: collection,
+		host:       t.host,
+		port:       t.port,
+		dbName:     t.dbName,
+	}
+}
+
+func (t *otelTracer) start(ctx context.Context, request *pgxRequest) context.Context {
+	ctx = pgxInstrumenter.Start(ctx, request)
+	return context.WithValue(ctx, pgxRequestKey{}, request)
+}
+
+func (t *otelTracer) end(ctx context.Context, err error) {
+	request, ok := ctx.Value(pgxRequestKey{}).(*pgxRequest)
+	if !ok {
+		return
+	}
+	pgxInstrumenter.End(ctx, request, nil, err)
+}
+
+func (t *otelTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	request := t.newRequest(data.SQL)
+	request.params = data.Args
+	ctx = t.start(ctx, request)
+	if t.inner != nil {
+		ctx = t.inner.TraceQueryStart(ctx, conn, data)
+	}
+	return ctx
+}
+
+func (t *otelTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	if t.inner != nil {
+		t.inner.TraceQueryEnd(ctx, conn, data)
+	}
+	t.end(ctx, data.Err)
+}
+
+func (t *otelTracer) TraceBatchStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	request := t.newRequest("")
+	if data.Batch != nil {
+		request.batchSize = data.Batch.Len()
+	}
+	ctx = t.start(ctx, request)
+	if inner, ok := t.inner.(pgx.BatchTracer); ok {
+		ctx = inner.TraceBatchStart(ctx, conn, data)
+	}
+	return ctx
+}
+
+func (t *otelTracer) TraceBatchQuery(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchQueryData) {
+	if inner, ok := t.inner.(pgx.BatchTracer); ok {
+		inner.TraceBatchQuery(ctx, conn, data)
+	}
+	request, ok := ctx.Value(pgxRequestKey{}).(*pgxRequest)
+	if !ok {
+		return
+	}
+	operation, collection := db.StatementOperation(data.SQL)
+	if len(request.operations) == 0 {
+		request.statement = data.SQL
+		request.collection = collection
+	} else {
+		request.statement += "; " + data.SQL
+		if request.collection != collection {
+			request.collection = ""
+		}
+	}
+	request.operations = append(request.operations, operation)
+}
+
+func (t *otelTracer) TraceBatchEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchEndData) {
+	if inner, ok := t.inner.(pgx.BatchTracer); ok {
+		inner.TraceBatchEnd(ctx, conn, data)
+	}
+	if request, ok := ctx.Value(pgxRequestKey{}).(*pgxRequest); ok {
+		request.operation = db.CommonOperation(request.operations...)
+		// the queued queries are only known after the span is started
+		trace.SpanFromContext(ctx).SetName(pgxSpanNameExtractor.Extract(request))
+	}
+	t.end(ctx, data.Err)
+}
+
+func (t *otelTracer) TraceCopyFromStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	request := t.newRequest("")
+	request.operation = "COPY"
+	request.collection = strings.Join(data.TableName, ".")
+	request.statement = "COPY " + data.TableName.Sanitize() + " (" + strings.Join(data.ColumnNames, ", ") + ") FROM STDIN"
+	ctx = t.start(ctx, request)
+	if inner, ok := t.inner.(pgx.CopyFromTracer); ok {
+		ctx = inner.TraceCopyFromStart(ctx, conn, data)
+	}
+	return ctx
+}
+
+func (t *otelTracer) TraceCopyFromEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	if inner, ok := t.inner.(pgx.CopyFromTracer); ok {
+		inner.TraceCopyFromEnd(ctx, conn, data)
+	}
+	t.end(ctx, data.Err)
+}
+
+func (t *otelTracer) TracePrepareStart(ctx context.Context, conn *pgx.Conn, data pgx.TracePrepareStartData) context.Context {
+	request := t.newRequest(data.SQL)
+	request.operation = "PREPARE"
+	ctx = t.start(ctx, request)
+	if inner, ok := t.inner.(pgx.PrepareTracer); ok {
+		ctx = inner.TracePrepareStart(ctx, conn, data)
+	}
+	return ctx
+}
+
+func (t *otelTracer) TracePrepareEnd(ctx context.Context, conn *pgx.Conn, data pgx.TracePrepareEndData) {
+	if inner, ok := t.inner.(pgx.PrepareTracer); ok {
+		inner.TracePrepareEnd(ctx, conn, data)
+	}
+	t.end(ctx, data.Err)
+}
+
+// TraceConnectStart and TraceConnectEnd are only implemented to keep the
+// connect tracer of the user working.
+func (t *otelTracer) TraceConnectStart(ctx context.Context, data pgx.TraceConnectStartData) context.Context {
+	if inner, ok := t.inner.(pgx.ConnectTracer); ok {
+		return inner.TraceConnectStart(ctx, data)
+	}
+	return ctx
+}
+
+func (t *otelTracer) TraceConnectEnd(ctx context.Context, data pgx.TraceConnectEndData) {
+	if inner, ok := t.inner.(pgx.ConnectTracer); ok {
+		inner.TraceConnectEnd(ctx, data)
+	}
+}
+
+//go:linkname beforePgxConnect github.com/jackc/pgx/v5.beforePgxConnect
+func beforePgxConnect(call api.CallContext, ctx context.Context, config *pgx.ConnConfig) {
+	if !pgxEnabler.Enable() {
+		return
+	}
+	if config == nil {
+		return
+	}
+	if _, ok := config.Tracer.(*otelTracer); ok {
+		return
+	}
+	config.Tracer = &otelTracer{
+		inner:  config.Tracer,
+		host:   config.Host,
+		port:   int(config.Port),
+		dbName: config.Database,
+	}
+}
+
+type pgxPool struct {
+	name       string
+	unregister func()
+}
+
+var pgxPools sync.Map
+
+// ref: https://opentelemetry.io/docs/specs/semconv/attributes-registry/db/#db-client-connection-pool-name
+func poolName(config *pgxpool.Config) string {
+	if config == nil || config.ConnConfig == nil {
+		return ""
+	}
+	return config.ConnConfig.Host + ":" + strconv.Itoa(int(config.ConnConfig.Port)) + "/" + config.ConnConfig.Database
+}
+
+//go:linkname afterPgxPoolNewWithConfig github.com/jackc/pgx/v5/pgxpool.afterPgxPoolNewWithConfig
+func afterPgxPoolNewWithConfig(call api.CallContext, pool *pgxpool.Pool, err error) {
+	if !pgxEnabler.Enable() {
+		return
+	}
+	if pool == nil || err != nil {
+		return
+	}
+	name := poolName(pool.Config())
+	unregister := db.RegisterConnectionPool(name, func() db.ConnectionPoolStats {
+		stat := pool.Stat()
+		return db.ConnectionPoolStats{
+			Idle: int64(stat.IdleConns()),
+			Used: int64(stat.AcquiredConns()),
+			Max:  int64(stat.MaxConns()),
+		}
+	})
+	pgxPools.Store(pool, &pgxPool{name: name, unregister: unregister})
+}
+
+//go:linkname beforePgxPoolClose github.com/jackc/pgx/v5/pgxpool.beforePgxPoolClose
+func beforePgxPoolClose(call api.CallContext, pool *pgxpool.Pool) {
+	if p, ok := pgxPools.LoadAndDelete(pool); ok {
+		p.(*pgxPool).unregister()
+	}
+}
+
+type pgxAcquire struct {
+	ctx       context.Context
+	pool      *pgxpool.Pool
+	startTime time.Time
+}
+
+//go:linkname beforePgxPoolAcquire github.com/jackc/pgx/v5/pgxpool.beforePgxPoolAcquire
+func beforePgxPoolAcquire(call api.CallContext, pool *pgxpool.Pool, ctx context.Context) {
+	if !pgxEnabler.Enable() {
+		return
+	}
+	call.SetData(pgxAcquire{ctx: ctx, pool: pool, startTime: time.Now()})
+}
+
+//go:linkname afterPgxPoolAcquire github.com/jackc/pgx/v5/pgxpool.afterPgxPoolAcquire
+func afterPgxPoolAcquire(call api.CallContext, conn *pgxpool.Conn, err error) {
+	if !pgxEnabler.Enable() {
+		return
+	}
+	acquire, ok := call.GetData().(pgxAcquire)
+	if !ok {
+		return
+	}
+	pool, ok := pgxPools.Load(acquire.pool)
+	if !ok {
+		return
+	}
+	db.RecordConnectionWaitTime(acquire.ctx, pool.(*pgxPool).name, time.Since(acquire.startTime))
+}
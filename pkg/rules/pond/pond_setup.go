@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pond
+
+import (
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/gls"
+)
+
+type pondInnerEnabler struct {
+	enabled bool
+}
+
+func (p pondInnerEnabler) Enable() bool {
+	return p.enabled
+}
+
+var pondEnabler = pondInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_POND_ENABLED") != "false"}
+
+// all the submissions of v1, including the ones of the task groups, end up in
+// WorkerPool.submit
+//
+//go:linkname pondWorkerPoolSubmitOnEnter github.com/alitto/pond.pondWorkerPoolSubmitOnEnter
+func pondWorkerPoolSubmitOnEnter(call api.CallContext, _ interface{}, task func(), mustSubmit bool) {
+	if !pondEnabler.Enable() || task == nil {
+		return
+	}
+	call.SetParam(1, gls.Wrap(task))
+}
+
+// all the submissions of v2, including the ones of the groups, the result
+// pools and the subpools, end up in pool.submit
+//
+//go:linkname pondPoolSubmitOnEnter github.com/alitto/pond/v2.pondPoolSubmitOnEnter
+func pondPoolSubmitOnEnter(call api.CallContext, _ interface{}, task interface{}, nonBlocking bool) {
+	if !pondEnabler.Enable() {
+		return
+	}
+	if wrapped := wrapTask(task); wrapped != nil {
+		call.SetParam(1, wrapped)
+	}
+}
+
+// wrapTask runs the task with the context of the submitter, keeping the
+// function types the workers of v2 know how to invoke.
+func wrapTask(task interface{}) interface{} {
+	snapshot := gls.Capture()
+	switch t := task.(type) {
+	case func():
+		return func() {
+			snapshot.Run(t)
+		}
+	case func() error:
+		return func() (err error) {
+			snapshot.Run(func() { err = t() })
+			return err
+		}
+	case func() interface{}:
+		return func() (output interface{}) {
+			snapshot.Run(func() { output = t() })
+			return output
+		}
+	case func() (interface{}, error):
+		return func() (output interface{}, err error) {
+			snapshot.Run(func() { output, err = t() })
+			return output, err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qdrant
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type qdrantInnerEnabler struct {
+	enabled bool
+}
+
+func (q qdrantInnerEnabler) Enable() bool {
+	return q.enabled
+}
+
+var qdrantEnabler = qdrantInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_QDRANT_ENABLED") != "false"}
+
+type qdrantAttrsGetter struct {
+}
+
+var _ db.DbClientAttrsGetter[qdrantRequest] = qdrantAttrsGetter{}
+var _ db.VectorDbAttrsGetter[qdrantRequest, qdrantResponse] = qdrantAttrsGetter{}
+
+func (q qdrantAttrsGetter) GetSystem(request qdrantRequest) string {
+	return db.SystemQdrant
+}
+
+// the client balances the calls over a pool of connections without telling
+// which one serves a call, the peer is reported by the nested gRPC span
+func (q qdrantAttrsGetter) GetServerAddress(request qdrantRequest) string {
+	return ""
+}
+
+func (q qdrantAttrsGetter) GetServerPort(request qdrantRequest) int {
+	return 0
+}
+
+// the filters are not reported, they may carry sensitive values
+func (q qdrantAttrsGetter) GetStatement(request qdrantRequest) string {
+	return ""
+}
+
+func (q qdrantAttrsGetter) GetOperation(request qdrantRequest) string {
+	return request.operation
+}
+
+func (q qdrantAttrsGetter) GetCollection(request qdrantRequest) string {
+	return request.collection
+}
+
+func (q qdrantAttrsGetter) GetParameters(request qdrantRequest) []any {
+	return nil
+}
+
+func (q qdrantAttrsGetter) GetDbNamespace(request qdrantRequest) string {
+	return ""
+}
+
+func (q qdrantAttrsGetter) GetBatchSize(request qdrantRequest) int {
+	return 0
+}
+
+func (q qdrantAttrsGetter) GetTopK(request qdrantRequest) int {
+	return request.topK
+}
+
+func (q qdrantAttrsGetter) GetVectorDimension(request qdrantRequest) int {
+	return request.dimension
+}
+
+func (q qdrantAttrsGetter) GetResultCount(request qdrantRequest, response qdrantResponse) int {
+	return response.resultCount
+}
+
+func BuildQdrantInstrumenter() instrumenter.Instrumenter[qdrantRequest, qdrantResponse] {
+	builder := instrumenter.Builder[qdrantRequest, qdrantResponse]{}
+	getter := qdrantAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[qdrantRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[qdrantRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[qdrantRequest, qdrantResponse, qdrantAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[qdrantRequest, qdrantResponse, qdrantAttrsGetter]{Getter: getter}}).
+		AddAttributesExtractor(&db.VectorDbAttrsExtractor[qdrantRequest, qdrantResponse, qdrantAttrsGetter]{Getter: getter}).
+		AddOperationListeners(db.DbClientMetrics("vector.qdrant")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.QDRANT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
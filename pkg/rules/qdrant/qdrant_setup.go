@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qdrant
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+var qdrantInstrumenter = BuildQdrantInstrumenter()
+
+// startQdrantOperation starts the span and passes its context to the callee,
+// so the gRPC client span of the request nests under it
+func startQdrantOperation(call api.CallContext, ctx context.Context, request qdrantRequest) {
+	newCtx := qdrantInstrumenter.Start(ctx, request)
+	call.SetParam(1, newCtx)
+	call.SetKeyData("ctx", newCtx)
+	call.SetKeyData("request", request)
+}
+
+func endQdrantOperation(call api.CallContext, resultCount int, err error) {
+	newCtx, ok := call.GetKeyData("ctx").(context.Context)
+	if !ok {
+		return
+	}
+	request := call.GetKeyData("request").(qdrantRequest)
+	qdrantInstrumenter.End(newCtx, request, qdrantResponse{resultCount: resultCount}, err)
+}
+
+func vectorDimension(vector *qdrant.Vector) int {
+	if vector == nil {
+		return 0
+	}
+	// dense vectors moved out of the deprecated data field in 1.16
+	if dense, ok := any(vector).(interface{ GetDense() *qdrant.DenseVector }); ok {
+		if dimension := len(dense.GetDense().GetData()); dimension > 0 {
+			return dimension
+		}
+	}
+	return len(vector.GetData())
+}
+
+//go:linkname beforeQdrantQuery github.com/qdrant/go-client/qdrant.beforeQdrantQuery
+func beforeQdrantQuery(call api.CallContext, client *qdrant.Client, ctx context.Context, request *qdrant.QueryPoints) {
+	if !qdrantEnabler.Enable() || ctx == nil || request == nil {
+		return
+	}
+	qr := qdrantRequest{operation: "query", collection: request.GetCollectionName(), topK: int(request.GetLimit())}
+	// the nearest and the prefetch (hybrid) queries are similarity searches,
+	// the others scroll the points by a filter or an order
+	if nearest := request.GetQuery().GetNearest(); nearest != nil {
+		qr.operation = "search"
+		qr.dimension = len(nearest.GetDense().GetData())
+	} else if len(request.GetPrefetch()) > 0 {
+		qr.operation = "search"
+	}
+	startQdrantOperation(call, ctx, qr)
+}
+
+//go:linkname afterQdrantQuery github.com/qdrant/go-client/qdrant.afterQdrantQuery
+func afterQdrantQuery(call api.CallContext, points []*qdrant.ScoredPoint, err error) {
+	endQdrantOperation(call, len(points), err)
+}
+
+//go:linkname beforeQdrantUpsert github.com/qdrant/go-client/qdrant.beforeQdrantUpsert
+func beforeQdrantUpsert(call api.CallContext, client *qdrant.Client, ctx context.Context, request *qdrant.UpsertPoints) {
+	if !qdrantEnabler.Enable() || ctx == nil || request == nil {
+		return
+	}
+	qr := qdrantRequest{operation: "upsert", collection: request.GetCollectionName()}
+	if points := request.GetPoints(); len(points) > 0 {
+		qr.dimension = vectorDimension(points[0].GetVectors().GetVector())
+	}
+	startQdrantOperation(call, ctx, qr)
+}
+
+//go:linkname afterQdrantUpsert github.com/qdrant/go-client/qdrant.afterQdrantUpsert
+func afterQdrantUpsert(call api.CallContext, result *qdrant.UpdateResult, err error) {
+	endQdrantOperation(call, -1, err)
+}
+
+//go:linkname beforeQdrantDelete github.com/qdrant/go-client/qdrant.beforeQdrantDelete
+func beforeQdrantDelete(call api.CallContext, client *qdrant.Client, ctx context.Context, request *qdrant.DeletePoints) {
+	if !qdrantEnabler.Enable() || ctx == nil || request == nil {
+		return
+	}
+	startQdrantOperation(call, ctx, qdrantRequest{operation: "delete", collection: request.GetCollectionName()})
+}
+
+//go:linkname afterQdrantDelete github.com/qdrant/go-client/qdrant.afterQdrantDelete
+func afterQdrantDelete(call api.CallContext, result *qdrant.UpdateResult, err error) {
+	endQdrantOperation(call, -1, err)
+}
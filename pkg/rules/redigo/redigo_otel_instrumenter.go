@@ -45,6 +45,10 @@ func (m redigoAttrsGetter) GetServerAddress(request *redigoRequest) string {
 	return request.endpoint
 }
 
+func (m redigoAttrsGetter) GetServerPort(request *redigoRequest) int {
+	return 0
+}
+
 func (m redigoAttrsGetter) GetStatement(request *redigoRequest) string {
 	builder := strings.Builder{}
 	builder.WriteString(request.cmd + " ")
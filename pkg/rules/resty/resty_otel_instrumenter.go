@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resty
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const backoffEventName = "http.request.backoff"
+
+const backoffDurationKey = attribute.Key("http.request.backoff.duration")
+
+var restyEnabler = restyInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_RESTY_ENABLED") != "false"}
+
+var restyInstrumenter = buildRestyInstrumenter()
+
+type restyInnerEnabler struct {
+	enabled bool
+}
+
+func (r restyInnerEnabler) Enable() bool {
+	return r.enabled
+}
+
+type restySpanNameExtractor struct{}
+
+func (extractor *restySpanNameExtractor) Extract(request *restyRequest) string {
+	if request.method == "" {
+		return "HTTP"
+	}
+	return request.method
+}
+
+// restyAttrsExtractor records the logical request, the attributes of each
+// attempt are recorded by the net/http client spans.
+type restyAttrsExtractor struct{}
+
+func (extractor *restyAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *restyRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.HTTPRequestMethodKey.String(request.method))
+	return attributes, parentContext
+}
+
+func (extractor *restyAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *restyRequest, response *restyResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if request.url != "" {
+		attributes = append(attributes, semconv.URLFull(request.url))
+	}
+	if response != nil && response.statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+		if response.statusCode >= 400 {
+			attributes = append(attributes, semconv.ErrorTypeKey.String(strconv.Itoa(response.statusCode)))
+		}
+	} else if err != nil {
+		attributes = append(attributes, semconv.ErrorTypeOther)
+	}
+	return attributes, context
+}
+
+func buildRestyInstrumenter() instrumenter.Instrumenter[*restyRequest, *restyResponse] {
+	builder := instrumenter.Builder[*restyRequest, *restyResponse]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.RESTY_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&restySpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[*restyRequest]{}).
+		AddAttributesExtractor(&restyAttrsExtractor{}).
+		BuildInstrumenter()
+}
+
+// recordBackoff adds the wait before the next attempt to the logical request
+// span, which is the current span of the goroutine between two attempts.
+func recordBackoff(resendCount int, wait time.Duration) {
+	span := trace.SpanFromContext(context.Background())
+	readOnlySpan, ok := span.(sdktrace.ReadOnlySpan)
+	if !ok || !span.IsRecording() || readOnlySpan.InstrumentationScope().Name != utils.RESTY_SCOPE_NAME {
+		return
+	}
+	span.AddEvent(backoffEventName, trace.WithAttributes(
+		semconv.HTTPRequestResendCount(resendCount),
+		backoffDurationKey.Float64(wait.Seconds()),
+	))
+}
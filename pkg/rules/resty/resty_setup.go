@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resty
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/http"
+	"github.com/go-resty/resty/v2"
+)
+
+type restyLogicalRequest struct {
+	ctx       context.Context
+	parentCtx context.Context
+	r         *resty.Request
+	request   *restyRequest
+}
+
+//go:linkname restyExecuteOnEnter github.com/go-resty/resty/v2.restyExecuteOnEnter
+func restyExecuteOnEnter(call api.CallContext, r *resty.Request, method, url string) {
+	if !restyEnabler.Enable() || r == nil {
+		return
+	}
+	parentCtx := r.Context()
+	request := &restyRequest{method: method, url: url}
+	ctx := restyInstrumenter.Start(parentCtx, request)
+	// every attempt is sent with the logical request span in context, the
+	// counter stamps the resend count on the net/http client spans
+	r.SetContext(http.ContextWithResendCounter(ctx))
+	call.SetData(restyLogicalRequest{ctx: ctx, parentCtx: parentCtx, r: r, request: request})
+}
+
+//go:linkname restyExecuteOnExit github.com/go-resty/resty/v2.restyExecuteOnExit
+func restyExecuteOnExit(call api.CallContext, resp *resty.Response, err error) {
+	logical, ok := call.GetData().(restyLogicalRequest)
+	if !ok {
+		return
+	}
+	logical.r.SetContext(logical.parentCtx)
+	// the url is resolved against the base url of the client when sent
+	if logical.r.RawRequest != nil && logical.r.RawRequest.URL != nil {
+		logical.request.url = logical.r.RawRequest.URL.String()
+	}
+	response := &restyResponse{}
+	if resp != nil {
+		response.statusCode = resp.StatusCode()
+	}
+	restyInstrumenter.End(logical.ctx, logical.request, response, err)
+}
+
+//go:linkname restySleepDurationOnEnter github.com/go-resty/resty/v2.restySleepDurationOnEnter
+func restySleepDurationOnEnter(call api.CallContext, resp *resty.Response, min, max time.Duration, attempt int) {
+	if !restyEnabler.Enable() {
+		return
+	}
+	call.SetData(attempt)
+}
+
+//go:linkname restySleepDurationOnExit github.com/go-resty/resty/v2.restySleepDurationOnExit
+func restySleepDurationOnExit(call api.CallContext, wait time.Duration, err error) {
+	attempt, ok := call.GetData().(int)
+	if !ok || err != nil {
+		return
+	}
+	// the wait of attempt n comes before the (n+1)th resend
+	recordBackoff(attempt+1, wait)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retryablehttp
+
+import (
+	"context"
+	"net/http"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	httpsemconv "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/http"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type retryableHttpLogicalRequest struct {
+	ctx       context.Context
+	parentCtx context.Context
+	req       *retryablehttp.Request
+	request   *retryableHttpRequest
+}
+
+//go:linkname clientDoOnEnter github.com/hashicorp/go-retryablehttp.clientDoOnEnter
+func clientDoOnEnter(call api.CallContext, c *retryablehttp.Client, req *retryablehttp.Request) {
+	if !retryableHttpEnabler.Enable() || req == nil || req.Request == nil {
+		return
+	}
+	parentCtx := req.Context()
+	request := &retryableHttpRequest{method: req.Method}
+	if req.URL != nil {
+		request.url = req.URL.String()
+	}
+	ctx := retryableHttpInstrumenter.Start(parentCtx, request)
+	// every attempt is sent with the logical request span in context, the
+	// counter stamps the resend count on the net/http client spans
+	req.Request = req.Request.WithContext(httpsemconv.ContextWithResendCounter(ctx))
+	call.SetData(retryableHttpLogicalRequest{ctx: ctx, parentCtx: parentCtx, req: req, request: request})
+}
+
+//go:linkname clientDoOnExit github.com/hashicorp/go-retryablehttp.clientDoOnExit
+func clientDoOnExit(call api.CallContext, resp *http.Response, err error) {
+	logical, ok := call.GetData().(retryableHttpLogicalRequest)
+	if !ok {
+		return
+	}
+	logical.req.Request = logical.req.Request.WithContext(logical.parentCtx)
+	response := &retryableHttpResponse{}
+	if resp != nil {
+		response.statusCode = resp.StatusCode
+	}
+	retryableHttpInstrumenter.End(logical.ctx, logical.request, response, err)
+}
+
+func backoffOnEnter(call api.CallContext, attemptNum int) {
+	if !retryableHttpEnabler.Enable() {
+		return
+	}
+	call.SetData(attemptNum)
+}
+
+func backoffOnExit(call api.CallContext, wait time.Duration) {
+	attemptNum, ok := call.GetData().(int)
+	if !ok {
+		return
+	}
+	// the wait of attempt n comes before the (n+1)th resend
+	recordBackoff(attemptNum+1, wait)
+}
+
+//go:linkname defaultBackoffOnEnter github.com/hashicorp/go-retryablehttp.defaultBackoffOnEnter
+func defaultBackoffOnEnter(call api.CallContext, min, max time.Duration, attemptNum int, resp *http.Response) {
+	backoffOnEnter(call, attemptNum)
+}
+
+//go:linkname defaultBackoffOnExit github.com/hashicorp/go-retryablehttp.defaultBackoffOnExit
+func defaultBackoffOnExit(call api.CallContext, wait time.Duration) {
+	backoffOnExit(call, wait)
+}
+
+//go:linkname linearJitterBackoffOnEnter github.com/hashicorp/go-retryablehttp.linearJitterBackoffOnEnter
+func linearJitterBackoffOnEnter(call api.CallContext, min, max time.Duration, attemptNum int, resp *http.Response) {
+	backoffOnEnter(call, attemptNum)
+}
+
+//go:linkname linearJitterBackoffOnExit github.com/hashicorp/go-retryablehttp.linearJitterBackoffOnExit
+func linearJitterBackoffOnExit(call api.CallContext, wait time.Duration) {
+	backoffOnExit(call, wait)
+}
+
+//go:linkname rateLimitLinearJitterBackoffOnEnter github.com/hashicorp/go-retryablehttp.rateLimitLinearJitterBackoffOnEnter
+func rateLimitLinearJitterBackoffOnEnter(call api.CallContext, min, max time.Duration, attemptNum int, resp *http.Response) {
+	backoffOnEnter(call, attemptNum)
+}
+
+//go:linkname rateLimitLinearJitterBackoffOnExit github.com/hashicorp/go-retryablehttp.rateLimitLinearJitterBackoffOnExit
+func rateLimitLinearJitterBackoffOnExit(call api.CallContext, wait time.Duration) {
+	backoffOnExit(call, wait)
+}
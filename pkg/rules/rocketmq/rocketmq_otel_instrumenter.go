@@ -16,6 +16,7 @@ package rocketmq
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -168,6 +169,23 @@ func (r ProducerAttrsGetter) GetDestinationPartitionId(req ProducerRequest) stri
 	return ""
 }
 
+func (r ProducerAttrsGetter) GetErrorType(req ProducerRequest, res ProducerResponse, err error) string {
+	return rocketmqErrorType(err)
+}
+
+// rocketmqErrorType returns the response code of the errors of the broker.
+func rocketmqErrorType(err error) string {
+	var brokerErr primitive.MQBrokerErr
+	if errors.As(err, &brokerErr) {
+		return strconv.Itoa(int(brokerErr.ResponseCode))
+	}
+	var brokerErrPtr *primitive.MQBrokerErr
+	if errors.As(err, &brokerErrPtr) && brokerErrPtr != nil {
+		return strconv.Itoa(int(brokerErrPtr.ResponseCode))
+	}
+	return ""
+}
+
 func (r ProducerAttrsGetter) GetSystem(req ProducerRequest) string {
 	return "rocketmq"
 }
@@ -239,6 +257,10 @@ func (r ConsumerAttrsGetter) IsAnonymousDestination(req ConsumerRequest) bool {
 	return false
 }
 
+func (r ConsumerAttrsGetter) GetErrorType(req ConsumerRequest, response ConsumerResponse, err error) string {
+	return rocketmqErrorType(err)
+}
+
 func (r ConsumerAttrsGetter) GetDestinationPartitionId(req ConsumerRequest) string {
 	return ""
 }
@@ -398,6 +420,7 @@ func newProducerInstrumenter() instrumenter.Instrumenter[ProducerRequest, Produc
 		AddAttributesExtractor(&ProducerAttrsExtractor{}).
 		AddAttributesExtractor(&message.MessageAttrsExtractor[ProducerRequest, ProducerResponse, ProducerAttrsGetter]{Operation: message.PUBLISH}).
 		SetSpanStatusExtractor(&ProducerStatusExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("rocketmq.producer", message.PUBLISH)).
 		BuildPropagatingToDownstreamInstrumenter(
 			func(req ProducerRequest) propagation.TextMapCarrier {
 				return ProducerCarrier{Msg: req.Message}
@@ -435,7 +458,8 @@ func newConsumerInstrumenter(operation message.MessageOperation, isBatch bool) i
 		AddAttributesExtractor(&ConsumerProcessAttrsExtractor{}).
 		AddAttributesExtractor(&message.MessageAttrsExtractor[ConsumerRequest, ConsumerResponse, ConsumerAttrsGetter]{
 			Operation: operation,
-		})
+		}).
+		AddOperationListeners(message.MessagingMetrics("rocketmq.consumer", operation))
 
 	if !isBatch {
 		return buildInstrumenter.SetSpanStatusExtractor(&ConsumerStatusExtractor{}).
@@ -17,6 +17,7 @@ package rueidisgo
 import (
 	"context"
 	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/redis/rueidis"
 	"go.opentelemetry.io/otel/trace"
 	"os"
@@ -1227,6 +1228,11 @@ func processCacheMulti(multi []rueidis.CacheableTTL) command {
 }
 
 func multiCommand(cmds []command) command {
+	names := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		names[i] = cmd.cmdName
+	}
+	batchSize, batchOperation := len(cmds), db.CommonOperation(names...)
 	// limit to the 5 first
 	if len(cmds) > 5 {
 		cmds = cmds[:5]
@@ -1242,8 +1248,10 @@ func multiCommand(cmds []command) command {
 		}
 	}
 	return command{
-		cmdName:   statement.String(),
-		statement: raw.String(),
+		cmdName:        statement.String(),
+		statement:      raw.String(),
+		batchOperation: batchOperation,
+		batchSize:      batchSize,
 	}
 }
 
@@ -22,4 +22,7 @@ type goRueidisRequest struct {
 type command struct {
 	cmdName   string
 	statement string
+	// batchOperation and batchSize are only set for DoMulti commands
+	batchOperation string
+	batchSize      int
 }
@@ -31,6 +31,10 @@ func (d goRueidisAttrsGetter) GetServerAddress(request *goRueidisRequest) string
 	return request.endpoint
 }
 
+func (d goRueidisAttrsGetter) GetServerPort(request *goRueidisRequest) int {
+	return 0
+}
+
 func (d goRueidisAttrsGetter) GetStatement(request *goRueidisRequest) string {
 	if request.cmd.statement == "" {
 		return request.cmd.cmdName
@@ -43,6 +47,9 @@ func (d goRueidisAttrsGetter) GetStatement(request *goRueidisRequest) string {
 }
 
 func (d goRueidisAttrsGetter) GetOperation(request *goRueidisRequest) string {
+	if request.cmd.batchSize > 0 {
+		return request.cmd.batchOperation
+	}
 	return request.cmd.cmdName
 }
 
@@ -55,7 +62,7 @@ func (d goRueidisAttrsGetter) GetDbNamespace(request *goRueidisRequest) string {
 }
 
 func (d goRueidisAttrsGetter) GetBatchSize(request *goRueidisRequest) int {
-	return 0
+	return request.cmd.batchSize
 }
 
 func (d goRueidisAttrsGetter) GetCollection(request *goRueidisRequest) string {
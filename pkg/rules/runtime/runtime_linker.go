@@ -24,6 +24,20 @@ import (
 // See https://github.com/alibaba/loongsuite-go-agent/blob/main/pkg/otel_setup.go
 var ExitHook func()
 
+// When the program dies of an unrecovered panic, we should record the panic
+// and flush the pending spans before the runtime kills the process.
+//
+// The hook is called by fatalpanic, which is only reached from gopanic on a
+// user goroutine that holds no runtime lock, is not allocating and has run
+// all of its defers, so the world is running and the hook may allocate, lock
+// and block like any other code. It is not called for the fatal errors
+// thrown by the runtime. The hook runs at most once, the goroutines dying of
+// a panic meanwhile do not wait for it.
+var PanicHook func(interface{})
+
+// panicHookRan is set by the first goroutine calling the PanicHook.
+var panicHookRan uint32
+
 //go:linkname otel_get_trace_context_from_gls otel_get_trace_context_from_gls
 var otel_get_trace_context_from_gls = _otel_gls_get_trace_context_impl
 
@@ -36,6 +50,9 @@ var otel_set_trace_context_to_gls = _otel_gls_set_trace_context_impl
 //go:linkname otel_set_baggage_container_to_gls otel_set_baggage_container_to_gls
 var otel_set_baggage_container_to_gls = _otel_gls_set_baggage_container_impl
 
+//go:linkname otel_get_goroutine_id otel_get_goroutine_id
+var otel_get_goroutine_id = _otel_get_goroutine_id_impl
+
 //go:nosplit
 func _otel_gls_get_trace_context_impl() interface{} {
 	return getg().m.curg.otel_trace_context
@@ -56,6 +73,11 @@ func _otel_gls_set_baggage_container_impl(v interface{}) {
 	getg().m.curg.otel_baggage_container = v
 }
 
+//go:nosplit
+func _otel_get_goroutine_id_impl() uint64 {
+	return uint64(getg().m.curg.goid)
+}
+
 type ContextSnapshoter interface {
 	TakeSnapShot() interface{}
 }
@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import (
+	"context"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// saramaProcessSpan is the process span of a message handed to a handler.
+type saramaProcessSpan struct {
+	ctx     context.Context
+	request saramaConsumerReq
+}
+
+// consumerGroups maps a consumer group to its group id.
+var consumerGroups sync.Map
+
+// startProcessSpan starts the span of a message, its context is injected into
+// the headers of the message when the handler does not hold the message yet,
+// so that the handler can continue the trace from the message.
+func startProcessSpan(msg *sarama.ConsumerMessage, groupID string, inject bool) *saramaProcessSpan {
+	request := saramaConsumerReq{msg: msg, groupID: groupID}
+	parentCtx := otel.GetTextMapPropagator().Extract(context.Background(), saramaConsumerCarrier{msg: msg})
+	var ctx context.Context
+	if trace.SpanContextFromContext(parentCtx).IsValid() {
+		ctx = consumerInstrumenter.Start(context.Background(), request)
+	} else {
+		ctx = consumerInstrumenter.Start(context.Background(), request, trace.WithNewRoot())
+	}
+	if inject {
+		otel.GetTextMapPropagator().Inject(ctx, saramaConsumerCarrier{msg: msg})
+	}
+	return &saramaProcessSpan{ctx: ctx, request: request}
+}
+
+func (s *saramaProcessSpan) end() {
+	if s != nil {
+		consumerInstrumenter.End(s.ctx, s.request, nil, nil)
+	}
+}
+
+// otelConsumerGroupHandler wraps the handler of the user so that the messages
+// of each claim are traced.
+type otelConsumerGroupHandler struct {
+	sarama.ConsumerGroupHandler
+	groupID string
+}
+
+func (h *otelConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	otelClaim := newOtelConsumerGroupClaim(claim, h.groupID)
+	defer otelClaim.release()
+	return h.ConsumerGroupHandler.ConsumeClaim(&otelConsumerGroupSession{ConsumerGroupSession: session, claim: otelClaim}, otelClaim)
+}
+
+// otelConsumerGroupSession tells the claim when the handler marks a message.
+type otelConsumerGroupSession struct {
+	sarama.ConsumerGroupSession
+	claim *otelConsumerGroupClaim
+}
+
+func (s *otelConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.ConsumerGroupSession.MarkMessage(msg, metadata)
+	s.claim.mark(msg)
+}
+
+// otelConsumerGroupClaim hands the messages of a claim over to the handler.
+// The handler reads the messages from a channel, so the handoff of a message
+// is only seen by the goroutine forwarding them. The process span of a message
+// starts once the handler is done with the last one, that is it marked the
+// last message or asks for the next one, and ends the same way. A handler
+// that does neither takes the message before its span is started, the span
+// then starts at the handoff and its context is not injected.
+type otelConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+	groupID  string
+	messages chan *sarama.ConsumerMessage
+	notify   chan struct{}
+	done     chan struct{}
+
+	mu sync.Mutex
+	// last is the message handed over last
+	last *sarama.ConsumerMessage
+	// marked is whether the handler marked the last message
+	marked bool
+	// asked is whether the handler asked for the messages since the last
+	// message was handed over
+	asked bool
+}
+
+func newOtelConsumerGroupClaim(claim sarama.ConsumerGroupClaim, groupID string) *otelConsumerGroupClaim {
+	c := &otelConsumerGroupClaim{
+		ConsumerGroupClaim: claim,
+		groupID:            groupID,
+		messages:           make(chan *sarama.ConsumerMessage),
+		notify:             make(chan struct{}, 1),
+		done:               make(chan struct{}),
+	}
+	go c.forward()
+	return c
+}
+
+func (c *otelConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
+	c.mu.Lock()
+	c.asked = true
+	c.mu.Unlock()
+	c.signal()
+	return c.messages
+}
+
+func (c *otelConsumerGroupClaim) mark(msg *sarama.ConsumerMessage) {
+	c.mu.Lock()
+	last := msg == c.last
+	if last {
+		c.marked = true
+	}
+	c.mu.Unlock()
+	if last {
+		c.signal()
+	}
+}
+
+func (c *otelConsumerGroupClaim) signal() {
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *otelConsumerGroupClaim) idle() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.marked || c.asked
+}
+
+// handingOver makes msg the last message before the handler can take it, so
+// that it is not missed if the handler marks it right away.
+func (c *otelConsumerGroupClaim) handingOver(msg *sarama.ConsumerMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.last = msg
+	c.marked = false
+}
+
+// handedOver forgets that the handler asked for the messages before it took
+// msg.
+func (c *otelConsumerGroupClaim) handedOver(msg *sarama.ConsumerMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.last != msg {
+		c.last = msg
+		c.marked = false
+	}
+	c.asked = false
+}
+
+func (c *otelConsumerGroupClaim) release() {
+	close(c.done)
+}
+
+func (c *otelConsumerGroupClaim) forward() {
+	var span *saramaProcessSpan
+	defer func() {
+		span.end()
+	}()
+	defer close(c.messages)
+	for {
+		select {
+		case msg, ok := <-c.ConsumerGroupClaim.Messages():
+			if !ok {
+				return
+			}
+			if span, ok = c.handOver(msg, span); !ok {
+				return
+			}
+		case <-c.notify:
+			if c.idle() {
+				span.end()
+				span = nil
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// handOver ends the span of the last message and starts the one of msg, it
+// returns false if the claim is released before the handler takes msg.
+func (c *otelConsumerGroupClaim) handOver(msg *sarama.ConsumerMessage, span *saramaProcessSpan) (*saramaProcessSpan, bool) {
+	for !c.idle() {
+		select {
+		case <-c.notify:
+		case c.messages <- msg:
+			c.handedOver(msg)
+			span.end()
+			return startProcessSpan(msg, c.groupID, false), true
+		case <-c.done:
+			return span, false
+		}
+	}
+	span.end()
+	span = startProcessSpan(msg, c.groupID, true)
+	c.handingOver(msg)
+	select {
+	case c.messages <- msg:
+		c.handedOver(msg)
+		return span, true
+	case <-c.done:
+		return span, false
+	}
+}
+
+//go:linkname newConsumerGroupOnEnter github.com/IBM/sarama.newConsumerGroupOnEnter
+func newConsumerGroupOnEnter(call api.CallContext, groupID string, client sarama.Client) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	call.SetData(groupID)
+}
+
+//go:linkname newConsumerGroupOnExit github.com/IBM/sarama.newConsumerGroupOnExit
+func newConsumerGroupOnExit(call api.CallContext, group sarama.ConsumerGroup, err error) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	groupID, ok := call.GetData().(string)
+	if !ok || group == nil || err != nil {
+		return
+	}
+	consumerGroups.Store(group, groupID)
+}
+
+//go:linkname consumerGroupConsumeOnEnter github.com/IBM/sarama.consumerGroupConsumeOnEnter
+func consumerGroupConsumeOnEnter(call api.CallContext, group interface{}, ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) {
+	if !saramaEnabler.Enable() || handler == nil {
+		return
+	}
+	if _, ok := handler.(*otelConsumerGroupHandler); ok {
+		return
+	}
+	groupID := ""
+	if value, ok := consumerGroups.Load(group); ok {
+		groupID = value.(string)
+	}
+	call.SetParam(3, &otelConsumerGroupHandler{ConsumerGroupHandler: handler, groupID: groupID})
+}
+
+//go:linkname consumerGroupCloseOnEnter github.com/IBM/sarama.consumerGroupCloseOnEnter
+func consumerGroupCloseOnEnter(call api.CallContext, group interface{}) {
+	consumerGroups.Delete(group)
+}
+
+//go:linkname partitionConsumerParseResponseOnEnter github.com/IBM/sarama.partitionConsumerParseResponseOnEnter
+func partitionConsumerParseResponseOnEnter(call api.CallContext, _ interface{}, response *sarama.FetchResponse) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	call.SetData(time.Now())
+}
+
+// partitionConsumerParseResponseOnExit records a receive span for each
+// fetched batch, the batch is linked to the producer of every message instead
+// of being parented by any of them.
+//
+//go:linkname partitionConsumerParseResponseOnExit github.com/IBM/sarama.partitionConsumerParseResponseOnExit
+func partitionConsumerParseResponseOnExit(call api.CallContext, msgs []*sarama.ConsumerMessage, err error) {
+	if !saramaEnabler.Enable() || len(msgs) == 0 {
+		return
+	}
+	startTime, ok := call.GetData().(time.Time)
+	if !ok {
+		return
+	}
+	links := make([]trace.Link, 0, len(msgs))
+	for _, msg := range msgs {
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), saramaConsumerCarrier{msg: msg})
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+			links = append(links, trace.Link{SpanContext: spanContext})
+		}
+	}
+	request := saramaReceiveReq{msgs: msgs, topic: msgs[0].Topic, partition: msgs[0].Partition}
+	receiveInstrumenter.StartAndEndWithOptions(context.Background(), request, nil, err, startTime, time.Now(),
+		[]trace.SpanStartOption{trace.WithNewRoot(), trace.WithLinks(links...)}, nil)
+}
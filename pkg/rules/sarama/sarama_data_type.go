@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import "github.com/IBM/sarama"
+
+type saramaProducerReq struct {
+	msgs  []*sarama.ProducerMessage
+	topic string
+	// partition and offset are only known once the message is acknowledged
+	partition int32
+	offset    int64
+	acked     bool
+}
+
+type saramaConsumerReq struct {
+	msg     *sarama.ConsumerMessage
+	groupID string
+}
+
+type saramaReceiveReq struct {
+	msgs      []*sarama.ConsumerMessage
+	topic     string
+	partition int32
+}
@@ -0,0 +1,423 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/message"
+	semconvutils "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+var saramaEnabler = saramaInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_SARAMA_ENABLED") != "false"}
+
+var (
+	producerInstrumenter = buildSaramaProducerInstrumenter()
+	consumerInstrumenter = buildSaramaConsumerInstrumenter()
+	receiveInstrumenter  = buildSaramaReceiveInstrumenter()
+)
+
+type saramaInnerEnabler struct {
+	enabled bool
+}
+
+func (s saramaInnerEnabler) Enable() bool {
+	return s.enabled
+}
+
+// saramaProducerCarrier injects the context into the headers of the produced
+// messages, an existing header of the same key is overwritten so that retried
+// or resent messages do not carry stale contexts.
+type saramaProducerCarrier struct {
+	msgs []*sarama.ProducerMessage
+}
+
+func (carrier saramaProducerCarrier) Get(key string) string {
+	if len(carrier.msgs) == 0 {
+		return ""
+	}
+	for _, header := range carrier.msgs[0].Headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (carrier saramaProducerCarrier) Set(key, value string) {
+	for _, msg := range carrier.msgs {
+		replaced := false
+		for i := range msg.Headers {
+			if string(msg.Headers[i].Key) == key {
+				msg.Headers[i].Value = []byte(value)
+				replaced = true
+			}
+		}
+		if !replaced {
+			msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+		}
+	}
+}
+
+func (carrier saramaProducerCarrier) Keys() []string {
+	return []string{}
+}
+
+type saramaConsumerCarrier struct {
+	msg *sarama.ConsumerMessage
+}
+
+func (carrier saramaConsumerCarrier) Get(key string) string {
+	for _, header := range carrier.msg.Headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set overwrites the header of the same key, the handler of a message sees
+// the context of its process span instead of the one of its producer.
+func (carrier saramaConsumerCarrier) Set(key, value string) {
+	for _, header := range carrier.msg.Headers {
+		if header != nil && string(header.Key) == key {
+			header.Value = []byte(value)
+			return
+		}
+	}
+	carrier.msg.Headers = append(carrier.msg.Headers, &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (carrier saramaConsumerCarrier) Keys() []string {
+	return []string{}
+}
+
+type saramaProducerAttrsGetter struct{}
+
+func (getter saramaProducerAttrsGetter) GetSystem(request saramaProducerReq) string {
+	return "kafka"
+}
+
+func (getter saramaProducerAttrsGetter) GetDestination(request saramaProducerReq) string {
+	return request.topic
+}
+
+func (getter saramaProducerAttrsGetter) GetDestinationTemplate(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) IsTemporaryDestination(request saramaProducerReq) bool {
+	return false
+}
+
+func (getter saramaProducerAttrsGetter) IsAnonymousDestination(request saramaProducerReq) bool {
+	return false
+}
+
+func (getter saramaProducerAttrsGetter) GetConversationId(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageBodySize(request saramaProducerReq) int64 {
+	if len(request.msgs) != 1 || request.msgs[0].Value == nil {
+		return 0
+	}
+	return int64(request.msgs[0].Value.Length())
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageEnvelopSize(request saramaProducerReq) int64 {
+	return 0
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageId(request saramaProducerReq, response any) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetClientId(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetBatchMessageCount(request saramaProducerReq, response any) int64 {
+	return int64(len(request.msgs))
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageHeader(request saramaProducerReq, name string) []string {
+	return []string{}
+}
+
+func (getter saramaProducerAttrsGetter) GetDestinationPartitionId(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetErrorType(request saramaProducerReq, response any, err error) string {
+	return saramaErrorType(err)
+}
+
+// saramaErrorType returns the error code of the errors of the brokers.
+func saramaErrorType(err error) string {
+	var kerr sarama.KError
+	if errors.As(err, &kerr) {
+		return strconv.Itoa(int(kerr))
+	}
+	return ""
+}
+
+type saramaConsumerAttrsGetter struct{}
+
+func (getter saramaConsumerAttrsGetter) GetSystem(request saramaConsumerReq) string {
+	return "kafka"
+}
+
+func (getter saramaConsumerAttrsGetter) GetDestination(request saramaConsumerReq) string {
+	return request.msg.Topic
+}
+
+func (getter saramaConsumerAttrsGetter) GetDestinationTemplate(request saramaConsumerReq) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) IsTemporaryDestination(request saramaConsumerReq) bool {
+	return false
+}
+
+func (getter saramaConsumerAttrsGetter) IsAnonymousDestination(request saramaConsumerReq) bool {
+	return false
+}
+
+func (getter saramaConsumerAttrsGetter) GetConversationId(request saramaConsumerReq) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageBodySize(request saramaConsumerReq) int64 {
+	return int64(len(request.msg.Value))
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageEnvelopSize(request saramaConsumerReq) int64 {
+	return 0
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageId(request saramaConsumerReq, response any) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) GetClientId(request saramaConsumerReq) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) GetBatchMessageCount(request saramaConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageHeader(request saramaConsumerReq, name string) []string {
+	var headerValues []string
+	for _, header := range request.msg.Headers {
+		if header != nil && string(header.Key) == name {
+			headerValues = append(headerValues, string(header.Value))
+		}
+	}
+	return headerValues
+}
+
+func (getter saramaConsumerAttrsGetter) GetDestinationPartitionId(request saramaConsumerReq) string {
+	return strconv.Itoa(int(request.msg.Partition))
+}
+
+func (getter saramaConsumerAttrsGetter) GetErrorType(request saramaConsumerReq, response any, err error) string {
+	return saramaErrorType(err)
+}
+
+type saramaReceiveAttrsGetter struct{}
+
+func (getter saramaReceiveAttrsGetter) GetSystem(request saramaReceiveReq) string {
+	return "kafka"
+}
+
+func (getter saramaReceiveAttrsGetter) GetDestination(request saramaReceiveReq) string {
+	return request.topic
+}
+
+func (getter saramaReceiveAttrsGetter) GetDestinationTemplate(request saramaReceiveReq) string {
+	return ""
+}
+
+func (getter saramaReceiveAttrsGetter) IsTemporaryDestination(request saramaReceiveReq) bool {
+	return false
+}
+
+func (getter saramaReceiveAttrsGetter) IsAnonymousDestination(request saramaReceiveReq) bool {
+	return false
+}
+
+func (getter saramaReceiveAttrsGetter) GetConversationId(request saramaReceiveReq) string {
+	return ""
+}
+
+func (getter saramaReceiveAttrsGetter) GetMessageBodySize(request saramaReceiveReq) int64 {
+	return 0
+}
+
+func (getter saramaReceiveAttrsGetter) GetMessageEnvelopSize(request saramaReceiveReq) int64 {
+	return 0
+}
+
+func (getter saramaReceiveAttrsGetter) GetMessageId(request saramaReceiveReq, response any) string {
+	return ""
+}
+
+func (getter saramaReceiveAttrsGetter) GetClientId(request saramaReceiveReq) string {
+	return ""
+}
+
+func (getter saramaReceiveAttrsGetter) GetBatchMessageCount(request saramaReceiveReq, response any) int64 {
+	return int64(len(request.msgs))
+}
+
+func (getter saramaReceiveAttrsGetter) GetMessageHeader(request saramaReceiveReq, name string) []string {
+	return []string{}
+}
+
+func (getter saramaReceiveAttrsGetter) GetDestinationPartitionId(request saramaReceiveReq) string {
+	return strconv.Itoa(int(request.partition))
+}
+
+func (getter saramaReceiveAttrsGetter) GetErrorType(request saramaReceiveReq, response any, err error) string {
+	return saramaErrorType(err)
+}
+
+// saramaProducerAttrsExtractor adds the Kafka specific attributes of the
+// published message.
+type saramaProducerAttrsExtractor struct{}
+
+func (extractor *saramaProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request saramaProducerReq) ([]attribute.KeyValue, context.Context) {
+	if len(request.msgs) == 1 && request.msgs[0].Key != nil {
+		if key, err := request.msgs[0].Key.Encode(); err == nil {
+			attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(key)))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (extractor *saramaProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request saramaProducerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if request.acked && len(request.msgs) == 1 {
+		n := len(attributes)
+		attributes = append(attributes,
+			semconv.MessagingDestinationPartitionID(strconv.Itoa(int(request.partition))),
+			semconv.MessagingKafkaOffset(int(request.offset)))
+		attributes = semconvutils.DupAttributes(semconvutils.MessagingSemconv, semconvutils.KafkaOldConv, attributes, n)
+	}
+	return attributes, ctx
+}
+
+// saramaConsumerAttrsExtractor adds the Kafka specific attributes of the
+// processed message.
+type saramaConsumerAttrsExtractor struct{}
+
+func (extractor *saramaConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request saramaConsumerReq) ([]attribute.KeyValue, context.Context) {
+	n := len(attributes)
+	attributes = append(attributes, semconv.MessagingKafkaOffset(int(request.msg.Offset)))
+	if len(request.msg.Key) > 0 {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(request.msg.Key)))
+	}
+	if request.groupID != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.groupID))
+	}
+	attributes = semconvutils.DupAttributes(semconvutils.MessagingSemconv, semconvutils.KafkaOldConv, attributes, n)
+	return attributes, parentContext
+}
+
+func (extractor *saramaConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request saramaConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildSaramaProducerInstrumenter() instrumenter.Instrumenter[saramaProducerReq, any] {
+	builder := instrumenter.Builder[saramaProducerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SARAMA_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[saramaProducerReq, any]{
+			Getter:        saramaProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[saramaProducerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[saramaProducerReq, any, saramaProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&saramaProducerAttrsExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("sarama.producer", message.PUBLISH)).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request saramaProducerReq) propagation.TextMapCarrier {
+				return saramaProducerCarrier{msgs: request.msgs}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildSaramaConsumerInstrumenter() instrumenter.Instrumenter[saramaConsumerReq, any] {
+	builder := instrumenter.Builder[saramaConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SARAMA_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[saramaConsumerReq, any]{
+			Getter:        saramaConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[saramaConsumerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[saramaConsumerReq, any, saramaConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&saramaConsumerAttrsExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("sarama.consumer", message.PROCESS)).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(request saramaConsumerReq) propagation.TextMapCarrier {
+				return saramaConsumerCarrier{msg: request.msg}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildSaramaReceiveInstrumenter() instrumenter.Instrumenter[saramaReceiveReq, any] {
+	builder := instrumenter.Builder[saramaReceiveReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SARAMA_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[saramaReceiveReq, any]{
+			Getter:        saramaReceiveAttrsGetter{},
+			OperationName: message.RECEIVE,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[saramaReceiveReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[saramaReceiveReq, any, saramaReceiveAttrsGetter]{
+			Operation: message.RECEIVE,
+		}).
+		AddOperationListeners(message.MessagingMetrics("sarama.receive", message.RECEIVE)).
+		BuildInstrumenter()
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import (
+	"context"
+	"sync"
+	_ "unsafe"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type saramaProducerSpan struct {
+	ctx     context.Context
+	request saramaProducerReq
+}
+
+var (
+	// syncMessages holds the messages sent by a sync producer, they are traced
+	// by the SendMessage(s) hooks and skipped by the async interceptor.
+	syncMessages sync.Map
+	// asyncSpans holds the publish spans of the in-flight async messages.
+	asyncSpans sync.Map
+)
+
+//go:linkname syncProducerSendMessageOnEnter github.com/IBM/sarama.syncProducerSendMessageOnEnter
+func syncProducerSendMessageOnEnter(call api.CallContext, _ interface{}, msg *sarama.ProducerMessage) {
+	if !saramaEnabler.Enable() || msg == nil {
+		return
+	}
+	request := saramaProducerReq{msgs: []*sarama.ProducerMessage{msg}, topic: msg.Topic}
+	ctx := producerInstrumenter.Start(context.Background(), request)
+	syncMessages.Store(msg, struct{}{})
+	call.SetData(saramaProducerSpan{ctx: ctx, request: request})
+}
+
+//go:linkname syncProducerSendMessageOnExit github.com/IBM/sarama.syncProducerSendMessageOnExit
+func syncProducerSendMessageOnExit(call api.CallContext, partition int32, offset int64, err error) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	span, ok := call.GetData().(saramaProducerSpan)
+	if !ok {
+		return
+	}
+	syncMessages.Delete(span.request.msgs[0])
+	if err == nil {
+		span.request.partition = partition
+		span.request.offset = offset
+		span.request.acked = true
+	}
+	producerInstrumenter.End(span.ctx, span.request, nil, err)
+}
+
+//go:linkname syncProducerSendMessagesOnEnter github.com/IBM/sarama.syncProducerSendMessagesOnEnter
+func syncProducerSendMessagesOnEnter(call api.CallContext, _ interface{}, msgs []*sarama.ProducerMessage) {
+	if !saramaEnabler.Enable() || len(msgs) == 0 {
+		return
+	}
+	request := saramaProducerReq{msgs: msgs, topic: msgs[0].Topic}
+	for _, msg := range msgs[1:] {
+		if msg.Topic != request.topic {
+			request.topic = ""
+			break
+		}
+	}
+	ctx := producerInstrumenter.Start(context.Background(), request)
+	for _, msg := range msgs {
+		syncMessages.Store(msg, struct{}{})
+	}
+	call.SetData(saramaProducerSpan{ctx: ctx, request: request})
+}
+
+//go:linkname syncProducerSendMessagesOnExit github.com/IBM/sarama.syncProducerSendMessagesOnExit
+func syncProducerSendMessagesOnExit(call api.CallContext, err error) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	span, ok := call.GetData().(saramaProducerSpan)
+	if !ok {
+		return
+	}
+	for _, msg := range span.request.msgs {
+		syncMessages.Delete(msg)
+	}
+	producerInstrumenter.End(span.ctx, span.request, nil, err)
+}
+
+// otelProducerInterceptor starts the publish span of the messages sent by an
+// async producer, it is called by the dispatcher before the message is
+// partitioned and encoded.
+type otelProducerInterceptor struct{}
+
+func (otelProducerInterceptor) OnSend(msg *sarama.ProducerMessage) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	if _, ok := syncMessages.Load(msg); ok {
+		return
+	}
+	// the interceptors are applied again on retries
+	if _, ok := asyncSpans.Load(msg); ok {
+		return
+	}
+	request := saramaProducerReq{msgs: []*sarama.ProducerMessage{msg}, topic: msg.Topic}
+	carrier := saramaProducerCarrier{msgs: request.msgs}
+	parentCtx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+	var ctx context.Context
+	if trace.SpanContextFromContext(parentCtx).IsValid() {
+		ctx = producerInstrumenter.Start(parentCtx, request)
+	} else {
+		// the dispatcher goroutine does not carry the context of the sender
+		ctx = producerInstrumenter.Start(parentCtx, request, trace.WithNewRoot())
+	}
+	asyncSpans.Store(msg, saramaProducerSpan{ctx: ctx, request: request})
+}
+
+//go:linkname newAsyncProducerOnEnter github.com/IBM/sarama.newAsyncProducerOnEnter
+func newAsyncProducerOnEnter(call api.CallContext, client sarama.Client) {
+	if !saramaEnabler.Enable() || client == nil {
+		return
+	}
+	config := client.Config()
+	if config == nil {
+		return
+	}
+	for _, interceptor := range config.Producer.Interceptors {
+		if _, ok := interceptor.(otelProducerInterceptor); ok {
+			return
+		}
+	}
+	config.Producer.Interceptors = append(config.Producer.Interceptors, otelProducerInterceptor{})
+}
+
+//go:linkname asyncProducerReturnSuccessesOnEnter github.com/IBM/sarama.asyncProducerReturnSuccessesOnEnter
+func asyncProducerReturnSuccessesOnEnter(call api.CallContext, _ interface{}, batch []*sarama.ProducerMessage) {
+	for _, msg := range batch {
+		value, ok := asyncSpans.LoadAndDelete(msg)
+		if !ok {
+			continue
+		}
+		span := value.(saramaProducerSpan)
+		span.request.partition = msg.Partition
+		span.request.offset = msg.Offset
+		span.request.acked = true
+		producerInstrumenter.End(span.ctx, span.request, nil, nil)
+	}
+}
+
+//go:linkname asyncProducerReturnErrorOnEnter github.com/IBM/sarama.asyncProducerReturnErrorOnEnter
+func asyncProducerReturnErrorOnEnter(call api.CallContext, _ interface{}, msg *sarama.ProducerMessage, err error) {
+	value, ok := asyncSpans.LoadAndDelete(msg)
+	if !ok {
+		return
+	}
+	span := value.(saramaProducerSpan)
+	producerInstrumenter.End(span.ctx, span.request, nil, err)
+}
@@ -16,7 +16,9 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/message"
+	semconvutils "github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
 	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
@@ -29,6 +31,7 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 	"os"
+	"strconv"
 )
 
 // Instrumentation enabler controller
@@ -176,6 +179,19 @@ func (getter kafkaMessageProducerAttrsGetter) GetMessageHeader(request kafkaProd
 	return []string{}
 }
 
+func (getter kafkaMessageProducerAttrsGetter) GetErrorType(request kafkaProducerReq, response any, err error) string {
+	return kafkaErrorType(err)
+}
+
+// kafkaErrorType returns the error code of the errors of the brokers.
+func kafkaErrorType(err error) string {
+	var kerr kafka.Error
+	if errors.As(err, &kerr) {
+		return strconv.Itoa(int(kerr))
+	}
+	return ""
+}
+
 // KafkaMessageConsumerAttributesGetter retrieves consumer message attributes
 type kafkaMessageConsumerAttrsGetter struct{}
 
@@ -187,6 +203,10 @@ func (getter kafkaMessageConsumerAttrsGetter) GetDestinationPartitionId(request
 	return ""
 }
 
+func (getter kafkaMessageConsumerAttrsGetter) GetErrorType(request kafkaConsumerReq, response any, err error) string {
+	return kafkaErrorType(err)
+}
+
 func (getter kafkaMessageConsumerAttrsGetter) GetSystem(request kafkaConsumerReq) string {
 	return "kafka"
 }
@@ -255,6 +275,10 @@ func (extractor *kafkaProducerAttributesExtractor) OnStart(attributes []attribut
 		semconv.MessagingDestinationNameKey.String(request.topic),
 		semconv.MessagingOperationName("publish"),
 	}
+	if len(request.msgs) > 1 {
+		kafkaAttributes = append(kafkaAttributes, semconv.MessagingBatchMessageCount(len(request.msgs)))
+	}
+	kafkaAttributes = semconvutils.DupAttributes(semconvutils.MessagingSemconv, semconvutils.KafkaOldConv, kafkaAttributes, 0)
 	return append(attributes, kafkaAttributes...), parentContext
 }
 
@@ -267,7 +291,14 @@ type kafkaConsumerAttributesExtractor struct {
 }
 
 func (extractor *kafkaConsumerAttributesExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request kafkaConsumerReq) ([]attribute.KeyValue, context.Context) {
-	return attributes, parentContext
+	kafkaAttributes := []attribute.KeyValue{
+		semconv.MessagingKafkaOffset(int(request.msg.Offset)),
+	}
+	if len(request.msg.Key) > 0 {
+		kafkaAttributes = append(kafkaAttributes, semconv.MessagingKafkaMessageKey(string(request.msg.Key)))
+	}
+	kafkaAttributes = semconvutils.DupAttributes(semconvutils.MessagingSemconv, semconvutils.KafkaOldConv, kafkaAttributes, 0)
+	return append(attributes, kafkaAttributes...), parentContext
 }
 
 func (extractor *kafkaConsumerAttributesExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request kafkaConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
@@ -289,6 +320,7 @@ func buildKafkaProducerInstrumenter() instrumenter.Instrumenter[kafkaProducerReq
 		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[kafkaProducerReq]{}).
 		SetSpanStatusExtractor(&kafkaProducerStatusExtractor{}).
 		AddAttributesExtractor(&kafkaProducerAttributesExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("kafka.producer", message.PUBLISH)).
 		BuildPropagatingToDownstreamInstrumenter(
 			func(request kafkaProducerReq) propagation.TextMapCarrier {
 				return kafkaProducerCarrier{messages: request.msgs}
@@ -314,6 +346,7 @@ func buildKafkaConsumerInstrumenter() instrumenter.Instrumenter[kafkaConsumerReq
 			Operation: message.PROCESS,
 		}).
 		AddAttributesExtractor(&kafkaConsumerAttributesExtractor{}).
+		AddOperationListeners(message.MessagingMetrics("kafka.consumer", message.PROCESS)).
 		BuildPropagatingFromUpstreamInstrumenter(
 			func(request kafkaConsumerReq) propagation.TextMapCarrier {
 				return kafkaConsumerCarrier{message: request.msg}
@@ -118,6 +118,7 @@ func beforeNamedExec(ctx api.CallContext, db *sqlx.DB, query string, arg interfa
 		driverName: db.DriverName(),
 		dbName:     db.DbName,
 		params:     []any{arg},
+		batchSize:  extractBatchSize(arg),
 	}
 	sqlInstrumenter.Start(context.Background(), request)
 	ctx.SetData(request)
@@ -21,4 +21,5 @@ type sqlxRequest struct {
 	driverName string
 	dbName     string
 	params     []any
+	batchSize  int
 }
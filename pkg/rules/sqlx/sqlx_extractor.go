@@ -14,7 +14,10 @@
 
 package sqlx
 
-import "strings"
+import (
+	"reflect"
+	"strings"
+)
 
 var opTypes = []string{"CREATE TABLE", "DROP TABLE", "ALTER TABLE", "SELECT", "INSERT", "UPDATE", "DELETE"}
 
@@ -27,3 +30,21 @@ func extractOpType(statement string) string {
 	}
 	return ""
 }
+
+// extractBatchSize returns the number of rows bound by a NamedExec argument.
+// sqlx expands a slice or array of structs/maps into a multi-row bulk insert.
+func extractBatchSize(arg interface{}) int {
+	v := reflect.ValueOf(arg)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		// []byte is bound as a single value
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return 0
+		}
+		return v.Len()
+	}
+	return 0
+}
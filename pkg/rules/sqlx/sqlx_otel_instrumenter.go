@@ -32,6 +32,10 @@ func (g sqlxAttrsGetter) GetServerAddress(sqlxRequest sqlxRequest) string {
 	return sqlxRequest.endpoint
 }
 
+func (g sqlxAttrsGetter) GetServerPort(sqlxRequest sqlxRequest) int {
+	return 0
+}
+
 func (g sqlxAttrsGetter) GetStatement(sqlxRequest sqlxRequest) string {
 	return sqlxRequest.statement
 }
@@ -53,8 +57,8 @@ func (g sqlxAttrsGetter) GetDbNamespace(sqlxRequest sqlxRequest) string {
 	return sqlxRequest.dbName
 }
 
-func (g sqlxAttrsGetter) GetBatchSize(_ sqlxRequest) int {
-	return 0
+func (g sqlxAttrsGetter) GetBatchSize(sqlxRequest sqlxRequest) int {
+	return sqlxRequest.batchSize
 }
 
 func BuildSqlxInstrumenter() instrumenter.Instrumenter[sqlxRequest, interface{}] {
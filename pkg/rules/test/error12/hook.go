@@ -27,7 +27,7 @@ func onEnterTestGetSet(call api.CallContext, arg1 int, arg2, arg3 bool, arg4 flo
 	call.SetParam(2, arg3)
 	call.SetParam(3, arg4)
 	call.SetParam(4, arg5)
-	call.SetParam(5, arg6)
+	call.SetParam(5, "suzhou")
 	call.SetParam(6, arg7)
 	call.SetParam(7, arg8)
 	call.SetParam(8, arg9)
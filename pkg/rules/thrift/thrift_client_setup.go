@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thrift
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+var thriftClientInstrumenter = BuildThriftClientInstrumenter()
+
+// the generated arguments are named after the service and the method, e.g.
+// CalculatorAddArgs for the method add of the service Calculator
+func serviceOfArgs(args thrift.TStruct, method string) string {
+	if args == nil {
+		return ""
+	}
+	t := reflect.TypeOf(args)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name, ok := strings.CutSuffix(t.Name(), "Args")
+	if !ok {
+		return ""
+	}
+	method = strings.ReplaceAll(method, "_", "")
+	if len(name) <= len(method) || !strings.EqualFold(name[len(name)-len(method):], method) {
+		return ""
+	}
+	return name[:len(name)-len(method)]
+}
+
+// func (p *TStandardClient) Call(ctx context.Context, method string, args, result TStruct) (ResponseMeta, error)
+//
+//go:linkname thriftClientCallOnEnter github.com/apache/thrift/lib/go/thrift.thriftClientCallOnEnter
+func thriftClientCallOnEnter(call api.CallContext, _ interface{}, ctx context.Context, method string, args, result thrift.TStruct) {
+	if !thriftEnabler.Enable() {
+		return
+	}
+	request := thriftRequest{
+		service: serviceOfArgs(args, method),
+		method:  method,
+		headers: make(map[string]string),
+	}
+	ctx = thriftClientInstrumenter.Start(ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+	// the headers are only written when the client speaks THeader protocol
+	keys := thrift.GetWriteHeaderList(ctx)
+	for key, value := range request.headers {
+		ctx = thrift.SetHeader(ctx, key, value)
+		keys = append(keys, key)
+	}
+	call.SetParam(1, thrift.SetWriteHeaderList(ctx, keys))
+}
+
+// func (p *TStandardClient) Call(ctx context.Context, method string, args, result TStruct) (ResponseMeta, error)
+//
+//go:linkname thriftClientCallOnExit github.com/apache/thrift/lib/go/thrift.thriftClientCallOnExit
+func thriftClientCallOnExit(call api.CallContext, meta thrift.ResponseMeta, err error) {
+	if !thriftEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx := data["ctx"].(context.Context)
+	request := data["request"].(thriftRequest)
+	thriftClientInstrumenter.End(ctx, request, thriftResponse{}, err)
+}
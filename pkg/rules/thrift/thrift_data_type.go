@@ -0,0 +1,46 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thrift
+
+type thriftRequest struct {
+	service string
+	method  string
+	// headers are carried by the THeader transport, they are empty for the
+	// other transports
+	headers map[string]string
+}
+
+type thriftResponse struct {
+}
+
+type thriftHeaderCarrier struct {
+	headers map[string]string
+}
+
+func (t thriftHeaderCarrier) Get(key string) string {
+	return t.headers[key]
+}
+
+func (t thriftHeaderCarrier) Set(key string, value string) {
+	t.headers[key] = value
+}
+
+func (t thriftHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(t.headers))
+	for key := range t.headers {
+		keys = append(keys, key)
+	}
+	return keys
+}
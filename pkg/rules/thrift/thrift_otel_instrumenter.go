@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thrift
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type thriftInnerEnabler struct {
+	enabled bool
+}
+
+func (t thriftInnerEnabler) Enable() bool {
+	return t.enabled
+}
+
+var thriftEnabler = thriftInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_THRIFT_ENABLED") != "false"}
+
+type thriftAttrsGetter struct {
+}
+
+func (t thriftAttrsGetter) GetSystem(request thriftRequest) string {
+	return "apache_thrift"
+}
+
+func (t thriftAttrsGetter) GetService(request thriftRequest) string {
+	return request.service
+}
+
+func (t thriftAttrsGetter) GetMethod(request thriftRequest) string {
+	return request.method
+}
+
+func (t thriftAttrsGetter) GetServerAddress(request thriftRequest) string {
+	return ""
+}
+
+func BuildThriftClientInstrumenter() instrumenter.Instrumenter[thriftRequest, thriftResponse] {
+	builder := instrumenter.Builder[thriftRequest, thriftResponse]{}
+	getter := thriftAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[thriftRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[thriftRequest]{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[thriftRequest, thriftResponse, thriftAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.THRIFT_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(rpc.RpcClientMetrics("thrift.client")).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(n thriftRequest) propagation.TextMapCarrier {
+				return thriftHeaderCarrier{headers: n.headers}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func BuildThriftServerInstrumenter() instrumenter.Instrumenter[thriftRequest, thriftResponse] {
+	builder := instrumenter.Builder[thriftRequest, thriftResponse]{}
+	getter := thriftAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[thriftRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[thriftRequest]{}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[thriftRequest, thriftResponse, thriftAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.THRIFT_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(rpc.RpcServerMetrics("thrift.server")).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(n thriftRequest) propagation.TextMapCarrier {
+				return thriftHeaderCarrier{headers: n.headers}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
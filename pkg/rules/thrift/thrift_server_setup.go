@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thrift
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+var thriftServerInstrumenter = BuildThriftServerInstrumenter()
+
+// thriftProcessorFunction wraps the function that the processor dispatches
+// a call of a method to.
+type thriftProcessorFunction struct {
+	service string
+	method  string
+	next    thrift.TProcessorFunction
+}
+
+func (f *thriftProcessorFunction) Process(ctx context.Context, seqId int32, in, out thrift.TProtocol) (bool, thrift.TException) {
+	if !thriftEnabler.Enable() {
+		return f.next.Process(ctx, seqId, in, out)
+	}
+	// the server adds the headers read by the THeader transport to the context
+	request := thriftRequest{
+		service: f.service,
+		method:  f.method,
+		headers: make(map[string]string),
+	}
+	for _, key := range thrift.GetReadHeaderList(ctx) {
+		if value, ok := thrift.GetHeader(ctx, key); ok {
+			request.headers[key] = value
+		}
+	}
+	ctx = thriftServerInstrumenter.Start(ctx, request)
+	success, exception := f.next.Process(ctx, seqId, in, out)
+	var err error
+	if exception != nil {
+		err = exception
+	}
+	thriftServerInstrumenter.End(ctx, request, thriftResponse{}, err)
+	return success, exception
+}
+
+// the generated processors are named after the service, e.g.
+// CalculatorProcessor for the service Calculator
+func serviceOfProcessor(processor thrift.TProcessor) string {
+	if multiplexed, ok := processor.(*thrift.TMultiplexedProcessor); ok {
+		if multiplexed.DefaultProcessor == nil {
+			return ""
+		}
+		return serviceOfProcessor(multiplexed.DefaultProcessor)
+	}
+	t := reflect.TypeOf(processor)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	service, _ := strings.CutSuffix(t.Name(), "Processor")
+	return service
+}
+
+func wrapProcessor(processor thrift.TProcessor) {
+	service := serviceOfProcessor(processor)
+	for name, function := range processor.ProcessorMap() {
+		if _, ok := function.(*thriftProcessorFunction); ok {
+			continue
+		}
+		// the functions of a multiplexed processor are named service:method
+		wrapped := &thriftProcessorFunction{service: service, method: name, next: function}
+		if s, m, ok := strings.Cut(name, thrift.MULTIPLEXED_SEPARATOR); ok {
+			wrapped.service, wrapped.method = s, m
+		}
+		processor.AddToProcessorMap(name, wrapped)
+	}
+}
+
+// func NewTProcessorFactory(p TProcessor) TProcessorFactory
+//
+//go:linkname thriftNewProcessorFactoryOnEnter github.com/apache/thrift/lib/go/thrift.thriftNewProcessorFactoryOnEnter
+func thriftNewProcessorFactoryOnEnter(call api.CallContext, processor thrift.TProcessor) {
+	if !thriftEnabler.Enable() || processor == nil {
+		return
+	}
+	wrapProcessor(processor)
+}
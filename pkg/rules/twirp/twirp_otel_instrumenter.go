@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package twirp
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type twirpInnerEnabler struct {
+	enabled bool
+}
+
+func (t twirpInnerEnabler) Enable() bool {
+	return t.enabled
+}
+
+var twirpEnabler = twirpInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_TWIRP_ENABLED") != "false"}
+
+type twirpAttrsGetter struct {
+}
+
+func (t twirpAttrsGetter) GetSystem(request twirpRequest) string {
+	return "twirp"
+}
+
+func (t twirpAttrsGetter) GetService(request twirpRequest) string {
+	return request.service
+}
+
+func (t twirpAttrsGetter) GetMethod(request twirpRequest) string {
+	return request.method
+}
+
+func (t twirpAttrsGetter) GetServerAddress(request twirpRequest) string {
+	return request.serverAddress
+}
+
+func (t twirpAttrsGetter) GetErrorCode(request twirpRequest, response twirpResponse, err error) string {
+	if err == nil {
+		return ""
+	}
+	if twerr, ok := err.(twirp.Error); ok {
+		return string(twerr.Code())
+	}
+	return string(twirp.Internal)
+}
+
+// the trace context is propagated by the http client and server spans that
+// carry the calls, the twirp spans are their parents and children
+func BuildTwirpClientInstrumenter() instrumenter.Instrumenter[twirpRequest, twirpResponse] {
+	builder := instrumenter.Builder[twirpRequest, twirpResponse]{}
+	getter := twirpAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[twirpRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[twirpRequest]{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[twirpRequest, twirpResponse, twirpAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.TWIRP_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(rpc.RpcClientMetrics("twirp.client")).
+		BuildInstrumenter()
+}
+
+func BuildTwirpServerInstrumenter() instrumenter.Instrumenter[twirpRequest, twirpResponse] {
+	builder := instrumenter.Builder[twirpRequest, twirpResponse]{}
+	getter := twirpAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[twirpRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[twirpRequest]{}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[twirpRequest, twirpResponse, twirpAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.TWIRP_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddOperationListeners(rpc.RpcServerMetrics("twirp.server")).
+		BuildInstrumenter()
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package twirp
+
+import (
+	"context"
+	"net/http"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/twitchtv/twirp"
+)
+
+var (
+	twirpClientInstrumenter = BuildTwirpClientInstrumenter()
+	twirpServerInstrumenter = BuildTwirpServerInstrumenter()
+)
+
+// the generated clients and servers read the path prefix exactly once while
+// they are constructed, before the hooks are read
+const twirpPathPrefixOpt = "pathPrefix"
+
+func requestOf(ctx context.Context) twirpRequest {
+	service, _ := twirp.ServiceName(ctx)
+	if pkg, ok := twirp.PackageName(ctx); ok && pkg != "" {
+		service = pkg + "." + service
+	}
+	method, _ := twirp.MethodName(ctx)
+	return twirpRequest{service: service, method: method}
+}
+
+func endSpan(ctx context.Context, instrumenter func(span *twirpSpan)) {
+	span, ok := ctx.Value(twirpSpanKey{}).(*twirpSpan)
+	if !ok {
+		return
+	}
+	span.once.Do(func() {
+		instrumenter(span)
+	})
+}
+
+var twirpServerHooks = &twirp.ServerHooks{
+	RequestRouted: func(ctx context.Context) (context.Context, error) {
+		if !twirpEnabler.Enable() {
+			return ctx, nil
+		}
+		request := requestOf(ctx)
+		ctx = twirpServerInstrumenter.Start(ctx, request)
+		return context.WithValue(ctx, twirpSpanKey{}, &twirpSpan{ctx: ctx, request: request}), nil
+	},
+	Error: func(ctx context.Context, err twirp.Error) context.Context {
+		if span, ok := ctx.Value(twirpSpanKey{}).(*twirpSpan); ok {
+			span.err = err
+		}
+		return ctx
+	},
+	ResponseSent: func(ctx context.Context) {
+		endSpan(ctx, func(span *twirpSpan) {
+			twirpServerInstrumenter.End(span.ctx, span.request, twirpResponse{}, span.err)
+		})
+	},
+}
+
+var twirpClientHooks = &twirp.ClientHooks{
+	RequestPrepared: func(ctx context.Context, req *http.Request) (context.Context, error) {
+		if !twirpEnabler.Enable() {
+			return ctx, nil
+		}
+		request := requestOf(ctx)
+		if req != nil && req.URL != nil {
+			request.serverAddress = req.URL.Host
+		}
+		ctx = twirpClientInstrumenter.Start(ctx, request)
+		return context.WithValue(ctx, twirpSpanKey{}, &twirpSpan{ctx: ctx, request: request}), nil
+	},
+	ResponseReceived: func(ctx context.Context) {
+		endSpan(ctx, func(span *twirpSpan) {
+			twirpClientInstrumenter.End(span.ctx, span.request, twirpResponse{}, nil)
+		})
+	},
+	Error: func(ctx context.Context, err twirp.Error) {
+		endSpan(ctx, func(span *twirpSpan) {
+			twirpClientInstrumenter.End(span.ctx, span.request, twirpResponse{}, err)
+		})
+	},
+}
+
+// func (opts *ServerOptions) ReadOpt(key string, out interface{}) bool
+//
+//go:linkname twirpServerReadOptOnEnter github.com/twitchtv/twirp.twirpServerReadOptOnEnter
+func twirpServerReadOptOnEnter(call api.CallContext, opts *twirp.ServerOptions, key string, out interface{}) {
+	if !twirpEnabler.Enable() || opts == nil || key != twirpPathPrefixOpt {
+		return
+	}
+	opts.Hooks = twirp.ChainHooks(twirpServerHooks, opts.Hooks)
+}
+
+// func (opts *ClientOptions) ReadOpt(key string, out interface{}) bool
+//
+//go:linkname twirpClientReadOptOnEnter github.com/twitchtv/twirp.twirpClientReadOptOnEnter
+func twirpClientReadOptOnEnter(call api.CallContext, opts *twirp.ClientOptions, key string, out interface{}) {
+	if !twirpEnabler.Enable() || opts == nil || key != twirpPathPrefixOpt {
+		return
+	}
+	opts.Hooks = twirp.ChainClientHooks(twirpClientHooks, opts.Hooks)
+}
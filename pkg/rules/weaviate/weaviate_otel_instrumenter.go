@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaviate
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type weaviateInnerEnabler struct {
+	enabled bool
+}
+
+func (w weaviateInnerEnabler) Enable() bool {
+	return w.enabled
+}
+
+var weaviateEnabler = weaviateInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_WEAVIATE_ENABLED") != "false"}
+
+type weaviateAttrsGetter struct {
+}
+
+var _ db.DbClientAttrsGetter[weaviateRequest] = weaviateAttrsGetter{}
+var _ db.VectorDbAttrsGetter[weaviateRequest, weaviateResponse] = weaviateAttrsGetter{}
+
+func (w weaviateAttrsGetter) GetSystem(request weaviateRequest) string {
+	return db.SystemWeaviate
+}
+
+// the connection keeps its endpoint private, the peer is reported by the
+// nested HTTP or gRPC span
+func (w weaviateAttrsGetter) GetServerAddress(request weaviateRequest) string {
+	return ""
+}
+
+func (w weaviateAttrsGetter) GetServerPort(request weaviateRequest) int {
+	return 0
+}
+
+// the GraphQL queries are not reported, they embed the filter values and the
+// whole query vectors
+func (w weaviateAttrsGetter) GetStatement(request weaviateRequest) string {
+	return ""
+}
+
+func (w weaviateAttrsGetter) GetOperation(request weaviateRequest) string {
+	return request.operation
+}
+
+func (w weaviateAttrsGetter) GetCollection(request weaviateRequest) string {
+	return request.collection
+}
+
+func (w weaviateAttrsGetter) GetParameters(request weaviateRequest) []any {
+	return nil
+}
+
+func (w weaviateAttrsGetter) GetDbNamespace(request weaviateRequest) string {
+	return ""
+}
+
+func (w weaviateAttrsGetter) GetBatchSize(request weaviateRequest) int {
+	return 0
+}
+
+func (w weaviateAttrsGetter) GetTopK(request weaviateRequest) int {
+	return request.topK
+}
+
+func (w weaviateAttrsGetter) GetVectorDimension(request weaviateRequest) int {
+	return request.dimension
+}
+
+func (w weaviateAttrsGetter) GetResultCount(request weaviateRequest, response weaviateResponse) int {
+	return response.resultCount
+}
+
+func BuildWeaviateInstrumenter() instrumenter.Instrumenter[weaviateRequest, weaviateResponse] {
+	builder := instrumenter.Builder[weaviateRequest, weaviateResponse]{}
+	getter := weaviateAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[weaviateRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[weaviateRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[weaviateRequest, weaviateResponse, weaviateAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[weaviateRequest, weaviateResponse, weaviateAttrsGetter]{Getter: getter}}).
+		AddAttributesExtractor(&db.VectorDbAttrsExtractor[weaviateRequest, weaviateResponse, weaviateAttrsGetter]{Getter: getter}).
+		AddOperationListeners(db.DbClientMetrics("vector.weaviate")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.WEAVIATE_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
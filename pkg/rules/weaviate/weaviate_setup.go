@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaviate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/batch"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var weaviateInstrumenter = BuildWeaviateInstrumenter()
+
+var (
+	graphqlTargetPattern = regexp.MustCompile(`^\s*\{\s*(Get|Aggregate|Explore)\s*\{\s*(\w*)`)
+	graphqlLimitPattern  = regexp.MustCompile(`\blimit:\s*(\d+)`)
+	graphqlVectorPattern = regexp.MustCompile(`nearVector:\s*\{[^{}]*?\bvector:\s*\[([^\]]*)\]`)
+	graphqlSearchPattern = regexp.MustCompile(`\b(near\w+|hybrid|bm25|ask):`)
+)
+
+// parseGraphqlQuery reads the operation of the queries built by the graphql
+// package, e.g. `{Get {Article (nearVector: {vector: [0.1, 0.2]}, limit: 5) {title}}}`
+func parseGraphqlQuery(query string) weaviateRequest {
+	request := weaviateRequest{operation: "query"}
+	target := graphqlTargetPattern.FindStringSubmatch(query)
+	if target == nil {
+		return request
+	}
+	switch target[1] {
+	case "Get":
+		request.collection = target[2]
+		if graphqlSearchPattern.MatchString(query) {
+			request.operation = "search"
+		}
+	case "Aggregate":
+		request.operation = "aggregate"
+		request.collection = target[2]
+	case "Explore":
+		request.operation = "search"
+	}
+	if limit := graphqlLimitPattern.FindStringSubmatch(query); limit != nil {
+		request.topK, _ = strconv.Atoi(limit[1])
+	}
+	if vector := graphqlVectorPattern.FindStringSubmatch(query); vector != nil && strings.TrimSpace(vector[1]) != "" {
+		request.dimension = strings.Count(vector[1], ",") + 1
+	}
+	return request
+}
+
+func objectsCollection(objects []*models.Object) string {
+	collection := ""
+	for _, object := range objects {
+		if object == nil {
+			continue
+		}
+		if collection != "" && object.Class != collection {
+			// a batch may span several collections
+			return ""
+		}
+		collection = object.Class
+	}
+	return collection
+}
+
+func objectsDimension(objects []*models.Object) int {
+	for _, object := range objects {
+		if object != nil && len(object.Vector) > 0 {
+			return len(object.Vector)
+		}
+	}
+	return 0
+}
+
+// objectOf returns the object sent by the data package, which passes it by
+// value or by pointer depending on the version
+func objectOf(body interface{}) *models.Object {
+	switch object := body.(type) {
+	case models.Object:
+		return &object
+	case *models.Object:
+		return object
+	}
+	return nil
+}
+
+// weaviateRequestOf maps the REST calls of the data, batch and graphql
+// packages to the operations, the schema, backup and other management calls
+// are not traced
+func weaviateRequestOf(path string, method string, body interface{}) (weaviateRequest, bool) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return weaviateRequest{}, false
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case len(segments) == 1 && segments[0] == "graphql" && method == http.MethodPost:
+		var query string
+		switch q := body.(type) {
+		case *models.GraphQLQuery:
+			if q != nil {
+				query = q.Query
+			}
+		case models.GraphQLQuery:
+			query = q.Query
+		}
+		if query != "" {
+			request := parseGraphqlQuery(query)
+			request.graphql = true
+			return request, true
+		}
+	case len(segments) == 2 && segments[0] == "batch" && segments[1] == "objects":
+		switch b := body.(type) {
+		case batch.ObjectsBatchRequestBody:
+			return weaviateRequest{operation: "upsert", collection: objectsCollection(b.Objects), dimension: objectsDimension(b.Objects)}, true
+		case *batch.ObjectsBatchRequestBody:
+			return weaviateRequest{operation: "upsert", collection: objectsCollection(b.Objects), dimension: objectsDimension(b.Objects)}, true
+		case *models.BatchDelete:
+			request := weaviateRequest{operation: "delete"}
+			if b != nil && b.Match != nil {
+				request.collection = b.Match.Class
+			}
+			return request, true
+		}
+	case segments[0] == "objects" && len(segments) <= 3:
+		// the references and the validation have their own sub-paths
+		if len(segments) == 2 && segments[1] == "validate" {
+			return weaviateRequest{}, false
+		}
+		request := weaviateRequest{}
+		if len(segments) == 3 {
+			request.collection = segments[1]
+		}
+		if object := objectOf(body); object != nil {
+			request.collection = object.Class
+			request.dimension = len(object.Vector)
+		}
+		switch method {
+		case http.MethodPost:
+			request.operation = "insert"
+		case http.MethodPut, http.MethodPatch:
+			request.operation = "update"
+		case http.MethodDelete:
+			request.operation = "delete"
+		case http.MethodGet:
+			request.operation = "query"
+			if request.collection == "" {
+				request.collection = u.Query().Get("class")
+			}
+		default:
+			return weaviateRequest{}, false
+		}
+		return request, true
+	}
+	return weaviateRequest{}, false
+}
+
+type graphqlResponse struct {
+	Data   map[string]map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphqlResult counts the objects returned by a Get query and reports the
+// GraphQL errors, which come with a 200 status
+func graphqlResult(request weaviateRequest, body []byte) (int, error) {
+	var response graphqlResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return -1, nil
+	}
+	if len(response.Errors) > 0 {
+		return -1, fmt.Errorf("weaviate graphql: %s", response.Errors[0].Message)
+	}
+	var objects []json.RawMessage
+	if request.collection == "" {
+		return -1, nil
+	}
+	if err := json.Unmarshal(response.Data["Get"][request.collection], &objects); err != nil {
+		return -1, nil
+	}
+	return len(objects), nil
+}
+
+//go:linkname beforeWeaviateRunREST github.com/weaviate/weaviate-go-client/v4/weaviate/connection.beforeWeaviateRunREST
+func beforeWeaviateRunREST(call api.CallContext, con *connection.Connection, ctx context.Context, path string, restMethod string, requestBody interface{}) {
+	if !weaviateEnabler.Enable() || ctx == nil {
+		return
+	}
+	request, ok := weaviateRequestOf(path, restMethod, requestBody)
+	if !ok {
+		return
+	}
+	newCtx := weaviateInstrumenter.Start(ctx, request)
+	// the HTTP client span of the call nests under the operation
+	call.SetParam(1, newCtx)
+	call.SetKeyData("ctx", newCtx)
+	call.SetKeyData("request", request)
+}
+
+//go:linkname afterWeaviateRunREST github.com/weaviate/weaviate-go-client/v4/weaviate/connection.afterWeaviateRunREST
+func afterWeaviateRunREST(call api.CallContext, responseData *connection.ResponseData, err error) {
+	newCtx, ok := call.GetKeyData("ctx").(context.Context)
+	if !ok {
+		return
+	}
+	request := call.GetKeyData("request").(weaviateRequest)
+	resultCount := -1
+	if err == nil && responseData != nil {
+		if responseData.StatusCode >= http.StatusBadRequest {
+			err = fmt.Errorf("weaviate: unexpected status %d", responseData.StatusCode)
+		} else if request.graphql {
+			resultCount, err = graphqlResult(request, responseData.Body)
+		}
+	}
+	weaviateInstrumenter.End(newCtx, request, weaviateResponse{resultCount: resultCount}, err)
+}
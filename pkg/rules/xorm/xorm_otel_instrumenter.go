@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xorm
+
+import (
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/instrumenter"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/utils"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type xormInnerEnabler struct {
+	enabled bool
+}
+
+func (x xormInnerEnabler) Enable() bool {
+	return x.enabled
+}
+
+var xormEnabler = xormInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_XORM_ENABLED") != "false"}
+
+type xormAttrsGetter struct {
+}
+
+func (x xormAttrsGetter) GetSystem(request xormRequest) string {
+	return request.system
+}
+
+func (x xormAttrsGetter) GetServerAddress(request xormRequest) string {
+	return ""
+}
+
+func (x xormAttrsGetter) GetServerPort(request xormRequest) int {
+	return 0
+}
+
+// the statement is reported by the nested database/sql span
+func (x xormAttrsGetter) GetStatement(request xormRequest) string {
+	return ""
+}
+
+func (x xormAttrsGetter) GetCollection(request xormRequest) string {
+	return request.table
+}
+
+func (x xormAttrsGetter) GetOperation(request xormRequest) string {
+	return request.operation
+}
+
+func (x xormAttrsGetter) GetParameters(request xormRequest) []any {
+	return nil
+}
+
+func (x xormAttrsGetter) GetDbNamespace(request xormRequest) string {
+	return ""
+}
+
+func (x xormAttrsGetter) GetBatchSize(request xormRequest) int {
+	return 0
+}
+
+// the db.client.request.duration is recorded by the nested database/sql span,
+// so the operations have no metrics of their own
+func BuildXormInstrumenter() instrumenter.Instrumenter[xormRequest, any] {
+	builder := instrumenter.Builder[xormRequest, any]{}
+	getter := xormAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[xormRequest]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[xormRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[xormRequest, any, xormAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[xormRequest, any, xormAttrsGetter]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.XORM_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xorm
+
+import (
+	"context"
+	"reflect"
+	_ "unsafe"
+
+	"github.com/alibaba/loongsuite-go-agent/pkg/api"
+	"github.com/alibaba/loongsuite-go-agent/pkg/inst-api-semconv/instrumenter/db"
+	"xorm.io/xorm"
+	"xorm.io/xorm/contexts"
+)
+
+var xormInstrumenter = BuildXormInstrumenter()
+
+// xormHook is called by the sessions around every statement they run, the
+// operation and the table of the session are read from the statement
+type xormHook struct {
+	system string
+}
+
+func (h xormHook) BeforeProcess(c *contexts.ContextHook) (context.Context, error) {
+	if !xormEnabler.Enable() {
+		return c.Ctx, nil
+	}
+	operation, table := db.StatementOperation(c.SQL)
+	// the transactions are already traced by database/sql, a span of the
+	// session would only wrap the BEGIN or the COMMIT
+	switch operation {
+	case "BEGIN", "COMMIT", "ROLLBACK":
+		return c.Ctx, nil
+	}
+	request := xormRequest{
+		system:    h.system,
+		operation: operation,
+		table:     db.UnquoteIdentifier(table),
+	}
+	ctx := xormInstrumenter.Start(c.Ctx, request)
+	// the hooks registered after this one start from c.Ctx
+	c.Ctx = context.WithValue(ctx, xormRequestKey{}, request)
+	return c.Ctx, nil
+}
+
+func (h xormHook) AfterProcess(c *contexts.ContextHook) error {
+	request, ok := c.Ctx.Value(xormRequestKey{}).(xormRequest)
+	if !ok {
+		return nil
+	}
+	xormInstrumenter.End(c.Ctx, request, nil, c.Err)
+	return nil
+}
+
+// addXormHook registers the hook on an engine once, the constructors call
+// each other and a group may be made of engines created by NewEngine
+func addXormHook(engine *xorm.Engine) {
+	if engine == nil || hasXormHook(engine) {
+		return
+	}
+	engine.AddHook(xormHook{system: db.SystemName(engine.DriverName())})
+}
+
+func hasXormHook(engine *xorm.Engine) bool {
+	coreDB := engine.DB()
+	if coreDB == nil {
+		return false
+	}
+	// the hooks are kept in the unexported core.DB.hooks.hooks slice
+	hooks := reflect.ValueOf(coreDB).Elem().FieldByName("hooks")
+	if hooks.IsValid() {
+		hooks = hooks.FieldByName("hooks")
+	}
+	if !hooks.IsValid() || hooks.Kind() != reflect.Slice {
+		return false
+	}
+	for i := 0; i < hooks.Len(); i++ {
+		hook := hooks.Index(i)
+		if !hook.IsNil() && hook.Elem().Type() == reflect.TypeOf(xormHook{}) {
+			return true
+		}
+	}
+	return false
+}
+
+// func NewEngine(driverName string, dataSourceName string) (*Engine, error)
+//
+//go:linkname afterNewXormEngine xorm.io/xorm.afterNewXormEngine
+func afterNewXormEngine(call api.CallContext, engine *xorm.Engine, err error) {
+	if !xormEnabler.Enable() || err != nil {
+		return
+	}
+	addXormHook(engine)
+}
+
+// func NewEngineWithParams(driverName string, dataSourceName string, params map[string]string) (*Engine, error)
+//
+//go:linkname afterNewXormEngineWithParams xorm.io/xorm.afterNewXormEngineWithParams
+func afterNewXormEngineWithParams(call api.CallContext, engine *xorm.Engine, err error) {
+	if !xormEnabler.Enable() || err != nil {
+		return
+	}
+	addXormHook(engine)
+}
+
+// func NewEngineWithDB(driverName string, dataSourceName string, db *core.DB) (*Engine, error)
+//
+//go:linkname afterNewXormEngineWithDB xorm.io/xorm.afterNewXormEngineWithDB
+func afterNewXormEngineWithDB(call api.CallContext, engine *xorm.Engine, err error) {
+	if !xormEnabler.Enable() || err != nil {
+		return
+	}
+	addXormHook(engine)
+}
+
+// func NewEngineWithDialectAndDB(driverName, dataSourceName string, dialect dialects.Dialect, db *core.DB) (*Engine, error)
+//
+//go:linkname afterNewXormEngineWithDialectAndDB xorm.io/xorm.afterNewXormEngineWithDialectAndDB
+func afterNewXormEngineWithDialectAndDB(call api.CallContext, engine *xorm.Engine, err error) {
+	if !xormEnabler.Enable() || err != nil {
+		return
+	}
+	addXormHook(engine)
+}
+
+// func NewEngineGroup(args1 interface{}, args2 interface{}, policies ...GroupPolicy) (*EngineGroup, error)
+//
+//go:linkname afterNewXormEngineGroup xorm.io/xorm.afterNewXormEngineGroup
+func afterNewXormEngineGroup(call api.CallContext, group *xorm.EngineGroup, err error) {
+	if !xormEnabler.Enable() || err != nil || group == nil {
+		return
+	}
+	addXormHook(group.Master())
+	for _, slave := range group.Slaves() {
+		addXormHook(slave)
+	}
+}
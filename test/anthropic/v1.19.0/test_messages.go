@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/v1/messages/count_tokens" {
+			w.Write([]byte(`{"input_tokens": 42}`))
+			return
+		}
+		w.Write([]byte(`{
+"id": "msg_test123",
+"type": "message",
+"role": "assistant",
+"model": "claude-sonnet-4-5",
+"content": [
+{"type": "text", "text": "Let me check the weather."},
+{"type": "tool_use", "id": "toolu_01", "name": "get_weather", "input": {"city": "Hangzhou"}}
+],
+"stop_reason": "tool_use",
+"stop_sequence": null,
+"usage": {
+"input_tokens": 10,
+"cache_read_input_tokens": 20,
+"cache_creation_input_tokens": 5,
+"output_tokens": 15
+}
+}`))
+	}))
+	defer mockServer.Close()
+
+	client := anthropic.NewClient(
+		option.WithAPIKey("test-api-key"),
+		option.WithBaseURL(mockServer.URL),
+	)
+	ctx := context.Background()
+
+	messages := []anthropic.MessageParam{
+		anthropic.NewUserMessage(anthropic.NewTextBlock("What's the weather in Hangzhou?")),
+	}
+	_, err := client.Messages.New(ctx, anthropic.MessageNewParams{
+		Model:       anthropic.Model("claude-sonnet-4-5"),
+		Messages:    messages,
+		MaxTokens:   1024,
+		Temperature: anthropic.Float(0.5),
+		Tools: []anthropic.ToolUnionParam{{OfTool: &anthropic.ToolParam{
+			Name:        "get_weather",
+			InputSchema: anthropic.ToolInputSchemaParam{Properties: map[string]any{"city": map[string]any{"type": "string"}}},
+		}}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	_, err = client.Messages.CountTokens(ctx, anthropic.MessageCountTokensParams{
+		Model:    anthropic.Model("claude-sonnet-4-5"),
+		Messages: messages,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "chat", "anthropic", "claude-sonnet-4-5")
+
+		temp := verifier.GetAttribute(span.Attributes, "gen_ai.request.temperature").AsFloat64()
+		verifier.Assert(temp > 0.49 && temp < 0.51, "Expected temperature to be approximately 0.5, got %f", temp)
+		maxTokens := verifier.GetAttribute(span.Attributes, "gen_ai.request.max_tokens").AsInt64()
+		verifier.Assert(maxTokens == 1024, "Expected max_tokens to be 1024, got %d", maxTokens)
+
+		// input tokens include the cached prompt tokens
+		inputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(inputTokens == 35, "Expected input tokens to be 35, got %d", inputTokens)
+		outputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.output_tokens").AsInt64()
+		verifier.Assert(outputTokens == 15, "Expected output tokens to be 15, got %d", outputTokens)
+		cacheRead := verifier.GetAttribute(span.Attributes, "gen_ai.usage.cache_read.input_tokens").AsInt64()
+		verifier.Assert(cacheRead == 20, "Expected cache read input tokens to be 20, got %d", cacheRead)
+		cacheCreation := verifier.GetAttribute(span.Attributes, "gen_ai.usage.cache_creation.input_tokens").AsInt64()
+		verifier.Assert(cacheCreation == 5, "Expected cache creation input tokens to be 5, got %d", cacheCreation)
+
+		responseID := verifier.GetAttribute(span.Attributes, "gen_ai.response.id").AsString()
+		verifier.Assert(responseID == "msg_test123", "Expected response ID to be msg_test123, got %s", responseID)
+		finishReasons := verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "tool_use", "Expected finish reason to be [tool_use], got %v", finishReasons)
+		output := verifier.GetAttribute(span.Attributes, "gen_ai.output.messages").AsString()
+		verifier.Assert(strings.Contains(output, "get_weather"), "Expected output messages to contain the tool use, got %s", output)
+
+		countSpan := stubs[1][0]
+		verifier.VerifyLLMAttributes(countSpan, "count_tokens", "anthropic", "claude-sonnet-4-5")
+		counted := verifier.GetAttribute(countSpan.Attributes, "anthropic.count_tokens.input_tokens").AsInt64()
+		verifier.Assert(counted == 42, "Expected counted input tokens to be 42, got %d", counted)
+		countInputTokens := verifier.GetAttribute(countSpan.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(countInputTokens == 0, "Expected no input token usage on count_tokens, got %d", countInputTokens)
+	}, 2)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		events := []string{
+			"event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"id\":\"msg_stream123\",\"type\":\"message\",\"role\":\"assistant\",\"model\":\"claude-sonnet-4-5\",\"content\":[],\"stop_reason\":null,\"stop_sequence\":null,\"usage\":{\"input_tokens\":12,\"cache_read_input_tokens\":8,\"output_tokens\":1}}}\n\n",
+			"event: content_block_start\ndata: {\"type\":\"content_block_start\",\"index\":0,\"content_block\":{\"type\":\"text\",\"text\":\"\"}}\n\n",
+			"event: ping\ndata: {\"type\":\"ping\"}\n\n",
+			"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"index\":0,\"delta\":{\"type\":\"text_delta\",\"text\":\"Hello\"}}\n\n",
+			"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"index\":0,\"delta\":{\"type\":\"text_delta\",\"text\":\" from Claude!\"}}\n\n",
+			"event: content_block_stop\ndata: {\"type\":\"content_block_stop\",\"index\":0}\n\n",
+			"event: message_delta\ndata: {\"type\":\"message_delta\",\"delta\":{\"stop_reason\":\"end_turn\",\"stop_sequence\":null},\"usage\":{\"output_tokens\":20}}\n\n",
+			"event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n",
+		}
+		for _, event := range events {
+			fmt.Fprint(w, event)
+			flusher.Flush()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer mockServer.Close()
+
+	client := anthropic.NewClient(
+		option.WithAPIKey("test-api-key"),
+		option.WithBaseURL(mockServer.URL),
+	)
+	stream := client.Messages.NewStreaming(context.Background(), anthropic.MessageNewParams{
+		Model:     anthropic.Model("claude-sonnet-4-5"),
+		MaxTokens: 100,
+		Messages: []anthropic.MessageParam{
+			anthropic.NewUserMessage(anthropic.NewTextBlock("Hello, how are you?")),
+		},
+	})
+	for stream.Next() {
+		_ = stream.Current()
+	}
+	if err := stream.Err(); err != nil {
+		panic(err)
+	}
+	stream.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "chat", "anthropic", "claude-sonnet-4-5")
+		inputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(inputTokens == 20, "Expected input tokens to be 20, got %d", inputTokens)
+		outputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.output_tokens").AsInt64()
+		verifier.Assert(outputTokens == 20, "Expected output tokens to be 20, got %d", outputTokens)
+		cacheRead := verifier.GetAttribute(span.Attributes, "gen_ai.usage.cache_read.input_tokens").AsInt64()
+		verifier.Assert(cacheRead == 8, "Expected cache read input tokens to be 8, got %d", cacheRead)
+		finishReasons := verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "end_turn", "Expected finish reason to be [end_turn], got %v", finishReasons)
+		responseID := verifier.GetAttribute(span.Attributes, "gen_ai.response.id").AsString()
+		verifier.Assert(responseID == "msg_stream123", "Expected response ID to be msg_stream123, got %s", responseID)
+	}, 1)
+
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"gen_ai.client.token.usage": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.client.token.usage metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[int64])
+			if len(point.DataPoints) != 2 {
+				panic("Expected 2 data points for gen_ai.client.token.usage, got " + strconv.Itoa(len(point.DataPoints)))
+			}
+			for _, dp := range point.DataPoints {
+				tokenType, _ := dp.Attributes.Value("gen_ai.token.type")
+				if dp.Sum != 20 {
+					panic("Expected " + tokenType.AsString() + " tokens sum to be 20, got " + strconv.FormatInt(dp.Sum, 10))
+				}
+			}
+		},
+		"gen_ai.server.time_to_first_token": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.server.time_to_first_token metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			if point.DataPoints[0].Count != 1 {
+				panic("Expected gen_ai.server.time_to_first_token count to be 1, got " + strconv.FormatUint(point.DataPoints[0].Count, 10))
+			}
+			if point.DataPoints[0].Sum <= 0 {
+				panic("gen_ai.server.time_to_first_token sum should be positive")
+			}
+		},
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const anthropic_dependency_name = "github.com/anthropics/anthropic-sdk-go"
+const anthropic_module_name = "anthropic"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("anthropic-messages-test", anthropic_module_name, "v1.19.0", "", "1.23", "", TestAnthropicMessages),
+		NewGeneralTestCase("anthropic-messages-stream-test", anthropic_module_name, "v1.19.0", "", "1.23", "", TestAnthropicMessagesStream),
+		NewLatestDepthTestCase("anthropic-messages-latestdepth-test", anthropic_dependency_name, anthropic_module_name, "v1.19.0", "", "1.23", "", TestAnthropicMessages),
+		NewMuzzleTestCase("anthropic-muzzle-test", anthropic_dependency_name, anthropic_module_name, "v1.19.0", "", "1.23", "", []string{"go", "build", "test_messages.go"}))
+}
+
+func TestAnthropicMessages(t *testing.T, env ...string) {
+	UseApp("anthropic/v1.19.0")
+	RunGoBuild(t, "go", "build", "test_messages.go")
+	RunApp(t, "test_messages", env...)
+}
+
+func TestAnthropicMessagesStream(t *testing.T, env ...string) {
+	UseApp("anthropic/v1.19.0")
+	RunGoBuild(t, "go", "build", "test_messages_stream.go")
+	RunApp(t, "test_messages_stream", env...)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const ants_dependency_name = "github.com/panjf2000/ants/v2"
+const ants_module_name = "ants"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("ants-submit-test", ants_module_name, "v2.0.0", "", "1.24", "", TestAntsSubmit),
+		NewLatestDepthTestCase("ants-submit-latest-depth", ants_dependency_name, ants_module_name, "v2.0.0", "", "1.24", "", TestAntsSubmit),
+	)
+}
+
+func TestAntsSubmit(t *testing.T, env ...string) {
+	UseApp("ants/v2.0.0")
+	RunGoBuild(t, "go", "build", "test_ants_submit.go")
+	RunApp(t, "test_ants_submit", env...)
+}
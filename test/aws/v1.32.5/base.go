@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const requestID = "4c7e0f40-0b6c-4c1a-9a5b-6c0d9a1b2e3f"
+
+var (
+	mu       sync.Mutex
+	received = map[string]string{}
+)
+
+// newServer stands in for the endpoints of DynamoDB, SQS, SNS and S3, the
+// bodies of the requests are recorded by the target operation.
+func newServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("x-amzn-RequestId", requestID)
+		w.Header().Set("x-amz-request-id", requestID)
+		target := r.Header.Get("X-Amz-Target")
+		switch {
+		case strings.HasPrefix(target, "DynamoDB_20120810."):
+			record("dynamodb", body)
+			w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+			_, _ = w.Write([]byte(`{"Item":{}}`))
+		case strings.HasPrefix(target, "AmazonSQS."):
+			record("sqs", body)
+			w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+			_, _ = w.Write([]byte(`{"MessageId":"message-1"}`))
+		case strings.Contains(string(body), "Action=Publish"):
+			record("sns", body)
+			w.Header().Set("Content-Type", "text/xml")
+			_, _ = w.Write([]byte(`<PublishResponse><PublishResult><MessageId>message-1</MessageId></PublishResult>` +
+				`<ResponseMetadata><RequestId>` + requestID + `</RequestId></ResponseMetadata></PublishResponse>`))
+		default:
+			record("s3", body)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+}
+
+func record(service string, body []byte) {
+	mu.Lock()
+	defer mu.Unlock()
+	received[service] = string(body)
+}
+
+func receivedBody(service string) string {
+	mu.Lock()
+	defer mu.Unlock()
+	return received[service]
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const region = "us-east-1"
+
+func verifyAwsAttributes(span tracetest.SpanStub, service, operation string, kind trace.SpanKind) {
+	verifier.Assert(span.Name == service+"."+operation, "Except span name to be %s.%s, got %s", service, operation, span.Name)
+	verifier.Assert(span.SpanKind == kind, "Expect to be %d span, got %d", kind, span.SpanKind)
+	system := verifier.GetAttribute(span.Attributes, "rpc.system").AsString()
+	verifier.Assert(system == "aws-api", "Except rpc.system to be aws-api, got %s", system)
+	rpcService := verifier.GetAttribute(span.Attributes, "rpc.service").AsString()
+	verifier.Assert(rpcService == service, "Except rpc.service to be %s, got %s", service, rpcService)
+	method := verifier.GetAttribute(span.Attributes, "rpc.method").AsString()
+	verifier.Assert(method == operation, "Except rpc.method to be %s, got %s", operation, method)
+	actualRegion := verifier.GetAttribute(span.Attributes, "cloud.region").AsString()
+	verifier.Assert(actualRegion == region, "Except cloud.region to be %s, got %s", region, actualRegion)
+	actualRequestID := verifier.GetAttribute(span.Attributes, "aws.request_id").AsString()
+	verifier.Assert(actualRequestID == requestID, "Except aws.request_id to be %s, got %s", requestID, actualRequestID)
+}
+
+func main() {
+	server := newServer()
+	defer server.Close()
+	ctx := context.Background()
+	credentialsProvider := credentials.NewStaticCredentialsProvider("id", "secret", "")
+
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region), config.WithCredentialsProvider(credentialsProvider))
+	if err != nil {
+		panic(err)
+	}
+	endpoint := aws.String(server.URL)
+
+	dynamodbClient := dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
+		o.BaseEndpoint = endpoint
+	})
+	if _, err = dynamodbClient.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String("users"),
+		Key:       map[string]types.AttributeValue{"id": &types.AttributeValueMemberS{Value: "1"}},
+	}); err != nil {
+		panic(err)
+	}
+
+	// the client is not built from the config, it is instrumented by New
+	sqsClient := sqs.New(sqs.Options{
+		Region:                           region,
+		Credentials:                      credentialsProvider,
+		BaseEndpoint:                     endpoint,
+		DisableMessageChecksumValidation: true,
+	})
+	if _, err = sqsClient.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(server.URL + "/123456789012/orders"),
+		MessageBody: aws.String("hello world"),
+	}); err != nil {
+		panic(err)
+	}
+
+	snsClient := sns.NewFromConfig(cfg, func(o *sns.Options) {
+		o.BaseEndpoint = endpoint
+	})
+	if _, err = snsClient.Publish(ctx, &sns.PublishInput{
+		TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:events"),
+		Message:  aws.String("hello world"),
+	}); err != nil {
+		panic(err)
+	}
+
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.BaseEndpoint = endpoint
+		o.UsePathStyle = true
+	})
+	if _, err = s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   strings.NewReader("hello world"),
+	}); err != nil {
+		panic(err)
+	}
+
+	verifier.Assert(strings.Contains(receivedBody("sqs"), "traceparent"), "Except traceparent to be injected into the SQS message attributes")
+	verifier.Assert(strings.Contains(receivedBody("sns"), "traceparent"), "Except traceparent to be injected into the SNS message attributes")
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyAwsAttributes(stubs[0][0], "DynamoDB", "GetItem", trace.SpanKindClient)
+		dbSystem := verifier.GetAttribute(stubs[0][0].Attributes, "db.system.name").AsString()
+		verifier.Assert(dbSystem == "aws.dynamodb", "Except db.system.name to be aws.dynamodb, got %s", dbSystem)
+		tables := verifier.GetAttribute(stubs[0][0].Attributes, "aws.dynamodb.table_names").AsStringSlice()
+		verifier.Assert(len(tables) == 1 && tables[0] == "users", "Except aws.dynamodb.table_names to be [users], got %v", tables)
+
+		verifyAwsAttributes(stubs[1][0], "SQS", "SendMessage", trace.SpanKindProducer)
+		messagingSystem := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.system").AsString()
+		verifier.Assert(messagingSystem == "aws_sqs", "Except messaging.system to be aws_sqs, got %s", messagingSystem)
+		queue := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.destination.name").AsString()
+		verifier.Assert(queue == "orders", "Except messaging.destination.name to be orders, got %s", queue)
+
+		verifyAwsAttributes(stubs[2][0], "SNS", "Publish", trace.SpanKindProducer)
+		topic := verifier.GetAttribute(stubs[2][0].Attributes, "messaging.destination.name").AsString()
+		verifier.Assert(topic == "events", "Except messaging.destination.name to be events, got %s", topic)
+
+		verifyAwsAttributes(stubs[3][0], "S3", "PutObject", trace.SpanKindClient)
+		bucket := verifier.GetAttribute(stubs[3][0].Attributes, "aws.s3.bucket").AsString()
+		verifier.Assert(bucket == "bucket", "Except aws.s3.bucket to be bucket, got %s", bucket)
+	}, 4)
+}
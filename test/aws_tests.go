@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const aws_dependency_name = "github.com/aws/aws-sdk-go-v2/config"
+const aws_module_name = "aws"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_aws_sdk", aws_module_name, "v1.32.5", "", "1.23", "", TestAwsSdk),
+		NewLatestDepthTestCase("test_aws_sdk", aws_dependency_name, aws_module_name, "v1.32.5", "", "1.23", "", TestAwsSdk))
+}
+
+func TestAwsSdk(t *testing.T, env ...string) {
+	UseApp("aws/v1.32.5")
+	RunGoBuild(t, "go", "build", "test_aws_sdk.go", "base.go")
+	RunApp(t, "test_aws_sdk", env...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/beego/beego/v2/server/web"
+	beecontext "github.com/beego/beego/v2/server/web/context"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupPattern() {
+	web.Get("/user/:name", func(ctx *beecontext.Context) {
+		ctx.Output.Body([]byte("ok"))
+	})
+	web.Run("127.0.0.1:8080")
+}
+
+func main() {
+	go setupPattern()
+	time.Sleep(3 * time.Second)
+	client := http.Client{}
+	resp, err := client.Get("http://127.0.0.1:8080/user/abc")
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpClientAttributes(stubs[0][0], "GET", "GET", "http://127.0.0.1:8080/user/abc", "http", "1.1", "tcp", "ipv4", "", "127.0.0.1:8080", 200, 0, 8080)
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "/user/:name", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/user/abc", "", "/user/:name", 200)
+	}, 1)
+}
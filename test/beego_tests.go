@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const beego_dependency_name = "github.com/beego/beego/v2"
+const beego_module_name = "beego"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("beego-pattern-test", beego_module_name, "v2.0.0", "", "1.24", "", TestBeegoPattern),
+		NewLatestDepthTestCase("beego-pattern-latest-depth", beego_dependency_name, beego_module_name, "v2.0.0", "", "1.24", "", TestBeegoPattern),
+	)
+}
+
+func TestBeegoPattern(t *testing.T, env ...string) {
+	UseApp("beego/v2.0.0")
+	RunGoBuild(t, "go", "build", "test_beego_pattern.go")
+	RunApp(t, "test_beego_pattern", env...)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	_ "modernc.org/sqlite"
+)
+
+type User struct {
+	bun.BaseModel `bun:"table:users"`
+	ID            int64 `bun:",pk,autoincrement"`
+	Name          string
+	Age           int
+}
+
+func verifyOrmSpan(span tracetest.SpanStub, name, operation, collection string) {
+	verifier.Assert(span.SpanKind == trace.SpanKindInternal, "Expect to be internal span, got %d", span.SpanKind)
+	verifier.Assert(span.Name == name, "Expect span name to be %s, got %s", name, span.Name)
+	system := verifier.GetAttribute(span.Attributes, "db.system.name").AsString()
+	verifier.Assert(system == "sqlite", "Expect db system to be sqlite, got %s", system)
+	actualOperation := verifier.GetAttribute(span.Attributes, "db.operation.name").AsString()
+	verifier.Assert(actualOperation == operation, "Expect db operation to be %s, got %s", operation, actualOperation)
+	actualCollection := verifier.GetAttribute(span.Attributes, "db.collection.name").AsString()
+	verifier.Assert(actualCollection == collection, "Expect db collection to be %s, got %s", collection, actualCollection)
+}
+
+func main() {
+	ctx := context.Background()
+	sqldb, err := sql.Open("sqlite", "file:bun_sqlite?mode=memory&cache=shared")
+	if err != nil {
+		panic(err)
+	}
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+	defer db.Close()
+	if _, err := db.NewCreateTable().Model((*User)(nil)).Exec(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	user := &User{Name: "opentelemetry", Age: 18}
+	if _, err := db.NewInsert().Model(user).Exec(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	var users []User
+	if err := db.NewSelect().Model(&users).Where("age > ?", 10).Scan(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	user.Age = 20
+	if _, err := db.NewUpdate().Model(user).WherePK().Exec(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := db.NewDelete().Model(user).WherePK().Exec(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	// the raw queries have no model, the table is taken from the statement
+	if _, err := db.ExecContext(ctx, "DELETE FROM users WHERE name = ?", "bun"); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := db.NewDropTable().Model((*User)(nil)).Exec(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	expected := []string{"CREATE TABLE", "INSERT", "SELECT", "UPDATE", "DELETE", "DELETE", "DROP TABLE"}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for i, operation := range expected {
+			verifyOrmSpan(stubs[i][0], operation+" users", operation, "users")
+			// the statement is run by database/sql inside the bun operation
+			verifier.Assert(len(stubs[i]) == 2, "Expect a nested database/sql span, got %d spans", len(stubs[i]))
+			verifier.Assert(stubs[i][1].SpanKind == trace.SpanKindClient, "Expect to be client span, got %d", stubs[i][1].SpanKind)
+			verifier.Assert(stubs[i][1].Parent.SpanID() == stubs[i][0].SpanContext.SpanID(), "Expect the database/sql span to be a child of the bun span")
+		}
+	}, len(expected))
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"db.client.request.duration": func(mrs metricdata.ResourceMetrics) {
+			verifyRequestDurationCount(mrs, len(expected))
+		},
+	})
+}
+
+// only the database/sql spans record the duration, the bun operations do not
+func verifyRequestDurationCount(mrs metricdata.ResourceMetrics, expected int) {
+	count := uint64(0)
+	for _, sm := range mrs.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "db.client.request.duration" {
+				continue
+			}
+			histogram, ok := m.Data.(metricdata.Histogram[float64])
+			verifier.Assert(ok, "Expect db.client.request.duration to be a histogram")
+			for _, point := range histogram.DataPoints {
+				count += point.Count
+			}
+		}
+	}
+	verifier.Assert(count == uint64(expected), "Expect %d db.client.request.duration records, got %d", expected, count)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const bun_dependency_name = "github.com/uptrace/bun"
+const bun_module_name = "bun"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_bun_crud", bun_module_name, "v1.1.12", "", "1.23", "", TestBunCrud),
+		NewLatestDepthTestCase("test_bun_crud", bun_dependency_name, bun_module_name, "v1.1.12", "", "1.23", "", TestBunCrud),
+		NewGeneralTestCase("test_bun_sqlite", bun_module_name, "v1.1.12", "", "1.23", "", TestBunSqlite))
+}
+
+func TestBunCrud(t *testing.T, env ...string) {
+	_, postgresPort := initPostgresContainer()
+	UseApp("bun/v1.1.12")
+	RunGoBuild(t, "go", "build", "test_bun_crud.go")
+	env = append(env, "POSTGRES_PORT="+postgresPort.Port())
+	RunApp(t, "test_bun_crud", env...)
+}
+
+func TestBunSqlite(t *testing.T, env ...string) {
+	UseApp("bun/v1.1.12")
+	RunGoBuild(t, "go", "build", "test_bun_sqlite.go")
+	RunApp(t, "test_bun_sqlite", env...)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	users := chi.NewRouter()
+	users.Get("/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(chi.URLParam(r, "id")))
+	})
+	router := chi.NewRouter()
+	router.Mount("/api/users", users)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/users/1")
+	if err != nil {
+		panic(err)
+	}
+	_, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	address := strings.TrimPrefix(server.URL, "http://")
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "/api/users/{id}", "GET", "http", "tcp", "ipv4", "", address, "Go-http-client/1.1", "http", "/api/users/1", "", "/api/users/{id}", 200)
+	}, 1)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const chi_dependency_name = "github.com/go-chi/chi/v5"
+const chi_module_name = "chi"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("chi-pattern-test", chi_module_name, "v5.0.0", "", "1.23", "", TestChiPattern),
+		NewLatestDepthTestCase("chi-latestdepth-test", chi_dependency_name, chi_module_name, "v5.0.0", "", "1.23", "", TestChiPattern))
+}
+
+func TestChiPattern(t *testing.T, env ...string) {
+	UseApp("chi/v5.0.0")
+	RunGoBuild(t, "go", "build", "test_chi_pattern.go")
+	RunApp(t, "test_chi_pattern", env...)
+}
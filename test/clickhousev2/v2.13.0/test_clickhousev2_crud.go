@@ -124,7 +124,8 @@ func main() {
 		verifier.VerifyDbAttributes(stubs[3][0], "QUERY", "clickhouse", addr, "SELECT * FROM users WHERE id = ?", "QUERY", "", nil)
 		verifier.VerifyDbAttributes(stubs[4][0], "QUERY_ROW", "clickhouse", addr, "SELECT * FROM users WHERE id = ?", "QUERY_ROW", "", nil)
 		verifier.VerifyDbAttributes(stubs[5][0], "PREPARE_BATCH", "clickhouse", addr, "INSERT INTO users (id, name, age)", "PREPARE_BATCH", "", nil)
-		verifier.VerifyDbAttributes(stubs[6][0], "SERVER_VERSION", "clickhouse", addr, "SERVER_VERSION", "SERVER_VERSION", "", nil)
-		verifier.VerifyDbAttributes(stubs[7][0], "PING", "clickhouse", addr, "PING", "PING", "", nil)
+		verifier.VerifyDbAttributes(stubs[6][0], "INSERT", "clickhouse", addr, "INSERT INTO users (id, name, age)", "INSERT", "", nil)
+		verifier.VerifyDbAttributes(stubs[7][0], "SERVER_VERSION", "clickhouse", addr, "SERVER_VERSION", "SERVER_VERSION", "", nil)
+		verifier.VerifyDbAttributes(stubs[8][0], "PING", "clickhouse", addr, "PING", "PING", "", nil)
 	}, 1)
 }
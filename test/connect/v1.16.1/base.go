@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	greetProcedure      = "/test.v1.GreetService/Greet"
+	greetManyProcedure  = "/test.v1.GreetService/GreetMany"
+	greetServiceName    = "test.v1.GreetService"
+	connectServerAddr   = "localhost:8080"
+	connectServerPrefix = "http://" + connectServerAddr
+)
+
+func greet(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+	if req.Msg.GetValue() == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("nobody to greet"))
+	}
+	if req.Msg.GetValue() == "broken" {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("greeter is broken"))
+	}
+	return connect.NewResponse(wrapperspb.String("hello " + req.Msg.GetValue())), nil
+}
+
+func greetMany(ctx context.Context, req *connect.Request[wrapperspb.StringValue], stream *connect.ServerStream[wrapperspb.StringValue]) error {
+	for i := 0; i < 3; i++ {
+		if err := stream.Send(wrapperspb.String("hello " + req.Msg.GetValue() + " " + strconv.Itoa(i))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setupConnect() {
+	mux := http.NewServeMux()
+	mux.Handle(greetProcedure, connect.NewUnaryHandler(greetProcedure, greet))
+	mux.Handle(greetManyProcedure, connect.NewServerStreamHandler(greetManyProcedure, greetMany))
+	if err := http.ListenAndServe(connectServerAddr, mux); err != nil {
+		panic(err)
+	}
+}
+
+func sendGreet(ctx context.Context, name string) error {
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](http.DefaultClient, connectServerPrefix+greetProcedure)
+	_, err := client.CallUnary(ctx, connect.NewRequest(wrapperspb.String(name)))
+	return err
+}
+
+func sendGreetMany(ctx context.Context, name string) error {
+	client := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](http.DefaultClient, connectServerPrefix+greetManyProcedure)
+	stream, err := client.CallServerStream(ctx, connect.NewRequest(wrapperspb.String(name)))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	n := 0
+	for stream.Receive() {
+		n++
+	}
+	if n != 3 {
+		return fmt.Errorf("expect 3 messages, got %d", n)
+	}
+	return stream.Err()
+}
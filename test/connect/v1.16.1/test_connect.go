@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	go setupConnect()
+	time.Sleep(3 * time.Second)
+	if err := sendGreet(context.Background(), "connect"); err != nil {
+		panic(err)
+	}
+	if err := sendGreet(context.Background(), ""); connect.CodeOf(err) != connect.CodeNotFound {
+		panic(err)
+	}
+	if err := sendGreet(context.Background(), "broken"); connect.CodeOf(err) != connect.CodeInternal {
+		panic(err)
+	}
+	if err := sendGreetMany(context.Background(), "connect"); err != nil {
+		panic(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		// every connect call is carried by a pair of net/http spans
+		verifier.VerifyRpcClientAttributes(stubs[0][0], greetServiceName+"/Greet", "connect_rpc", greetServiceName, "Greet")
+		verifier.VerifyRpcServerAttributes(stubs[0][3], greetServiceName+"/Greet", "connect_rpc", greetServiceName, "Greet")
+		verifier.Assert(stubs[0][3].Parent.SpanID() == stubs[0][2].SpanContext.SpanID(), "Expect the connect server span to be a child of the http server span")
+
+		for _, span := range []tracetest.SpanStub{stubs[1][0], stubs[1][3]} {
+			code := verifier.GetAttribute(span.Attributes, "rpc.connect_rpc.error_code").AsString()
+			verifier.Assert(code == "not_found", "Expect connect error code to be not_found, got %s", code)
+			errorType := verifier.GetAttribute(span.Attributes, "error.type").AsString()
+			verifier.Assert(errorType == "not_found", "Expect error type to be not_found, got %s", errorType)
+		}
+		// not_found is caused by the client, it only fails the client span
+		verifier.Assert(stubs[1][0].Status.Code == codes.Error, "Expect the connect client span to be failed, got %v", stubs[1][0].Status.Code)
+		verifier.Assert(stubs[1][3].Status.Code == codes.Unset, "Expect the connect server span not to be failed, got %v", stubs[1][3].Status.Code)
+		// internal is caused by the server, it fails both spans
+		verifier.Assert(stubs[2][0].Status.Code == codes.Error, "Expect the connect client span to be failed, got %v", stubs[2][0].Status.Code)
+		verifier.Assert(stubs[2][3].Status.Code == codes.Error, "Expect the connect server span to be failed, got %v", stubs[2][3].Status.Code)
+
+		verifier.VerifyRpcClientAttributes(stubs[3][0], greetServiceName+"/GreetMany", "connect_rpc", greetServiceName, "GreetMany")
+		verifier.VerifyRpcServerAttributes(stubs[3][3], greetServiceName+"/GreetMany", "connect_rpc", greetServiceName, "GreetMany")
+	}, 4)
+}
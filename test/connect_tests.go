@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const connect_dependency_name = "connectrpc.com/connect"
+const connect_module_name = "connect"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_connect", connect_module_name, "v1.16.1", "", "1.23", "", TestConnect),
+		NewLatestDepthTestCase("test_connect", connect_dependency_name, connect_module_name, "v1.16.1", "", "1.23", "", TestConnect))
+}
+
+func TestConnect(t *testing.T, env ...string) {
+	UseApp("connect/v1.16.1")
+	RunGoBuild(t, "go", "build", "test_connect.go", "base.go")
+	RunApp(t, "test_connect", env...)
+}
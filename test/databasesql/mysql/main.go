@@ -19,6 +19,7 @@ import (
 	"database/sql"
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/alibaba/loongsuite-go-agent/test/verifier"
 	_ "github.com/go-sql-driver/mysql"
@@ -78,6 +79,11 @@ func dbTransaction() {
 		verifier.VerifyDbAttributes(stubs[4][0], "INSERT users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ? )", "INSERT", "users", []any{"2", "foobar", 24})
 		verifier.VerifyDbAttributes(stubs[5][0], "UPDATE users", "mysql", "127.0.0.1", "UPDATE users SET name = ? WHERE id = ?", "UPDATE", "users", []any{"foobar", "0"})
 		verifier.VerifyDbAttributes(stubs[6][0], "COMMIT", "mysql", "127.0.0.1", "COMMIT", "COMMIT", "", nil)
+		port, _ := strconv.Atoi(os.Getenv("MYSQL_PORT"))
+		actualPort := verifier.GetAttribute(stubs[0][0].Attributes, "server.port").AsInt64()
+		verifier.Assert(actualPort == int64(port), "Except server.port to be %d, got %d", port, actualPort)
+		actualNamespace := verifier.GetAttribute(stubs[0][0].Attributes, "db.namespace").AsString()
+		verifier.Assert(actualNamespace == "test", "Except db.namespace to be test, got %s", actualNamespace)
 	}, 7)
 }
 
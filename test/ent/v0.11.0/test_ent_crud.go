@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"ent/v0.11.0/ent"
+	"ent/v0.11.0/ent/user"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	_ "modernc.org/sqlite"
+)
+
+func verifyOrmSpan(span tracetest.SpanStub, name, operation, collection string) {
+	verifier.Assert(span.SpanKind == trace.SpanKindInternal, "Expect to be internal span, got %d", span.SpanKind)
+	verifier.Assert(span.Name == name, "Expect span name to be %s, got %s", name, span.Name)
+	system := verifier.GetAttribute(span.Attributes, "db.system.name").AsString()
+	verifier.Assert(system == "sqlite", "Expect db system to be sqlite, got %s", system)
+	actualOperation := verifier.GetAttribute(span.Attributes, "db.operation.name").AsString()
+	verifier.Assert(actualOperation == operation, "Expect db operation to be %s, got %s", operation, actualOperation)
+	actualCollection := verifier.GetAttribute(span.Attributes, "db.collection.name").AsString()
+	verifier.Assert(actualCollection == collection, "Expect db collection to be %s, got %s", collection, actualCollection)
+}
+
+func main() {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite", "file:ent?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	// the table is created by hand, the migration of ent runs a lot of statements
+	if _, err := db.ExecContext(ctx, "CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, age INTEGER NOT NULL)"); err != nil {
+		panic(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	if _, err := client.User.Create().SetName("opentelemetry").SetAge(18).Save(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := client.User.CreateBulk(client.User.Create().SetName("ent").SetAge(3), client.User.Create().SetName("go").SetAge(13)).Save(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := client.User.Query().Where(user.AgeGT(10)).All(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := client.User.Query().Count(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := client.User.Update().Where(user.Name("ent")).SetAge(4).Save(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := client.User.Delete().Where(user.Name("go")).Exec(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+	expected := []struct {
+		operation string
+		batchSize int64
+	}{{"INSERT", 0}, {"BATCH INSERT", 2}, {"SELECT", 0}, {"SELECT", 0}, {"UPDATE", 0}, {"DELETE", 0}}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(stubs[0][0].Name == "CREATE", "Expect the table to be created by database/sql, got %s", stubs[0][0].Name)
+		for i, e := range expected {
+			spans := stubs[i+1]
+			verifyOrmSpan(spans[0], e.operation+" users", e.operation, "users")
+			batchSize := verifier.GetAttribute(spans[0].Attributes, "db.operation.batch.size").AsInt64()
+			verifier.Assert(batchSize == e.batchSize, "Expect db batch size to be %d, got %d", e.batchSize, batchSize)
+			// the statement is run by database/sql inside the ent operation
+			verifier.Assert(len(spans) == 2, "Expect a nested database/sql span, got %d spans", len(spans))
+			verifier.Assert(spans[1].SpanKind == trace.SpanKindClient, "Expect to be client span, got %d", spans[1].SpanKind)
+			verifier.Assert(spans[1].Parent.SpanID() == spans[0].SpanContext.SpanID(), "Expect the database/sql span to be a child of the ent span")
+		}
+	}, len(expected)+1)
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"db.client.request.duration": func(mrs metricdata.ResourceMetrics) {
+			verifyRequestDurationCount(mrs, len(expected)+1)
+		},
+	})
+}
+
+// only the database/sql spans record the duration, the ent operations do not
+func verifyRequestDurationCount(mrs metricdata.ResourceMetrics, expected int) {
+	count := uint64(0)
+	for _, sm := range mrs.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "db.client.request.duration" {
+				continue
+			}
+			histogram, ok := m.Data.(metricdata.Histogram[float64])
+			verifier.Assert(ok, "Expect db.client.request.duration to be a histogram")
+			for _, point := range histogram.DataPoints {
+				count += point.Count
+			}
+		}
+	}
+	verifier.Assert(count == uint64(expected), "Expect %d db.client.request.duration records, got %d", expected, count)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const ent_dependency_name = "entgo.io/ent"
+const ent_module_name = "ent"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("ent-crud-test", ent_module_name, "v0.11.0", "", "1.24", "", TestEntCrud),
+		NewLatestDepthTestCase("ent-crud-latest-depth", ent_dependency_name, ent_module_name, "v0.11.0", "", "1.24", "", TestEntCrud),
+	)
+}
+
+func TestEntCrud(t *testing.T, env ...string) {
+	UseApp("ent/v0.11.0")
+	RunGoBuild(t, "go", "build", "test_ent_crud.go")
+	RunApp(t, "test_ent_crud", env...)
+}
@@ -32,6 +32,7 @@ func TestRunErrors(t *testing.T) {
 	ExpectNotContains(t, stdout, "val1024")
 	ExpectContains(t, stdout, "val1298") // 0x512
 	ExpectContains(t, stdout, "7632")
+	ExpectContains(t, stdout, "suzhou")
 	ExpectContains(t, stdout, "4008208820")
 	ExpectContains(t, stdout, "118888")
 	ExpectContains(t, stdout, "0.001")
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/genai"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "invalid"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":400,"message":"Request contains an invalid argument.","status":"INVALID_ARGUMENT"}}`))
+		case strings.Contains(string(body), "second"):
+			w.Write([]byte(`{"embeddings":[{"values":[0.1,0.2,0.3,0.4]},{"values":[0.5,0.6,0.7,0.8]}]}`))
+		default:
+			w.Write([]byte(`{"embeddings":[{"values":[0.1,0.2,0.3]}]}`))
+		}
+	}))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:      "test-api-key",
+		Backend:     genai.BackendGeminiAPI,
+		HTTPOptions: genai.HTTPOptions{BaseURL: mockServer.URL},
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = client.Models.EmbedContent(ctx, "text-embedding-004", genai.Text("first"), nil); err != nil {
+		panic(err)
+	}
+	if _, err = client.Models.EmbedContent(ctx, "text-embedding-004", []*genai.Content{
+		genai.NewContentFromText("first", genai.RoleUser),
+		genai.NewContentFromText("second", genai.RoleUser),
+	}, nil); err != nil {
+		panic(err)
+	}
+	if _, err = client.Models.EmbedContent(ctx, "text-embedding-004", genai.Text("invalid"), nil); err == nil {
+		panic("expected the embedding of an invalid content to fail")
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "embeddings", "gemini", "text-embedding-004")
+		count := verifier.GetAttribute(span.Attributes, "gen_ai.embedding.count").AsInt64()
+		verifier.Assert(count == 1, "Expected embedding count to be 1, got %d", count)
+		dimensions := verifier.GetAttribute(span.Attributes, "gen_ai.embedding.dimensions").AsInt64()
+		verifier.Assert(dimensions == 3, "Expected embedding dimensions to be 3, got %d", dimensions)
+
+		span = stubs[1][0]
+		verifier.VerifyLLMAttributes(span, "embeddings", "gemini", "text-embedding-004")
+		count = verifier.GetAttribute(span.Attributes, "gen_ai.embedding.count").AsInt64()
+		verifier.Assert(count == 2, "Expected embedding count to be 2, got %d", count)
+		dimensions = verifier.GetAttribute(span.Attributes, "gen_ai.embedding.dimensions").AsInt64()
+		verifier.Assert(dimensions == 4, "Expected embedding dimensions to be 4, got %d", dimensions)
+
+		span = stubs[2][0]
+		verifier.VerifyLLMAttributes(span, "embeddings", "gemini", "text-embedding-004")
+		verifier.Assert(span.Status.Code == codes.Error, "Expected the failed embedding to have an error status, got %v", span.Status.Code)
+	}, 3)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/genai"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "forbidden"):
+			w.Write([]byte(`{"promptFeedback":{"blockReason":"SAFETY"},"usageMetadata":{"promptTokenCount":6,"totalTokenCount":6},"modelVersion":"gemini-2.0-flash","responseId":"resp_blocked"}`))
+		default:
+			w.Write([]byte(`{"candidates":[{"content":{"role":"model","parts":[{"text":"Hello from Gemini!"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":10,"candidatesTokenCount":5,"cachedContentTokenCount":4,"totalTokenCount":15},"modelVersion":"gemini-2.0-flash-001","responseId":"resp_123"}`))
+		}
+	}))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:      "test-api-key",
+		Backend:     genai.BackendGeminiAPI,
+		HTTPOptions: genai.HTTPOptions{BaseURL: mockServer.URL},
+	})
+	if err != nil {
+		panic(err)
+	}
+	temperature := float32(0.5)
+	config := &genai.GenerateContentConfig{
+		Temperature:     &temperature,
+		MaxOutputTokens: 100,
+		StopSequences:   []string{"END"},
+	}
+	if _, err = client.Models.GenerateContent(ctx, "gemini-2.0-flash", genai.Text("Hello, how are you?"), config); err != nil {
+		panic(err)
+	}
+	chat, err := client.Chats.Create(ctx, "gemini-2.0-flash", nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = chat.SendMessage(ctx, genai.Part{Text: "Tell me a joke"}); err != nil {
+		panic(err)
+	}
+	if _, err = client.Models.GenerateContent(ctx, "gemini-2.0-flash", genai.Text("Something forbidden"), nil); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-2.0-flash")
+		inputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(inputTokens == 10, "Expected input tokens to be 10, got %d", inputTokens)
+		outputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.output_tokens").AsInt64()
+		verifier.Assert(outputTokens == 5, "Expected output tokens to be 5, got %d", outputTokens)
+		cacheRead := verifier.GetAttribute(span.Attributes, "gen_ai.usage.cache_read.input_tokens").AsInt64()
+		verifier.Assert(cacheRead == 4, "Expected cache read input tokens to be 4, got %d", cacheRead)
+		maxTokens := verifier.GetAttribute(span.Attributes, "gen_ai.request.max_tokens").AsInt64()
+		verifier.Assert(maxTokens == 100, "Expected max tokens to be 100, got %d", maxTokens)
+		responseModel := verifier.GetAttribute(span.Attributes, "gen_ai.response.model").AsString()
+		verifier.Assert(responseModel == "gemini-2.0-flash-001", "Expected response model to be gemini-2.0-flash-001, got %s", responseModel)
+		finishReasons := verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "STOP", "Expected finish reason to be [STOP], got %v", finishReasons)
+
+		span = stubs[1][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-2.0-flash")
+		responseModel = verifier.GetAttribute(span.Attributes, "gen_ai.response.model").AsString()
+		verifier.Assert(responseModel == "gemini-2.0-flash-001", "Expected response model to be gemini-2.0-flash-001, got %s", responseModel)
+
+		span = stubs[2][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-2.0-flash")
+		finishReasons = verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "SAFETY", "Expected finish reason to be [SAFETY], got %v", finishReasons)
+	}, 3)
+}
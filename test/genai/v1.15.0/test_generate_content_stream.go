@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/genai"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		chunks := []string{
+			`{"candidates":[{"content":{"role":"model","parts":[{"text":"Hello"}]}}],"modelVersion":"gemini-2.0-flash-001","responseId":"resp_stream123"}`,
+			`{"candidates":[{"content":{"role":"model","parts":[{"text":" from Gemini!"}]}}],"modelVersion":"gemini-2.0-flash-001","responseId":"resp_stream123"}`,
+			`{"candidates":[{"content":{"role":"model","parts":[{"text":""}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":20,"candidatesTokenCount":20,"totalTokenCount":40},"modelVersion":"gemini-2.0-flash-001","responseId":"resp_stream123"}`,
+		}
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+			flusher.Flush()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:      "test-api-key",
+		Backend:     genai.BackendGeminiAPI,
+		HTTPOptions: genai.HTTPOptions{BaseURL: mockServer.URL},
+	})
+	if err != nil {
+		panic(err)
+	}
+	chat, err := client.Chats.Create(ctx, "gemini-2.0-flash", nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	for _, err := range chat.SendMessageStream(ctx, genai.Part{Text: "Hello, how are you?"}) {
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-2.0-flash")
+		inputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(inputTokens == 20, "Expected input tokens to be 20, got %d", inputTokens)
+		outputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.output_tokens").AsInt64()
+		verifier.Assert(outputTokens == 20, "Expected output tokens to be 20, got %d", outputTokens)
+		finishReasons := verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "STOP", "Expected finish reason to be [STOP], got %v", finishReasons)
+		responseModel := verifier.GetAttribute(span.Attributes, "gen_ai.response.model").AsString()
+		verifier.Assert(responseModel == "gemini-2.0-flash-001", "Expected response model to be gemini-2.0-flash-001, got %s", responseModel)
+	}, 1)
+
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"gen_ai.client.token.usage": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.client.token.usage metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[int64])
+			if len(point.DataPoints) != 2 {
+				panic("Expected 2 data points for gen_ai.client.token.usage, got " + strconv.Itoa(len(point.DataPoints)))
+			}
+			for _, dp := range point.DataPoints {
+				tokenType, _ := dp.Attributes.Value("gen_ai.token.type")
+				if dp.Sum != 20 {
+					panic("Expected " + tokenType.AsString() + " tokens sum to be 20, got " + strconv.FormatInt(dp.Sum, 10))
+				}
+			}
+		},
+		"gen_ai.server.time_to_first_token": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.server.time_to_first_token metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			if point.DataPoints[0].Count != 1 {
+				panic("Expected gen_ai.server.time_to_first_token count to be 1, got " + strconv.FormatUint(point.DataPoints[0].Count, 10))
+			}
+			if point.DataPoints[0].Sum <= 0 {
+				panic("gen_ai.server.time_to_first_token sum should be positive")
+			}
+		},
+	})
+}
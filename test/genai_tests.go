@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const genai_dependency_name = "google.golang.org/genai"
+const genai_module_name = "genai"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("genai-generate-content-test", genai_module_name, "v1.15.0", "", "1.23", "", TestGenAIGenerateContent),
+		NewGeneralTestCase("genai-generate-content-stream-test", genai_module_name, "v1.15.0", "", "1.23", "", TestGenAIGenerateContentStream),
+		NewGeneralTestCase("genai-embed-content-test", genai_module_name, "v1.15.0", "", "1.23", "", TestGenAIEmbedContent),
+		NewLatestDepthTestCase("genai-generate-content-latestdepth-test", genai_dependency_name, genai_module_name, "v1.15.0", "", "1.23", "", TestGenAIGenerateContent),
+		NewMuzzleTestCase("genai-muzzle-test", genai_dependency_name, genai_module_name, "v1.15.0", "", "1.23", "", []string{"go", "build", "test_generate_content.go"}))
+}
+
+func TestGenAIGenerateContent(t *testing.T, env ...string) {
+	UseApp("genai/v1.15.0")
+	RunGoBuild(t, "go", "build", "test_generate_content.go")
+	RunApp(t, "test_generate_content", env...)
+}
+
+func TestGenAIGenerateContentStream(t *testing.T, env ...string) {
+	UseApp("genai/v1.15.0")
+	RunGoBuild(t, "go", "build", "test_generate_content_stream.go")
+	RunApp(t, "test_generate_content_stream", env...)
+}
+
+func TestGenAIEmbedContent(t *testing.T, env ...string) {
+	UseApp("genai/v1.15.0")
+	RunGoBuild(t, "go", "build", "test_embed_content.go")
+	RunApp(t, "test_embed_content", env...)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/google/generative-ai-go/genai"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "invalid"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":400,"message":"Request contains an invalid argument.","status":"INVALID_ARGUMENT"}}`))
+		default:
+			w.Write([]byte(`{"embedding":{"values":[0.1,0.2,0.3]}}`))
+		}
+	}))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, option.WithAPIKey("test-api-key"), option.WithEndpoint(mockServer.URL))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	model := client.EmbeddingModel("text-embedding-004")
+	if _, err = model.EmbedContent(ctx, genai.Text("first")); err != nil {
+		panic(err)
+	}
+	if _, err = model.EmbedContentWithTitle(ctx, "title", genai.Text("second")); err != nil {
+		panic(err)
+	}
+	if _, err = model.EmbedContent(ctx, genai.Text("invalid")); err == nil {
+		panic("expected the embedding of an invalid content to fail")
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for i := 0; i < 2; i++ {
+			for _, child := range stubs[i][1:] {
+				verifier.Assert(child.Name != "embeddings", "Expected the embedding to have a single embeddings span, got %v", child.Name)
+			}
+			span := stubs[i][0]
+			verifier.VerifyLLMAttributes(span, "embeddings", "gemini", "text-embedding-004")
+			count := verifier.GetAttribute(span.Attributes, "gen_ai.embedding.count").AsInt64()
+			verifier.Assert(count == 1, "Expected embedding count to be 1, got %d", count)
+			dimensions := verifier.GetAttribute(span.Attributes, "gen_ai.embedding.dimensions").AsInt64()
+			verifier.Assert(dimensions == 3, "Expected embedding dimensions to be 3, got %d", dimensions)
+		}
+
+		span := stubs[2][0]
+		verifier.VerifyLLMAttributes(span, "embeddings", "gemini", "text-embedding-004")
+		verifier.Assert(span.Status.Code == codes.Error, "Expected the failed embedding to have an error status, got %v", span.Status.Code)
+	}, 3)
+}
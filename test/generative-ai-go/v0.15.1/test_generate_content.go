@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/google/generative-ai-go/genai"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "forbidden"):
+			w.Write([]byte(`{"promptFeedback":{"blockReason":"SAFETY"},"usageMetadata":{"promptTokenCount":6,"totalTokenCount":6}}`))
+		case strings.HasSuffix(r.URL.Path, ":streamGenerateContent"):
+			// chat messages are sent through the streaming endpoint
+			w.Write([]byte(`[{"candidates":[{"content":{"role":"model","parts":[{"text":"Why did the gopher cross the road?"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":12,"candidatesTokenCount":8,"totalTokenCount":20}}]`))
+		default:
+			w.Write([]byte(`{"candidates":[{"content":{"role":"model","parts":[{"text":"Hello from Gemini!"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":10,"candidatesTokenCount":5,"cachedContentTokenCount":4,"totalTokenCount":15}}`))
+		}
+	}))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, option.WithAPIKey("test-api-key"), option.WithEndpoint(mockServer.URL))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	model := client.GenerativeModel("gemini-1.5-flash")
+	model.SetTemperature(0.5)
+	model.SetMaxOutputTokens(100)
+	model.StopSequences = []string{"END"}
+	if _, err = model.GenerateContent(ctx, genai.Text("Hello, how are you?")); err != nil {
+		panic(err)
+	}
+	chat := model.StartChat()
+	chat.History = []*genai.Content{
+		{Role: "user", Parts: []genai.Part{genai.Text("Hi")}},
+		{Role: "model", Parts: []genai.Part{genai.Text("Hello!")}},
+	}
+	if _, err = chat.SendMessage(ctx, genai.Text("Tell me a joke")); err != nil {
+		panic(err)
+	}
+	var blocked *genai.BlockedError
+	if _, err = model.GenerateContent(ctx, genai.Text("Something forbidden")); !errors.As(err, &blocked) {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-1.5-flash")
+		inputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(inputTokens == 10, "Expected input tokens to be 10, got %d", inputTokens)
+		outputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.output_tokens").AsInt64()
+		verifier.Assert(outputTokens == 5, "Expected output tokens to be 5, got %d", outputTokens)
+		cacheRead := verifier.GetAttribute(span.Attributes, "gen_ai.usage.cache_read.input_tokens").AsInt64()
+		verifier.Assert(cacheRead == 4, "Expected cache read input tokens to be 4, got %d", cacheRead)
+		maxTokens := verifier.GetAttribute(span.Attributes, "gen_ai.request.max_tokens").AsInt64()
+		verifier.Assert(maxTokens == 100, "Expected max tokens to be 100, got %d", maxTokens)
+		temperature := verifier.GetAttribute(span.Attributes, "gen_ai.request.temperature").AsFloat64()
+		verifier.Assert(temperature == 0.5, "Expected temperature to be 0.5, got %f", temperature)
+		finishReasons := verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "STOP", "Expected finish reason to be [STOP], got %v", finishReasons)
+
+		for _, child := range stubs[1][1:] {
+			verifier.Assert(child.Name != "chat", "Expected the chat message to have a single chat span, got %v", child.Name)
+		}
+		span = stubs[1][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-1.5-flash")
+		inputTokens = verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+		verifier.Assert(inputTokens == 12, "Expected input tokens to be 12, got %d", inputTokens)
+		finishReasons = verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "STOP", "Expected finish reason to be [STOP], got %v", finishReasons)
+
+		span = stubs[2][0]
+		verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-1.5-flash")
+		finishReasons = verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+		verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "SAFETY", "Expected finish reason to be [SAFETY], got %v", finishReasons)
+		verifier.Assert(span.Status.Code != codes.Error, "Expected the blocked prompt not to have an error status, got %v", span.Status.Code)
+	}, 3)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/google/generative-ai-go/genai"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		chunks := []string{
+			`[{"candidates":[{"content":{"role":"model","parts":[{"text":"Hello"}]}}]}`,
+			`,{"candidates":[{"content":{"role":"model","parts":[{"text":" from Gemini!"}]}}]}`,
+			`,{"candidates":[{"content":{"role":"model","parts":[{"text":""}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":20,"candidatesTokenCount":20,"totalTokenCount":40}}]`,
+		}
+		for _, chunk := range chunks {
+			w.Write([]byte(chunk))
+			flusher.Flush()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client, err := genai.NewClient(ctx, option.WithAPIKey("test-api-key"), option.WithEndpoint(mockServer.URL))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	model := client.GenerativeModel("gemini-1.5-flash")
+	drain(model.GenerateContentStream(ctx, genai.Text("Hello, how are you?")))
+	drain(model.StartChat().SendMessageStream(ctx, genai.Text("Tell me a joke")))
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, trace := range stubs {
+			span := trace[0]
+			verifier.VerifyLLMAttributes(span, "chat", "gemini", "gemini-1.5-flash")
+			inputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.input_tokens").AsInt64()
+			verifier.Assert(inputTokens == 20, "Expected input tokens to be 20, got %d", inputTokens)
+			outputTokens := verifier.GetAttribute(span.Attributes, "gen_ai.usage.output_tokens").AsInt64()
+			verifier.Assert(outputTokens == 20, "Expected output tokens to be 20, got %d", outputTokens)
+			finishReasons := verifier.GetAttribute(span.Attributes, "gen_ai.response.finish_reasons").AsStringSlice()
+			verifier.Assert(len(finishReasons) == 1 && finishReasons[0] == "STOP", "Expected finish reason to be [STOP], got %v", finishReasons)
+		}
+	}, 2)
+
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"gen_ai.server.time_to_first_token": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.server.time_to_first_token metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			if point.DataPoints[0].Count != 2 {
+				panic("Expected gen_ai.server.time_to_first_token count to be 2, got " + strconv.FormatUint(point.DataPoints[0].Count, 10))
+			}
+			if point.DataPoints[0].Sum <= 0 {
+				panic("gen_ai.server.time_to_first_token sum should be positive")
+			}
+		},
+	})
+}
+
+func drain(iter *genai.GenerateContentResponseIterator) {
+	for {
+		_, err := iter.Next()
+		if err == iterator.Done {
+			return
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+}
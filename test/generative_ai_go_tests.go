@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const generative_ai_go_dependency_name = "github.com/google/generative-ai-go"
+const generative_ai_go_module_name = "generative-ai-go"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("generative-ai-go-generate-content-test", generative_ai_go_module_name, "v0.15.1", "", "1.23", "", TestGenerativeAIGoGenerateContent),
+		NewGeneralTestCase("generative-ai-go-generate-content-stream-test", generative_ai_go_module_name, "v0.15.1", "", "1.23", "", TestGenerativeAIGoGenerateContentStream),
+		NewGeneralTestCase("generative-ai-go-embed-content-test", generative_ai_go_module_name, "v0.15.1", "", "1.23", "", TestGenerativeAIGoEmbedContent),
+		NewLatestDepthTestCase("generative-ai-go-generate-content-latestdepth-test", generative_ai_go_dependency_name, generative_ai_go_module_name, "v0.15.1", "", "1.23", "", TestGenerativeAIGoGenerateContent),
+		NewMuzzleTestCase("generative-ai-go-muzzle-test", generative_ai_go_dependency_name, generative_ai_go_module_name, "v0.15.1", "", "1.23", "", []string{"go", "build", "test_generate_content.go"}))
+}
+
+func TestGenerativeAIGoGenerateContent(t *testing.T, env ...string) {
+	UseApp("generative-ai-go/v0.15.1")
+	RunGoBuild(t, "go", "build", "test_generate_content.go")
+	RunApp(t, "test_generate_content", env...)
+}
+
+func TestGenerativeAIGoGenerateContentStream(t *testing.T, env ...string) {
+	UseApp("generative-ai-go/v0.15.1")
+	RunGoBuild(t, "go", "build", "test_generate_content_stream.go")
+	RunApp(t, "test_generate_content_stream", env...)
+}
+
+func TestGenerativeAIGoEmbedContent(t *testing.T, env ...string) {
+	UseApp("generative-ai-go/v0.15.1")
+	RunGoBuild(t, "go", "build", "test_embed_content.go")
+	RunApp(t, "test_embed_content", env...)
+}
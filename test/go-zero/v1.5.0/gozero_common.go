@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/zeromicro/go-zero/core/conf"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var tracer = otel.Tracer("test-tracer")
+
+// mustFillDefault fills the defaults of a go-zero config, the switches of the
+// middlewares are left off and are turned on by each test
+func mustFillDefault(v any) {
+	if err := conf.FillDefault(v); err != nil {
+		panic(err)
+	}
+}
+
+func findSpan(stubs tracetest.SpanStubs, name string) *tracetest.SpanStub {
+	for i := range stubs {
+		if stubs[i].Name == name {
+			return &stubs[i]
+		}
+	}
+	return nil
+}
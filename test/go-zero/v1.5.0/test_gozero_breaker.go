@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/zeromicro/go-zero/core/breaker"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errDownstream = errors.New("downstream failure")
+
+func main() {
+	ctx, span := tracer.Start(context.Background(), "calls")
+	brk := breaker.NewBreaker(breaker.WithName("downstream"))
+	call := func(name string, req func() error) error {
+		_, span := tracer.Start(ctx, name)
+		defer span.End()
+		return brk.Do(req)
+	}
+	if err := call("succeeded", func() error { return nil }); err != nil {
+		panic(err)
+	}
+	// the breaker opens after enough failures, the calls are then rejected
+	// without being made
+	rejected := false
+	for i := 0; i < 1000 && !rejected; i++ {
+		rejected = call("failed", func() error { return errDownstream }) == breaker.ErrServiceUnavailable
+	}
+	span.End()
+	verifier.Assert(rejected, "Expect the breaker to reject a call")
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(stubs[0][0].Name == "calls", "Expect the calls span to be the root, got %s", stubs[0][0].Name)
+		outcomes := map[string]int{}
+		for _, stub := range stubs[0][1:] {
+			name := verifier.GetAttribute(stub.Attributes, "gozero.breaker.name").AsString()
+			verifier.Assert(name == "downstream", "Expect the breaker name on %s, got %s", stub.Name, name)
+			outcome := verifier.GetAttribute(stub.Attributes, "gozero.breaker.outcome").AsString()
+			if stub.Name == "succeeded" {
+				verifier.Assert(outcome == "accepted", "Expect the succeeded call to be accepted, got %s", outcome)
+			}
+			outcomes[outcome]++
+		}
+		verifier.Assert(outcomes["rejected"] == 1, "Expect one rejected call, got %d", outcomes["rejected"])
+		verifier.Assert(outcomes["accepted"] == len(stubs[0])-2, "Expect the other calls to be accepted, got %v", outcomes)
+	}, 1)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/zeromicro/go-zero/rest"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupRest() {
+	var c rest.RestConf
+	mustFillDefault(&c)
+	c.Name = "gozero-rest"
+	c.Host = "127.0.0.1"
+	c.Port = 8080
+	c.Middlewares = rest.MiddlewaresConf{Breaker: true, Shedding: true}
+	server := rest.MustNewServer(c)
+	server.AddRoute(rest.Route{
+		Method: http.MethodGet,
+		Path:   "/users/:id",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("ok"))
+		},
+	})
+	server.Start()
+}
+
+func main() {
+	go setupRest()
+	time.Sleep(3 * time.Second)
+	resp, err := http.Get("http://127.0.0.1:8080/users/1")
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 2, "Expect 2 spans, got %d", len(stubs[0]))
+		server := stubs[0][1]
+		verifier.Assert(server.Name == "/users/:id", "Expect the server span to be named after the route, got %s", server.Name)
+		verifier.Assert(verifier.GetAttribute(server.Attributes, "http.route").AsString() == "/users/:id", "Expect http.route to be /users/:id, got %s", verifier.GetAttribute(server.Attributes, "http.route").AsString())
+		breakerName := verifier.GetAttribute(server.Attributes, "gozero.breaker.name").AsString()
+		verifier.Assert(breakerName == "GET:///users/:id", "Expect the breaker of the route, got %s", breakerName)
+		breakerOutcome := verifier.GetAttribute(server.Attributes, "gozero.breaker.outcome").AsString()
+		verifier.Assert(breakerOutcome == "accepted", "Expect the request to be accepted by the breaker, got %s", breakerOutcome)
+		sheddingOutcome := verifier.GetAttribute(server.Attributes, "gozero.shedding.outcome").AsString()
+		verifier.Assert(sheddingOutcome == "accepted", "Expect the request to be accepted by the shedder, got %s", sheddingOutcome)
+	}, 1)
+}
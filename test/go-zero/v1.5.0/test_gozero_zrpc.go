@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/zeromicro/go-zero/zrpc"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const healthCheck = "/grpc.health.v1.Health/Check"
+
+// setupZrpc serves the health service registered by zrpc itself
+func setupZrpc() {
+	var c zrpc.RpcServerConf
+	mustFillDefault(&c)
+	c.Name = "gozero-zrpc"
+	c.ListenOn = "127.0.0.1:9090"
+	c.Middlewares = zrpc.ServerMiddlewaresConf{Breaker: true}
+	server := zrpc.MustNewServer(c, func(*grpc.Server) {})
+	server.Start()
+}
+
+func main() {
+	go setupZrpc()
+	time.Sleep(3 * time.Second)
+	var c zrpc.RpcClientConf
+	mustFillDefault(&c)
+	c.Endpoints = []string{"127.0.0.1:9090"}
+	c.Middlewares = zrpc.ClientMiddlewaresConf{Breaker: true}
+	client := zrpc.MustNewClient(c)
+	ctx, span := tracer.Start(context.Background(), "call")
+	_, err := grpc_health_v1.NewHealthClient(client.Conn()).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	span.End()
+	if err != nil {
+		panic(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 3, "Expect 3 spans, got %d", len(stubs[0]))
+		// the client breaker is consulted before the client span is started,
+		// its outcome is recorded on the span of the caller
+		call := stubs[0][0]
+		verifier.Assert(call.Name == "call", "Expect the call span to be the root, got %s", call.Name)
+		verifier.Assert(strings.HasSuffix(verifier.GetAttribute(call.Attributes, "gozero.breaker.name").AsString(), "127.0.0.1:9090"+healthCheck), "Expect the client breaker on the call span, got %s", verifier.GetAttribute(call.Attributes, "gozero.breaker.name").AsString())
+		verifier.Assert(verifier.GetAttribute(call.Attributes, "gozero.breaker.outcome").AsString() == "accepted", "Expect the call to be accepted by the client breaker, got %s", verifier.GetAttribute(call.Attributes, "gozero.breaker.outcome").AsString())
+		client := stubs[0][1]
+		verifier.VerifyRpcClientAttributes(client, healthCheck, "grpc", "/grpc.health.v1.Health", "Check")
+		server := stubs[0][2]
+		verifier.VerifyRpcServerAttributes(server, healthCheck, "grpc", "/grpc.health.v1.Health", "Check")
+		verifier.Assert(server.Parent.SpanID() == client.SpanContext.SpanID(), "Expect the server span to be a child of the client span")
+		verifier.Assert(verifier.GetAttribute(server.Attributes, "gozero.breaker.name").AsString() == healthCheck, "Expect the server breaker on the server span, got %s", verifier.GetAttribute(server.Attributes, "gozero.breaker.name").AsString())
+		verifier.Assert(verifier.GetAttribute(server.Attributes, "gozero.breaker.outcome").AsString() == "accepted", "Expect the call to be accepted by the server breaker, got %s", verifier.GetAttribute(server.Attributes, "gozero.breaker.outcome").AsString())
+		verifier.Assert(verifier.GetAttribute(server.Attributes, "gozero.shedding.outcome").AsString() == "accepted", "Expect the call to be accepted by the shedder, got %s", verifier.GetAttribute(server.Attributes, "gozero.shedding.outcome").AsString())
+	}, 1)
+}
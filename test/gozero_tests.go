@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const gozero_dependency_name = "github.com/zeromicro/go-zero"
+const gozero_module_name = "go-zero"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gozero-rest-test", gozero_module_name, "v1.5.0", "", "1.24", "", TestGoZeroRest),
+		NewGeneralTestCase("gozero-breaker-test", gozero_module_name, "v1.5.0", "", "1.24", "", TestGoZeroBreaker),
+		NewGeneralTestCase("gozero-zrpc-test", gozero_module_name, "v1.5.0", "", "1.24", "", TestGoZeroZrpc),
+		NewLatestDepthTestCase("gozero-rest-latest-depth", gozero_dependency_name, gozero_module_name, "v1.5.0", "", "1.24", "", TestGoZeroRest),
+		NewLatestDepthTestCase("gozero-zrpc-latest-depth", gozero_dependency_name, gozero_module_name, "v1.5.0", "", "1.24", "", TestGoZeroZrpc),
+	)
+}
+
+func TestGoZeroRest(t *testing.T, env ...string) {
+	UseApp("go-zero/v1.5.0")
+	RunGoBuild(t, "go", "build", "test_gozero_rest.go", "gozero_common.go")
+	RunApp(t, "test_gozero_rest", env...)
+}
+
+func TestGoZeroBreaker(t *testing.T, env ...string) {
+	UseApp("go-zero/v1.5.0")
+	RunGoBuild(t, "go", "build", "test_gozero_breaker.go", "gozero_common.go")
+	RunApp(t, "test_gozero_breaker", env...)
+}
+
+func TestGoZeroZrpc(t *testing.T, env ...string) {
+	UseApp("go-zero/v1.5.0")
+	RunGoBuild(t, "go", "build", "test_gozero_zrpc.go", "gozero_common.go")
+	RunApp(t, "test_gozero_zrpc", env...)
+}
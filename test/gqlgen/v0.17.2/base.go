@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const schemaString = `
+type Query {
+	user(id: ID!): User
+	users: [User!]!
+}
+
+type User {
+	id: ID!
+	name: String!
+	friends: [User!]!
+}
+`
+
+type user struct {
+	id      string
+	name    string
+	friends []string
+}
+
+var users = map[string]*user{
+	"1": {id: "1", name: "alice", friends: []string{"2", "3"}},
+	"2": {id: "2", name: "bob", friends: []string{"1", "3"}},
+	"3": {id: "3", name: "carol", friends: []string{"1", "2"}},
+}
+
+// executableSchema resolves the fields the way the code generated by gqlgen
+// does, the fields backed by resolvers go through the field middlewares.
+type executableSchema struct {
+	schema *ast.Schema
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	return e.schema
+}
+
+func (e *executableSchema) Complexity(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	first := true
+	return func(ctx context.Context) *graphql.Response {
+		if !first {
+			return nil
+		}
+		first = false
+		data, err := json.Marshal(resolveObject(ctx, rc, "Query", rc.Operation.SelectionSet, nil))
+		if err != nil {
+			panic(err)
+		}
+		return &graphql.Response{Data: data}
+	}
+}
+
+func resolveObject(ctx context.Context, rc *graphql.OperationContext, typeName string, selections ast.SelectionSet, obj *user) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, field := range graphql.CollectFields(rc, selections, []string{typeName}) {
+		resolver := field.Name == "user" || field.Name == "users" || field.Name == "friends"
+		fc := &graphql.FieldContext{
+			Object:     typeName,
+			Field:      field,
+			Args:       field.ArgumentMap(rc.Variables),
+			IsMethod:   resolver,
+			IsResolver: resolver,
+		}
+		fieldCtx := graphql.WithFieldContext(ctx, fc)
+		value, err := rc.ResolverMiddleware(fieldCtx, func(ctx context.Context) (interface{}, error) {
+			// the children are resolved in the context of the middlewares
+			fieldCtx = ctx
+			return resolveField(field.Name, obj, fc.Args)
+		})
+		if err != nil {
+			panic(err)
+		}
+		switch v := value.(type) {
+		case *user:
+			result[field.Alias] = resolveObject(fieldCtx, rc, "User", field.Selections, v)
+		case []*user:
+			list := make([]interface{}, 0, len(v))
+			for i, u := range v {
+				index := i
+				indexCtx := graphql.WithFieldContext(fieldCtx, &graphql.FieldContext{Index: &index, Result: u})
+				list = append(list, resolveObject(indexCtx, rc, "User", field.Selections, u))
+			}
+			result[field.Alias] = list
+		default:
+			result[field.Alias] = v
+		}
+	}
+	return result
+}
+
+func resolveField(name string, obj *user, args map[string]interface{}) (interface{}, error) {
+	switch name {
+	case "user":
+		return users[args["id"].(string)], nil
+	case "users":
+		return []*user{users["1"], users["2"], users["3"]}, nil
+	case "friends":
+		friends := make([]*user, 0, len(obj.friends))
+		for _, id := range obj.friends {
+			friends = append(friends, users[id])
+		}
+		return friends, nil
+	case "id":
+		return obj.id, nil
+	case "name":
+		return obj.name, nil
+	}
+	return nil, fmt.Errorf("unknown field %s", name)
+}
+
+func newGraphqlServer() *httptest.Server {
+	schema := &executableSchema{schema: gqlparser.MustLoadSchema(&ast.Source{Input: schemaString})}
+	mux := http.NewServeMux()
+	mux.Handle("/query", handler.NewDefaultServer(schema))
+	return httptest.NewServer(mux)
+}
+
+func query(url, document string) {
+	body, err := json.Marshal(map[string]string{"query": document})
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.Post(url+"/query", "application/json", bytes.NewReader(body))
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusUnprocessableEntity {
+		panic(fmt.Sprintf("unexpected status %d", resp.StatusCode))
+	}
+}
+
+func findSpan(stubs []tracetest.SpanStub, name string) tracetest.SpanStub {
+	for _, stub := range stubs {
+		if stub.Name == name {
+			return stub
+		}
+	}
+	panic("span " + name + " not found")
+}
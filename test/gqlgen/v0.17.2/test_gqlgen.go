@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	server := newGraphqlServer()
+	defer server.Close()
+	query(server.URL, `query GetUser { user(id: "1") { name friends { name } } }`)
+	query(server.URL, `query Broken { user(id: "1") { unknown } }`)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyOperation(stubs[0])
+		verifyInvalidOperation(stubs[1])
+	}, 2)
+}
+
+func verifyOperation(stubs tracetest.SpanStubs) {
+	server := findSpan(stubs, "POST /query GetUser")
+	verifier.Assert(server.SpanKind == trace.SpanKindServer, "Expect server span, got %d", server.SpanKind)
+	route := verifier.GetAttribute(server.Attributes, "http.route").AsString()
+	verifier.Assert(route == "/query", "Expect http.route to be /query, got %s", route)
+
+	parse := findSpan(stubs, "graphql.parse")
+	validate := findSpan(stubs, "graphql.validate")
+	execute := findSpan(stubs, "query GetUser")
+	for _, stub := range []tracetest.SpanStub{parse, validate, execute} {
+		verifier.Assert(stub.SpanKind == trace.SpanKindInternal, "Expect %s to be internal span, got %d", stub.Name, stub.SpanKind)
+		verifier.Assert(stub.Parent.SpanID() == server.SpanContext.SpanID(), "Expect %s to be the child of the server span", stub.Name)
+		name := verifier.GetAttribute(stub.Attributes, "graphql.operation.name").AsString()
+		verifier.Assert(name == "GetUser", "Expect operation name of %s to be GetUser, got %s", stub.Name, name)
+		operationType := verifier.GetAttribute(stub.Attributes, "graphql.operation.type").AsString()
+		verifier.Assert(operationType == "query", "Expect operation type of %s to be query, got %s", stub.Name, operationType)
+	}
+	verifier.Assert(!validate.StartTime.Before(parse.EndTime), "Expect validation to start after parsing")
+	document := verifier.GetAttribute(execute.Attributes, "graphql.document").AsString()
+	verifier.Assert(document == `query GetUser { user(id: ?) { name friends { name } } }`, "Expect document to be sanitized, got %s", document)
+
+	user := findSpan(stubs, "Query.user")
+	verifier.Assert(user.Parent.SpanID() == execute.SpanContext.SpanID(), "Expect resolver span to be the child of the execution")
+	path := verifier.GetAttribute(user.Attributes, "graphql.field.path").AsString()
+	verifier.Assert(path == "user", "Expect field path to be user, got %s", path)
+	friends := findSpan(stubs, "User.friends")
+	verifier.Assert(friends.Parent.SpanID() == user.SpanContext.SpanID(), "Expect the resolver of friends to be the child of the resolver of user")
+	path = verifier.GetAttribute(friends.Attributes, "graphql.field.path").AsString()
+	verifier.Assert(path == "user.friends", "Expect field path to be user.friends, got %s", path)
+	parent := verifier.GetAttribute(friends.Attributes, "graphql.parent.name").AsString()
+	verifier.Assert(parent == "User", "Expect parent name to be User, got %s", parent)
+	for _, stub := range stubs {
+		verifier.Assert(stub.Name != "User.name", "Expect no resolver span for the fields without resolver")
+	}
+}
+
+func verifyInvalidOperation(stubs tracetest.SpanStubs) {
+	// the operation is not executed, the server span is not renamed
+	server := findSpan(stubs, "POST /query")
+	validate := findSpan(stubs, "graphql.validate")
+	verifier.Assert(validate.Parent.SpanID() == server.SpanContext.SpanID(), "Expect validation to be the child of the server span")
+	verifier.Assert(validate.Status.Code == codes.Error, "Expect validation to fail, got status %d", validate.Status.Code)
+	for _, stub := range stubs {
+		verifier.Assert(!strings.HasPrefix(stub.Name, "query "), "Expect no execution of an invalid operation, got %s", stub.Name)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// every user has two friends, so the query resolves users once, friends 3
+// times at depth 2 and 6 times at depth 3
+const friendsQuery = `query Friends { users { name friends { name friends { name } } } }`
+
+func envInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func main() {
+	maxDepth := envInt("OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH", 5)
+	maxSpans := envInt("OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS", 100)
+	expected := 0
+	for depth, count := range []int{1, 3, 6} {
+		if depth+1 <= maxDepth {
+			expected += count
+		}
+	}
+	if expected > maxSpans {
+		expected = maxSpans
+	}
+
+	server := newGraphqlServer()
+	defer server.Close()
+	query(server.URL, friendsQuery)
+	// the limit on the number of spans applies to each operation
+	query(server.URL, friendsQuery)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, trace := range stubs {
+			execute := findSpan(trace, "query Friends")
+			resolvers := 0
+			for _, stub := range trace {
+				if !strings.HasPrefix(stub.Name, "Query.") && !strings.HasPrefix(stub.Name, "User.") {
+					continue
+				}
+				resolvers++
+				path := verifier.GetAttribute(stub.Attributes, "graphql.field.path").AsString()
+				depth := 0
+				for _, segment := range strings.Split(path, ".") {
+					if _, err := strconv.Atoi(segment); err != nil {
+						depth++
+					}
+				}
+				verifier.Assert(depth <= maxDepth, "Expect the depth of %s to be at most %d", path, maxDepth)
+				verifier.Assert(stub.SpanContext.TraceID() == execute.SpanContext.TraceID(), "Expect %s to be in the trace of the operation", path)
+			}
+			verifier.Assert(resolvers == expected, "Expect %d resolver spans, got %d", expected, resolvers)
+		}
+	}, 2)
+}
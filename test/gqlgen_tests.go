@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const gqlgen_dependency_name = "github.com/99designs/gqlgen"
+const gqlgen_module_name = "gqlgen"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_gqlgen", gqlgen_module_name, "v0.17.2", "", "1.23", "", TestGqlgen),
+		NewLatestDepthTestCase("test_gqlgen", gqlgen_dependency_name, gqlgen_module_name, "v0.17.2", "", "1.23", "", TestGqlgen),
+		NewGeneralTestCase("test_gqlgen_resolver_max_depth", gqlgen_module_name, "v0.17.2", "", "1.23", "", TestGqlgenResolverMaxDepth),
+		NewGeneralTestCase("test_gqlgen_resolver_max_spans", gqlgen_module_name, "v0.17.2", "", "1.23", "", TestGqlgenResolverMaxSpans))
+}
+
+func TestGqlgen(t *testing.T, env ...string) {
+	UseApp("gqlgen/v0.17.2")
+	RunGoBuild(t, "go", "build", "test_gqlgen.go", "base.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE=true")
+	RunApp(t, "test_gqlgen", env...)
+}
+
+func TestGqlgenResolverMaxDepth(t *testing.T, env ...string) {
+	UseApp("gqlgen/v0.17.2")
+	RunGoBuild(t, "go", "build", "test_gqlgen_resolver_limits.go", "base.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE=true",
+		"OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_DEPTH=2")
+	RunApp(t, "test_gqlgen_resolver_limits", env...)
+}
+
+func TestGqlgenResolverMaxSpans(t *testing.T, env ...string) {
+	UseApp("gqlgen/v0.17.2")
+	RunGoBuild(t, "go", "build", "test_gqlgen_resolver_limits.go", "base.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE=true",
+		"OTEL_INSTRUMENTATION_GRAPHQL_RESOLVER_MAX_SPANS=5")
+	RunApp(t, "test_gqlgen_resolver_limits", env...)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+)
+
+const schemaString = `
+schema {
+	query: Query
+}
+
+type Query {
+	user(id: ID!): User
+}
+
+type User {
+	id: ID!
+	name: String!
+	friends: [User!]!
+}
+`
+
+type user struct {
+	id      graphql.ID
+	name    string
+	friends []graphql.ID
+}
+
+var users = map[graphql.ID]*user{
+	"1": {id: "1", name: "alice", friends: []graphql.ID{"2"}},
+	"2": {id: "2", name: "bob"},
+}
+
+type queryResolver struct{}
+
+func (r *queryResolver) User(ctx context.Context, args struct{ ID graphql.ID }) *userResolver {
+	if u, ok := users[args.ID]; ok {
+		return &userResolver{u}
+	}
+	return nil
+}
+
+type userResolver struct {
+	u *user
+}
+
+func (r *userResolver) ID() graphql.ID {
+	return r.u.id
+}
+
+func (r *userResolver) Name() string {
+	return r.u.name
+}
+
+func (r *userResolver) Friends(ctx context.Context) []*userResolver {
+	friends := make([]*userResolver, 0, len(r.u.friends))
+	for _, id := range r.u.friends {
+		friends = append(friends, &userResolver{users[id]})
+	}
+	return friends
+}
+
+func newGraphqlServer() *httptest.Server {
+	schema := graphql.MustParseSchema(schemaString, &queryResolver{})
+	mux := http.NewServeMux()
+	mux.Handle("/query", &relay.Handler{Schema: schema})
+	return httptest.NewServer(mux)
+}
+
+func query(url, document string) {
+	body, err := json.Marshal(map[string]string{"query": document})
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.Post(url+"/query", "application/json", bytes.NewReader(body))
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		panic(fmt.Sprintf("unexpected status %d", resp.StatusCode))
+	}
+}
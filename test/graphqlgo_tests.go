@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const graphqlgo_dependency_name = "github.com/graph-gophers/graphql-go"
+const graphqlgo_module_name = "graphql-go"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_graphql_go", graphqlgo_module_name, "v1.3.0", "", "1.23", "", TestGraphqlGo),
+		NewLatestDepthTestCase("test_graphql_go", graphqlgo_dependency_name, graphqlgo_module_name, "v1.3.0", "", "1.23", "", TestGraphqlGo))
+}
+
+func TestGraphqlGo(t *testing.T, env ...string) {
+	UseApp("graphql-go/v1.3.0")
+	RunGoBuild(t, "go", "build", "test_graphql_go.go", "base.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GRAPHQL_EXPERIMENTAL_RESOLVER_SPAN_ENABLE=true")
+	RunApp(t, "test_graphql_go", env...)
+}
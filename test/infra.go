@@ -204,6 +204,21 @@ func RunApp(t *testing.T, appName string, env ...string) (string, string) {
 	return stdoutText, stderrText
 }
 
+// RunAppFallible runs the app with the real exporters and expects it to fail,
+// e.g. to die of a panic.
+func RunAppFallible(t *testing.T, appName string, env ...string) (string, string) {
+	cmd := runCmd([]string{"./" + appName})
+	cmd.Env = append(os.Environ(), env...)
+	err := cmd.Run()
+	stdoutText := readStdoutLog(t)
+	stderrText := readStderrLog(t)
+	if err == nil {
+		t.Log(stdoutText)
+		t.Fatal("expected failure", stderrText)
+	}
+	return stdoutText, stderrText
+}
+
 func FetchVersion(t *testing.T, dependency, version string) string {
 	t.Logf("dependency %s, version %s", dependency, version)
 	output, err := exec.Command("go", "get", dependency+"@"+version).Output()
@@ -25,11 +25,13 @@ func main() {
 		// Span 0: agentExecutor (root)
 		// Span 1: chains
 		// Span 2: llmGenerateSingle
-		// Span 3: agentAction
+		// Span 3: execute_tool
 		// Span 4: chains
 		// Span 5: llmGenerateSingle
 		verifier.VerifyLLMCommonAttributesWithGenAISpanKind(stubs[0][0], "agentExecutor", "langchain", trace.SpanKindClient, "agent")
-		verifier.VerifyLLMCommonAttributesWithGenAISpanKind(stubs[0][3], "agentAction", "langchain", trace.SpanKindClient, "tool")
+		verifier.VerifyLLMCommonAttributesWithGenAISpanKind(stubs[0][3], "execute_tool", "langchain", trace.SpanKindInternal, "tool")
+		toolName := verifier.GetAttribute(stubs[0][3].Attributes, "gen_ai.tool.name").AsString()
+		verifier.Assert(toolName == "getAge", "Expected gen_ai.tool.name to be getAge, got %s", toolName)
 	}, 1)
 }
 
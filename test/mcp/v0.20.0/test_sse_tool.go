@@ -47,7 +47,6 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	defer c.Close()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	// Start the mcpClient
@@ -83,12 +82,20 @@ func main() {
 		panic(err)
 	}
 
+	// closing the client ends the session span
+	c.Close()
+
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		verifier.VerifyLLMCommonAttributes(stubs[1][0], "execute_other:initialize", "mcp", trace.SpanKindClient)
-		verifier.VerifyLLMCommonAttributes(stubs[1][3], "execute_other:initialize", "mcp", trace.SpanKindServer)
-		verifier.VerifyLLMCommonAttributes(stubs[3][0], "execute_tool", "mcp", trace.SpanKindClient)
-		verifier.VerifyLLMCommonAttributes(stubs[3][3], "execute_tool", "mcp", trace.SpanKindServer)
-		verifier.VerifyLLMCommonAttributes(stubs[4][0], "execute_other:tools/list", "mcp", trace.SpanKindClient)
-		verifier.VerifyLLMCommonAttributes(stubs[4][3], "execute_other:tools/list", "mcp", trace.SpanKindServer)
-	}, 5)
+		verifier.VerifyLLMCommonAttributes(stubs[1][0], "mcp.session", "mcp", trace.SpanKindInternal)
+		verifier.VerifyLLMCommonAttributes(stubs[1][1], "execute_other:initialize", "mcp", trace.SpanKindClient)
+		verifier.VerifyLLMCommonAttributes(stubs[1][4], "execute_other:initialize", "mcp", trace.SpanKindServer)
+		verifier.VerifyLLMCommonAttributes(stubs[1][7], "execute_tool", "mcp", trace.SpanKindClient)
+		verifier.VerifyLLMCommonAttributes(stubs[1][10], "execute_tool", "mcp", trace.SpanKindServer)
+		verifier.VerifyLLMCommonAttributes(stubs[1][11], "execute_other:tools/list", "mcp", trace.SpanKindClient)
+		verifier.VerifyLLMCommonAttributes(stubs[1][14], "execute_other:tools/list", "mcp", trace.SpanKindServer)
+		// the server spans continue the trace carried in _meta
+		verifier.Assert(stubs[1][4].Parent.SpanID() == stubs[1][1].SpanContext.SpanID(), "Expect the initialize server span to be a child of the client span")
+		verifier.Assert(stubs[1][10].Parent.SpanID() == stubs[1][7].SpanContext.SpanID(), "Expect the execute_tool server span to be a child of the client span")
+		verifier.Assert(stubs[1][14].Parent.SpanID() == stubs[1][11].SpanContext.SpanID(), "Expect the tools/list server span to be a child of the client span")
+	}, 2)
 }
@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type echoSamplingHandler struct{}
+
+func (echoSamplingHandler) CreateMessage(ctx context.Context, request mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+	return &mcp.CreateMessageResult{
+		SamplingMessage: mcp.SamplingMessage{
+			Role:    mcp.RoleAssistant,
+			Content: mcp.NewTextContent("42"),
+		},
+		Model:      "mock-model",
+		StopReason: "endTurn",
+	}, nil
+}
+
+func askHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s := server.ServerFromContext(ctx)
+	if err := s.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+		"progressToken": "ask-1",
+		"progress":      1,
+		"total":         2,
+	}); err != nil {
+		return nil, err
+	}
+	result, err := s.RequestSampling(ctx, mcp.CreateMessageRequest{
+		CreateMessageParams: mcp.CreateMessageParams{
+			Messages:  []mcp.SamplingMessage{{Role: mcp.RoleUser, Content: mcp.NewTextContent("the answer?")}},
+			MaxTokens: 16,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("%v", result.Content)), nil
+}
+
+func hasEvent(span tracetest.SpanStub, name string) bool {
+	for _, event := range span.Events {
+		if event.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	mcpServer.EnableSampling()
+	mcpServer.AddTool(mcp.NewTool("ask"), askHandler)
+	testServer := httptest.NewServer(server.NewStreamableHTTPServer(mcpServer))
+	defer testServer.Close()
+
+	// the sampling requests of the server come over the listening stream
+	httpTransport, err := transport.NewStreamableHTTP(testServer.URL+"/mcp", transport.WithContinuousListening())
+	if err != nil {
+		panic(err)
+	}
+	c := client.NewClient(httpTransport, client.WithSamplingHandler(echoSamplingHandler{}))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := c.Start(ctx); err != nil {
+		panic(err)
+	}
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "example-client", Version: "1.0.0"}
+	if _, err := c.Initialize(ctx, initRequest); err != nil {
+		panic(err)
+	}
+	callRequest := mcp.CallToolRequest{}
+	callRequest.Params.Name = "ask"
+	if _, err := c.CallTool(ctx, callRequest); err != nil {
+		panic(err)
+	}
+	// closing the client ends the session span
+	if err := c.Close(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var session tracetest.SpanStubs
+		for _, stub := range stubs {
+			if stub[0].Name == "mcp.session" {
+				session = stub
+			}
+		}
+		verifier.Assert(session != nil, "Expect a mcp.session trace")
+		verifier.VerifyLLMCommonAttributes(session[0], "mcp.session", "mcp", trace.SpanKindInternal)
+		verifier.Assert(verifier.GetAttribute(session[0].Attributes, "gen_ai.other_output.server_info_name").AsString() == "test", "Expect the server info on the session span")
+		verifier.Assert(hasEvent(session[0], "notifications/progress"), "Expect the progress notification on the session span")
+		verifier.Assert(hasEvent(session[0], "sampling/createMessage"), "Expect the sampling request on the session span")
+		var clientSpans, serverSpans []tracetest.SpanStub
+		for _, span := range session {
+			if verifier.GetAttribute(span.Attributes, "gen_ai.system").AsString() != "mcp" {
+				continue
+			}
+			switch span.SpanKind {
+			case trace.SpanKindClient:
+				clientSpans = append(clientSpans, span)
+			case trace.SpanKindServer:
+				serverSpans = append(serverSpans, span)
+			}
+		}
+		verifier.Assert(len(clientSpans) == 2 && len(serverSpans) == 2, "Expect 2 client and 2 server mcp spans, got %d and %d", len(clientSpans), len(serverSpans))
+		verifier.VerifyLLMCommonAttributes(clientSpans[0], "execute_other:initialize", "mcp", trace.SpanKindClient)
+		verifier.VerifyLLMCommonAttributes(serverSpans[0], "execute_other:initialize", "mcp", trace.SpanKindServer)
+		verifier.VerifyLLMCommonAttributes(serverSpans[1], "execute_tool", "mcp", trace.SpanKindServer)
+		for i, clientSpan := range clientSpans {
+			verifier.Assert(clientSpan.Parent.SpanID() == session[0].SpanContext.SpanID(), "Expect the client span to be a child of the session span")
+			// the server continues the trace carried in _meta
+			verifier.Assert(serverSpans[i].Parent.SpanID() == clientSpan.SpanContext.SpanID(), "Expect the server span to be a child of the client span")
+		}
+		verifier.Assert(hasEvent(serverSpans[1], "notifications/progress"), "Expect the progress notification on the tool span")
+	}, 1)
+}
@@ -27,6 +27,7 @@ func init() {
 		NewGeneralTestCase("mcp-0.20.0-sse-prompt-test", mcp_module_name, "0.20.0", "0.20.0", "1.22.0", "", TestMcpPrompt),
 		NewGeneralTestCase("mcp-0.41.1-sse-prompt-test", mcp_module_name, "0.20.0", "", "1.22.0", "", TestMcpPrompt041),
 		NewGeneralTestCase("mcp-0.20.0-sse-resource-test", mcp_module_name, "0.20.0", "0.20.0", "1.22.0", "", TestMcpResource),
+		NewGeneralTestCase("mcp-0.45.0-streamable-session-test", mcp_module_name, "0.45.0", "", "1.24.0", "", TestMcpStreamableSession),
 	)
 
 }
@@ -48,6 +49,12 @@ func TestMcpPrompt041(t *testing.T, env ...string) {
 	RunApp(t, "test_sse_prompt", env...)
 }
 
+func TestMcpStreamableSession(t *testing.T, env ...string) {
+	UseApp("mcp/v0.45.0")
+	RunGoBuild(t, "go", "build", "test_streamable_session.go")
+	RunApp(t, "test_streamable_session", env...)
+}
+
 func TestMcpResource(t *testing.T, env ...string) {
 	UseApp("mcp/v0.20.0")
 	RunGoBuild(t, "go", "build", "test_sse_resource.go", "ext.go")
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const collection = "articles"
+
+func verifyVectorSpan(span tracetest.SpanStub, operation string, topK, dimension, rows int64) {
+	verifier.VerifyDbAttributes(span, operation+" "+collection, "milvus", "127.0.0.1", "", operation, collection, nil)
+	actualTopK := verifier.GetAttribute(span.Attributes, "db.vector.query.top_k").AsInt64()
+	verifier.Assert(actualTopK == topK, "Expect top_k to be %d, got %d", topK, actualTopK)
+	actualDimension := verifier.GetAttribute(span.Attributes, "db.vector.dimension").AsInt64()
+	verifier.Assert(actualDimension == dimension, "Expect vector dimension to be %d, got %d", dimension, actualDimension)
+	actualRows := verifier.GetAttribute(span.Attributes, "db.response.returned_rows")
+	if rows < 0 {
+		verifier.Assert(actualRows.Type() == 0, "Expect no returned rows, got %v", actualRows.Emit())
+	} else {
+		verifier.Assert(actualRows.AsInt64() == rows, "Expect returned rows to be %d, got %d", rows, actualRows.AsInt64())
+	}
+}
+
+func main() {
+	ctx := context.Background()
+	c, err := client.NewGrpcClient(ctx, "127.0.0.1:"+os.Getenv("MILVUS_PORT"))
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close()
+	schema := entity.NewSchema().WithName(collection).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("embedding").WithDataType(entity.FieldTypeFloatVector).WithDim(4))
+	if err := c.CreateCollection(ctx, schema, entity.DefaultShardNumber); err != nil {
+		panic(err)
+	}
+	vectors := [][]float32{{0.1, 0.2, 0.3, 0.4}, {0.2, 0.3, 0.4, 0.5}, {0.9, 0.8, 0.7, 0.6}}
+	if _, err := c.Insert(ctx, collection, "", entity.NewColumnInt64("id", []int64{1, 2, 3}),
+		entity.NewColumnFloatVector("embedding", 4, vectors)); err != nil {
+		panic(err)
+	}
+	if err := c.Flush(ctx, collection, false); err != nil {
+		panic(err)
+	}
+	index, err := entity.NewIndexFlat(entity.L2)
+	if err != nil {
+		panic(err)
+	}
+	if err := c.CreateIndex(ctx, collection, "embedding", index, false); err != nil {
+		panic(err)
+	}
+	if err := c.LoadCollection(ctx, collection, false); err != nil {
+		panic(err)
+	}
+	sp, err := entity.NewIndexFlatSearchParam()
+	if err != nil {
+		panic(err)
+	}
+	if _, err := c.Search(ctx, collection, nil, "", []string{"id"}, []entity.Vector{entity.FloatVector(vectors[0])},
+		"embedding", entity.L2, 2, sp); err != nil {
+		panic(err)
+	}
+	if _, err := c.Query(ctx, collection, nil, "id > 0", []string{"id"}); err != nil {
+		panic(err)
+	}
+	if err := c.DeleteByPks(ctx, collection, "", entity.NewColumnInt64("id", []int64{1})); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		// the collection management calls only have gRPC spans
+		var vectorTraces []tracetest.SpanStubs
+		for _, stub := range stubs {
+			if verifier.GetAttribute(stub[0].Attributes, "db.system.name").AsString() == "milvus" {
+				vectorTraces = append(vectorTraces, stub)
+			}
+		}
+		verifier.Assert(len(vectorTraces) == 4, "Expect 4 milvus traces, got %d", len(vectorTraces))
+		verifyVectorSpan(vectorTraces[0][0], "insert", 0, 4, -1)
+		verifyVectorSpan(vectorTraces[1][0], "search", 2, 4, 2)
+		verifyVectorSpan(vectorTraces[2][0], "query", 0, 0, 3)
+		verifyVectorSpan(vectorTraces[3][0], "delete", 0, 0, -1)
+		for _, stub := range vectorTraces {
+			verifier.Assert(len(stub) > 1 && stub[1].SpanKind == trace.SpanKindClient, "Expect a nested gRPC client span")
+			verifier.Assert(stub[1].Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect the gRPC span to be a child of the milvus span")
+		}
+	}, 4)
+}
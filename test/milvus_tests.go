@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const milvus_dependency_name = "github.com/milvus-io/milvus-sdk-go/v2"
+const milvus_module_name = "milvus"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("milvus-vector-test", milvus_module_name, "v2.3.0", "", "1.23", "", TestMilvusVector),
+		NewLatestDepthTestCase("milvus-vector-latestdepth-test", milvus_dependency_name, milvus_module_name, "v2.3.0", "", "1.23", "", TestMilvusVector),
+		NewMuzzleTestCase("milvus-muzzle-test", milvus_dependency_name, milvus_module_name, "v2.3.0", "", "1.23", "", []string{"go", "build", "test_milvus_vector.go"}))
+}
+
+func TestMilvusVector(t *testing.T, env ...string) {
+	_, milvusPort := initMilvusContainer()
+	UseApp("milvus/v2.3.0")
+	RunGoBuild(t, "go", "build", "test_milvus_vector.go")
+	env = append(env, "MILVUS_PORT="+milvusPort.Port())
+	RunApp(t, "test_milvus_vector", env...)
+}
+
+func initMilvusContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "milvusdb/milvus:v2.3.9",
+		ExposedPorts: []string{"19530/tcp", "9091/tcp"},
+		Cmd:          []string{"milvus", "run", "standalone"},
+		Env: map[string]string{
+			"ETCD_USE_EMBED":     "true",
+			"ETCD_DATA_DIR":      "/var/lib/milvus/etcd",
+			"ETCD_CONFIG_PATH":   "/milvus/configs/embedEtcd.yaml",
+			"COMMON_STORAGETYPE": "local",
+		},
+		Files: []testcontainers.ContainerFile{{
+			Reader:            strings.NewReader("listen-client-urls: http://0.0.0.0:2379\nadvertise-client-urls: http://0.0.0.0:2379\n"),
+			ContainerFilePath: "/milvus/configs/embedEtcd.yaml",
+			FileMode:          0o644,
+		}},
+		WaitingFor: wait.ForHTTP("/healthz").WithPort("9091/tcp"),
+	}
+	milvusC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := milvusC.MappedPort(context.Background(), "19530")
+	if err != nil {
+		panic(err)
+	}
+	return milvusC, port
+}
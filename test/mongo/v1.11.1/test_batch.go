@@ -46,6 +46,7 @@ func main() {
 	_, err = coll.BulkWrite(context.TODO(), models, opts)
 
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		verifier.VerifyDbAttributes(stubs[0][0], "update", "mongodb", "127.0.0.1", "update", "update", "", nil)
+		verifier.VerifyDbAttributes(stubs[0][0], "BATCH update", "mongodb", "127.0.0.1", "update", "BATCH update", "", nil)
+		verifier.Assert(verifier.GetAttribute(stubs[0][0].Attributes, "db.operation.batch.size").AsInt64() == 2, "Expect batch size to be 2")
 	}, 1)
 }
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	s := runServer()
+	defer s.Shutdown()
+	nc := connect(s)
+	defer nc.Close()
+
+	received := make(chan struct{}, 2)
+	if _, err := nc.Subscribe("orders", func(msg *nats.Msg) {
+		received <- struct{}{}
+	}); err != nil {
+		panic(err)
+	}
+	if _, err := nc.QueueSubscribe("jobs", "workers", func(msg *nats.Msg) {
+		received <- struct{}{}
+	}); err != nil {
+		panic(err)
+	}
+	if _, err := nc.Subscribe("echo", func(msg *nats.Msg) {
+		if err := msg.Respond(msg.Data); err != nil {
+			panic(err)
+		}
+	}); err != nil {
+		panic(err)
+	}
+	if err := nc.Flush(); err != nil {
+		panic(err)
+	}
+
+	if err := nc.Publish("orders", []byte("hello world")); err != nil {
+		panic(err)
+	}
+	<-received
+	time.Sleep(100 * time.Millisecond)
+	if err := nc.Publish("jobs", []byte("hello world")); err != nil {
+		panic(err)
+	}
+	<-received
+	time.Sleep(100 * time.Millisecond)
+	reply, err := nc.Request("echo", []byte("hello world"), 5*time.Second)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(string(reply.Data) == "hello world", "Except the reply to be hello world, got %s", string(reply.Data))
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", "orders", "nats")
+		verifier.VerifyMQConsumeAttributes(stubs[0][1], "", "", "", "process", "orders", "nats")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Except the process span to be the child of the publish span")
+
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", "jobs", "nats")
+		verifier.VerifyMQConsumeAttributes(stubs[1][1], "", "", "", "process", "jobs", "nats")
+		group := verifier.GetAttribute(stubs[1][1].Attributes, "messaging.consumer.group.name").AsString()
+		verifier.Assert(group == "workers", "Except messaging.consumer.group.name to be workers, got %s", group)
+
+		verifier.Assert(stubs[2][0].Name == "echo request", "Except the request span name to be echo request, got %s", stubs[2][0].Name)
+		verifier.Assert(stubs[2][0].SpanKind == trace.SpanKindClient, "Expect to be client span, got %d", stubs[2][0].SpanKind)
+		verifier.Assert(stubs[2][1].Name == "echo process", "Except the handler span name to be echo process, got %s", stubs[2][1].Name)
+		verifier.Assert(stubs[2][1].SpanKind == trace.SpanKindServer, "Expect to be server span, got %d", stubs[2][1].SpanKind)
+		verifier.Assert(stubs[2][1].Parent.SpanID() == stubs[2][0].SpanContext.SpanID(), "Except the server span to be the child of the client span")
+	}, 3)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	s := runServer()
+	defer s.Shutdown()
+	nc := connect(s)
+	defer nc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		panic(err)
+	}
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "ORDERS", Subjects: []string{"orders.>"}})
+	if err != nil {
+		panic(err)
+	}
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{Durable: "processor", AckPolicy: jetstream.AckExplicitPolicy})
+	if err != nil {
+		panic(err)
+	}
+
+	if _, err = js.Publish(ctx, "orders.new", []byte("hello world1")); err != nil {
+		panic(err)
+	}
+	batch, err := consumer.Fetch(1)
+	if err != nil {
+		panic(err)
+	}
+	for msg := range batch.Messages() {
+		if err = msg.Ack(); err != nil {
+			panic(err)
+		}
+	}
+	if err = batch.Error(); err != nil {
+		panic(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if _, err = js.Publish(ctx, "orders.new", []byte("hello world2")); err != nil {
+		panic(err)
+	}
+	consumed := make(chan struct{})
+	cc, err := consumer.Consume(func(msg jetstream.Msg) {
+		if err := msg.Ack(); err != nil {
+			panic(err)
+		}
+		close(consumed)
+	})
+	if err != nil {
+		panic(err)
+	}
+	<-consumed
+	cc.Stop()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", "orders.new", "nats")
+		streamName := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.nats.jetstream.stream").AsString()
+		verifier.Assert(streamName == "ORDERS", "Except messaging.nats.jetstream.stream to be ORDERS, got %s", streamName)
+		sequence := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.nats.jetstream.sequence").AsInt64()
+		verifier.Assert(sequence == 1, "Except messaging.nats.jetstream.sequence to be 1, got %d", sequence)
+
+		verifier.VerifyMQConsumeAttributes(stubs[1][0], "", "", "", "receive", "orders.new", "nats")
+		verifier.Assert(len(stubs[1][0].Links) == 1, "Except the receive span to have 1 link, got %d", len(stubs[1][0].Links))
+		subscription := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.destination.subscription.name").AsString()
+		verifier.Assert(subscription == "processor", "Except messaging.destination.subscription.name to be processor, got %s", subscription)
+
+		verifier.VerifyMQPublishAttributes(stubs[2][0], "", "", "", "publish", "orders.new", "nats")
+		verifier.VerifyMQConsumeAttributes(stubs[2][1], "", "", "", "process", "orders.new", "nats")
+		ack := verifier.GetAttribute(stubs[2][1].Attributes, "messaging.nats.jetstream.ack").AsString()
+		verifier.Assert(ack == "ack", "Except messaging.nats.jetstream.ack to be ack, got %s", ack)
+	}, 3)
+}
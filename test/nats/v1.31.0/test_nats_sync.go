@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	s := runServer()
+	defer s.Shutdown()
+	nc := connect(s)
+	defer nc.Close()
+
+	sub, err := nc.SubscribeSync("sync")
+	if err != nil {
+		panic(err)
+	}
+	if err = nc.Publish("sync", []byte("hello world")); err != nil {
+		panic(err)
+	}
+	if _, err = sub.NextMsg(5 * time.Second); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", "sync", "nats")
+		verifier.VerifyMQConsumeAttributes(stubs[1][0], "", "", "", "receive", "sync", "nats")
+		verifier.Assert(len(stubs[1][0].Links) == 1, "Except the receive span to have 1 link, got %d", len(stubs[1][0].Links))
+		verifier.Assert(stubs[1][0].Links[0].SpanContext.SpanID() == stubs[0][0].SpanContext.SpanID(), "Except the receive span to link the publish span")
+	}, 2)
+}
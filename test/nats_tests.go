@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const nats_dependency_name = "github.com/nats-io/nats.go"
+const nats_module_name = "nats"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_nats_core", nats_module_name, "v1.31.0", "", "1.21", "", TestNatsCore),
+		NewLatestDepthTestCase("test_nats_core", nats_dependency_name, nats_module_name, "v1.31.0", "", "1.21", "", TestNatsCore),
+		NewGeneralTestCase("test_nats_sync", nats_module_name, "v1.31.0", "", "1.21", "", TestNatsSync),
+		NewGeneralTestCase("test_nats_jetstream", nats_module_name, "v1.31.0", "", "1.21", "", TestNatsJetStream))
+}
+
+func TestNatsCore(t *testing.T, env ...string) {
+	UseApp("nats/v1.31.0")
+	RunGoBuild(t, "go", "build", "test_nats_core.go", "base.go")
+	RunApp(t, "test_nats_core", env...)
+}
+
+func TestNatsSync(t *testing.T, env ...string) {
+	UseApp("nats/v1.31.0")
+	RunGoBuild(t, "go", "build", "test_nats_sync.go", "base.go")
+	RunApp(t, "test_nats_sync", env...)
+}
+
+func TestNatsJetStream(t *testing.T, env ...string) {
+	UseApp("nats/v1.31.0")
+	RunGoBuild(t, "go", "build", "test_nats_jetstream.go", "base.go")
+	RunApp(t, "test_nats_jetstream", env...)
+}
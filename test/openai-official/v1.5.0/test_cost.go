@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/shared"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+"id": "chatcmpl-cost-test",
+"object": "chat.completion",
+"created": 1677652288,
+"model": "gpt-4o-2024-08-06",
+"choices": [{
+"index": 0,
+"message": {"role": "assistant", "content": "Hello!"},
+"finish_reason": "stop"
+}],
+"usage": {
+"prompt_tokens": 2000,
+"completion_tokens": 500,
+"total_tokens": 2500,
+"prompt_tokens_details": {"cached_tokens": 1000}
+}
+}`))
+	}))
+	defer mockServer.Close()
+
+	client := openai.NewClient(
+		option.WithAPIKey("test-api-key"),
+		option.WithBaseURL(mockServer.URL),
+	)
+
+	member, err := baggage.NewMember("team", "search")
+	if err != nil {
+		panic(err)
+	}
+	bag, err := baggage.New(member)
+	if err != nil {
+		panic(err)
+	}
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	_, err = client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
+		Model: shared.ChatModelGPT4o,
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.UserMessage("Hello"),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"gen_ai.client.cost": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.client.cost metrics received!")
+			}
+			costs := map[string]float64{}
+			for _, dp := range mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[float64]).DataPoints {
+				tokenType, _ := dp.Attributes.Value("gen_ai.token.type")
+				team, _ := dp.Attributes.Value("gen_ai.cost.team")
+				currency, _ := dp.Attributes.Value("gen_ai.cost.currency")
+				verifier.Assert(team.AsString() == "search", "Expected team to be search, got %s", team.AsString())
+				verifier.Assert(currency.AsString() == "USD", "Expected currency to be USD, got %s", currency.AsString())
+				costs[tokenType.AsString()] = dp.Value
+			}
+			verifier.Assert(len(costs) == 3, "Expected 3 token types, got %v", costs)
+			verifier.Assert(approximately(costs["input"], 0.0025), "Expected input cost to be 0.0025, got %f", costs["input"])
+			verifier.Assert(approximately(costs["cache_read"], 0.00125), "Expected cache_read cost to be 0.00125, got %f", costs["cache_read"])
+			verifier.Assert(approximately(costs["output"], 0.005), "Expected output cost to be 0.005, got %f", costs["output"])
+		},
+		"gen_ai.client.budget.status": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.client.budget.status metrics received!")
+			}
+			dp := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64]).DataPoints[0]
+			name, _ := dp.Attributes.Value("gen_ai.budget.name")
+			verifier.Assert(name.AsString() == "default", "Expected budget name to be default, got %s", name.AsString())
+			verifier.Assert(dp.Value == 1, "Expected budget status to be warning, got %d", dp.Value)
+		},
+		"gen_ai.client.budget.utilization": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No gen_ai.client.budget.utilization metrics received!")
+			}
+			dp := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[float64]).DataPoints[0]
+			verifier.Assert(approximately(dp.Value, 0.875), "Expected budget utilization to be 0.875, got %f", dp.Value)
+		},
+	})
+}
+
+func approximately(actual, expected float64) bool {
+	return actual > expected-1e-9 && actual < expected+1e-9
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/shared"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// Run with OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT=true and
+// OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION=email.
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+"id": "chatcmpl-content-test123",
+"object": "chat.completion",
+"created": 1677652288,
+"model": "gpt-4",
+"choices": [{
+"index": 0,
+"message": {
+"role": "assistant",
+"content": null,
+"tool_calls": [{"id": "call_2", "type": "function", "function": {"name": "send_email", "arguments": "{\"to\":\"jane@example.com\"}"}}]
+},
+"finish_reason": "tool_calls"
+}],
+"usage": {"prompt_tokens": 30, "completion_tokens": 10, "total_tokens": 40}
+}`))
+	}))
+	defer mockServer.Close()
+
+	client := openai.NewClient(
+		option.WithAPIKey("test-api-key"),
+		option.WithBaseURL(mockServer.URL),
+	)
+	_, err := client.Chat.Completions.New(context.Background(), openai.ChatCompletionNewParams{
+		Model: shared.ChatModelGPT4,
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage("You are a mail assistant."),
+			openai.UserMessage("Mail john@example.com the weather in Paris."),
+			openai.ToolMessage("sunny", "call_1"),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := stubs[0][0]
+		verifier.VerifyLLMAttributes(span, "chat", "openai", "gpt-4")
+
+		var input []map[string]any
+		rawInput := verifier.GetAttribute(span.Attributes, "gen_ai.input.messages").AsString()
+		verifier.Assert(json.Unmarshal([]byte(rawInput), &input) == nil, "Expected input messages to be JSON, got %s", rawInput)
+		verifier.Assert(len(input) == 3, "Expected 3 input messages, got %s", rawInput)
+		verifier.Assert(input[0]["role"] == "system" && input[1]["role"] == "user" && input[2]["role"] == "tool", "Unexpected input roles in %s", rawInput)
+		verifier.Assert(strings.Contains(rawInput, `"content":"Mail [REDACTED] the weather in Paris."`), "Expected the email to be redacted, got %s", rawInput)
+		verifier.Assert(strings.Contains(rawInput, `{"type":"tool_call_response","id":"call_1","response":"sunny"}`), "Expected the tool call response, got %s", rawInput)
+
+		rawOutput := verifier.GetAttribute(span.Attributes, "gen_ai.output.messages").AsString()
+		verifier.Assert(rawOutput == `[{"role":"assistant","parts":[{"type":"tool_call","id":"call_2","name":"send_email","arguments":{"to":"[REDACTED]"}}],"finish_reason":"tool_calls"}]`, "Unexpected output messages %s", rawOutput)
+	}, 1)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/shared"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Run with OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT=true.
+func main() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body strings.Builder
+		buf := make([]byte, 4096)
+		for {
+			n, err := r.Body.Read(buf)
+			body.Write(buf[:n])
+			if err != nil {
+				break
+			}
+		}
+		if strings.Contains(body.String(), `"role":"tool"`) {
+			w.Write([]byte(`{
+"id": "chatcmpl-tool-result",
+"object": "chat.completion",
+"created": 1677652288,
+"model": "gpt-4",
+"choices": [{"index": 0, "message": {"role": "assistant", "content": "It is sunny in Paris."}, "finish_reason": "stop"}],
+"usage": {"prompt_tokens": 40, "completion_tokens": 8, "total_tokens": 48}
+}`))
+			return
+		}
+		w.Write([]byte(`{
+"id": "chatcmpl-tool-call",
+"object": "chat.completion",
+"created": 1677652288,
+"model": "gpt-4",
+"choices": [{
+"index": 0,
+"message": {
+"role": "assistant",
+"content": null,
+"tool_calls": [{"id": "call_weather", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}}]
+},
+"finish_reason": "tool_calls"
+}],
+"usage": {"prompt_tokens": 30, "completion_tokens": 10, "total_tokens": 40}
+}`))
+	}))
+	defer mockServer.Close()
+
+	client := openai.NewClient(
+		option.WithAPIKey("test-api-key"),
+		option.WithBaseURL(mockServer.URL),
+	)
+	params := openai.ChatCompletionNewParams{
+		Model: shared.ChatModelGPT4,
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.UserMessage("What is the weather in Paris?"),
+		},
+	}
+	// the tool calls are linked to the generations of the same trace
+	ctx, agent := otel.Tracer("agent").Start(context.Background(), "agent")
+	completion, err := client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	params.Messages = append(params.Messages, completion.Choices[0].Message.ToParam())
+	for _, toolCall := range completion.Choices[0].Message.ToolCalls {
+		params.Messages = append(params.Messages, openai.ToolMessage("sunny", toolCall.ID))
+	}
+	_, err = client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+	agent.End()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var generation, tool *tracetest.SpanStub
+		for i := range stubs[0] {
+			span := &stubs[0][i]
+			switch {
+			case span.Name == "execute_tool":
+				tool = span
+			case verifier.GetAttribute(span.Attributes, "gen_ai.response.id").AsString() == "chatcmpl-tool-call":
+				generation = span
+			}
+		}
+		verifier.Assert(generation != nil && tool != nil, "Expected a generation and an execute_tool span")
+		verifier.VerifyLLMCommonAttributesWithGenAISpanKind(*tool, "execute_tool", "openai", trace.SpanKindInternal, "tool")
+		toolName := verifier.GetAttribute(tool.Attributes, "gen_ai.tool.name").AsString()
+		verifier.Assert(toolName == "get_weather", "Expected gen_ai.tool.name to be get_weather, got %s", toolName)
+		callID := verifier.GetAttribute(tool.Attributes, "gen_ai.tool.call.id").AsString()
+		verifier.Assert(callID == "call_weather", "Expected gen_ai.tool.call.id to be call_weather, got %s", callID)
+		arguments := verifier.GetAttribute(tool.Attributes, "gen_ai.tool.call.arguments").AsString()
+		verifier.Assert(arguments == `{"city":"Paris"}`, "Expected the tool call arguments, got %s", arguments)
+		result := verifier.GetAttribute(tool.Attributes, "gen_ai.tool.call.result").AsString()
+		verifier.Assert(result == `"sunny"`, "Expected the tool call result, got %s", result)
+		verifier.Assert(len(tool.Links) == 1 && tool.Links[0].SpanContext.SpanID() == generation.SpanContext.SpanID(),
+			"Expected the execute_tool span to link to the generation span, got %v", tool.Links)
+	}, 1)
+}
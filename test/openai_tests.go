@@ -30,6 +30,9 @@ func init() {
 	// Official SDK tests (openai-go) - v1.5.0
 	tc4 := NewGeneralTestCase("openai-official-v1-chat-completion-test", openai_official_module_name, "v1.5.0", "", "1.22.0", "", TestOpenAIOfficialSDKV1ChatCompletion)
 	tc5 := NewGeneralTestCase("openai-official-v1-chat-stream-test", openai_official_module_name, "v1.5.0", "", "1.22.0", "", TestOpenAIOfficialSDKV1ChatStream)
+	tc13 := NewGeneralTestCase("openai-official-v1-message-content-test", openai_official_module_name, "v1.5.0", "", "1.22.0", "", TestOpenAIOfficialSDKV1MessageContent)
+	tc14 := NewGeneralTestCase("openai-official-v1-tool-call-test", openai_official_module_name, "v1.5.0", "", "1.22.0", "", TestOpenAIOfficialSDKV1ToolCall)
+	tc15 := NewGeneralTestCase("openai-official-v1-cost-test", openai_official_module_name, "v1.5.0", "", "1.22.0", "", TestOpenAIOfficialSDKV1Cost)
 	tc6 := NewMuzzleTestCase("openai-official-v1-muzzle-test", openai_official_dependency_name, openai_official_module_name, "v1.5.0", "", "1.22.0", "", []string{"go", "build", "test_chat_completion.go"})
 	
 	// Official SDK tests (openai-go) - v2.0.0
@@ -78,6 +81,15 @@ func init() {
 	if tc12 != nil {
 		TestCases = append(TestCases, tc12)
 	}
+	if tc13 != nil {
+		TestCases = append(TestCases, tc13)
+	}
+	if tc14 != nil {
+		TestCases = append(TestCases, tc14)
+	}
+	if tc15 != nil {
+		TestCases = append(TestCases, tc15)
+	}
 }
 
 // Community SDK (sashabaranov/go-openai) tests
@@ -106,6 +118,27 @@ func TestOpenAIOfficialSDKV1ChatStream(t *testing.T, env ...string) {
 	RunApp(t, "./test_chat_completion_stream", env...)
 }
 
+func TestOpenAIOfficialSDKV1MessageContent(t *testing.T, env ...string) {
+	UseApp("openai-official/v1.5.0")
+	RunGoBuild(t, "go", "build", "test_message_content.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT=true", "OTEL_INSTRUMENTATION_GENAI_CONTENT_REDACTION=email")
+	RunApp(t, "./test_message_content", env...)
+}
+
+func TestOpenAIOfficialSDKV1ToolCall(t *testing.T, env ...string) {
+	UseApp("openai-official/v1.5.0")
+	RunGoBuild(t, "go", "build", "test_tool_call.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GENAI_CAPTURE_MESSAGE_CONTENT=true")
+	RunApp(t, "./test_tool_call", env...)
+}
+
+func TestOpenAIOfficialSDKV1Cost(t *testing.T, env ...string) {
+	UseApp("openai-official/v1.5.0")
+	RunGoBuild(t, "go", "build", "test_cost.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GENAI_COST_BUDGET=0.01")
+	RunApp(t, "./test_cost", env...)
+}
+
 // Official SDK (openai/openai-go) tests - v2.0.0
 func TestOpenAIOfficialSDKV2ChatCompletion(t *testing.T, env ...string) {
 	UseApp("openai-official/v2.0.0")
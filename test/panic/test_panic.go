@@ -0,0 +1,38 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+)
+
+func main() {
+	tracer := otel.Tracer("test-tracer")
+	ctx, span := tracer.Start(context.Background(), "panic-request")
+	handle(ctx)
+	span.End()
+}
+
+func handle(ctx context.Context) {
+	_, span := otel.Tracer("test-tracer").Start(ctx, "panic-handler")
+	if span.IsRecording() {
+		// the span is left open, unlike the ones ended by deferred calls
+		panic(errors.New("unrecovered boom"))
+	}
+	span.End()
+}
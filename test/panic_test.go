@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+func TestUnrecoveredPanic(t *testing.T) {
+	UseApp("panic")
+	RunGoBuild(t, "go", "build", "test_panic.go")
+
+	env := []string{
+		"OTEL_TRACES_EXPORTER=console",
+		"OTEL_METRICS_EXPORTER=none",
+		"OTEL_SERVICE_NAME=panic-test",
+		"IN_OTEL_TEST=false", // Use real exporters, the process dies
+	}
+
+	stdout, stderr := RunAppFallible(t, "test_panic", env...)
+	ExpectContains(t, stderr, "unrecovered boom")
+	// the spans left open by the panic are recorded and exported
+	ExpectContains(t, stdout, "panic-handler")
+	ExpectContains(t, stdout, "panic-request")
+	ExpectContains(t, stdout, "exception.stacktrace")
+	ExpectContains(t, stdout, `"Code":"Error"`)
+	ExpectContains(t, stdout, "unrecovered boom")
+}
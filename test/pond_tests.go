@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const pond_dependency_name = "github.com/alitto/pond"
+const pond_module_name = "pond"
+const pondv2_dependency_name = "github.com/alitto/pond/v2"
+const pondv2_module_name = "pondv2"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("pond-submit-test", pond_module_name, "v1.4.0", "", "1.24", "", TestPondSubmit),
+		NewLatestDepthTestCase("pond-submit-latest-depth", pond_dependency_name, pond_module_name, "v1.4.0", "", "1.24", "", TestPondSubmit),
+		NewGeneralTestCase("pondv2-submit-test", pondv2_module_name, "v2.3.1", "", "1.24", "", TestPondV2Submit),
+		NewLatestDepthTestCase("pondv2-submit-latest-depth", pondv2_dependency_name, pondv2_module_name, "v2.3.1", "", "1.24", "", TestPondV2Submit),
+	)
+}
+
+func TestPondSubmit(t *testing.T, env ...string) {
+	UseApp("pond/v1.4.0")
+	RunGoBuild(t, "go", "build", "test_pond_submit.go")
+	RunApp(t, "test_pond_submit", env...)
+}
+
+func TestPondV2Submit(t *testing.T, env ...string) {
+	UseApp("pondv2/v2.3.1")
+	RunGoBuild(t, "go", "build", "test_pondv2_submit.go")
+	RunApp(t, "test_pondv2_submit", env...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/alitto/pond/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var tracer = otel.Tracer("test-tracer")
+
+// task records a span without being given the context of the request
+func task(name string, done chan struct{}) func() {
+	return func() {
+		_, span := tracer.Start(context.Background(), name)
+		span.End()
+		close(done)
+	}
+}
+
+// request submits a task while its span is active, the workers of the pool
+// were started before it
+func request(name string, submit func(func())) {
+	_, span := tracer.Start(context.Background(), name+"-request")
+	done := make(chan struct{})
+	submit(task(name+"-task", done))
+	<-done
+	span.End()
+}
+
+// verifyRequest checks that the task span is a child of the request span
+func verifyRequest(stub tracetest.SpanStubs, name string) {
+	verifier.Assert(len(stub) == 2, "Expect 2 spans in the %s trace, got %d", name, len(stub))
+	verifier.Assert(stub[0].Name == name+"-request", "Expect the %s request span, got %s", name, stub[0].Name)
+	verifier.Assert(stub[1].Name == name+"-task", "Expect the %s task span, got %s", name, stub[1].Name)
+	verifier.Assert(stub[1].Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect the %s task span to be a child of the request span", name)
+}
+
+func main() {
+	pool := pond.NewPool(1)
+	defer pool.StopAndWait()
+
+	// start the worker outside of any span, the workers exit when the queue is
+	// empty, so this one is kept busy until the task of the request is queued
+	release := make(chan struct{})
+	pool.Submit(func() { <-release })
+
+	request("pondv2", func(f func()) {
+		pool.Submit(f)
+		close(release)
+	})
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyRequest(stubs[0], "pondv2")
+	}, 1)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/qdrant/go-client/qdrant"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const collection = "articles"
+
+func verifyVectorSpan(span tracetest.SpanStub, operation string, topK, dimension, rows int64) {
+	verifier.VerifyDbAttributes(span, operation+" "+collection, "qdrant", "", "", operation, collection, nil)
+	actualTopK := verifier.GetAttribute(span.Attributes, "db.vector.query.top_k").AsInt64()
+	verifier.Assert(actualTopK == topK, "Expect top_k to be %d, got %d", topK, actualTopK)
+	actualDimension := verifier.GetAttribute(span.Attributes, "db.vector.dimension").AsInt64()
+	verifier.Assert(actualDimension == dimension, "Expect vector dimension to be %d, got %d", dimension, actualDimension)
+	actualRows := verifier.GetAttribute(span.Attributes, "db.response.returned_rows")
+	if rows < 0 {
+		verifier.Assert(actualRows.Type() == 0, "Expect no returned rows, got %v", actualRows.Emit())
+	} else {
+		verifier.Assert(actualRows.AsInt64() == rows, "Expect returned rows to be %d, got %d", rows, actualRows.AsInt64())
+	}
+}
+
+func main() {
+	ctx := context.Background()
+	port, err := strconv.Atoi(os.Getenv("QDRANT_PORT"))
+	if err != nil {
+		panic(err)
+	}
+	c, err := qdrant.NewClient(&qdrant.Config{Host: "127.0.0.1", Port: port})
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close()
+	if err := c.CreateCollection(ctx, &qdrant.CreateCollection{
+		CollectionName: collection,
+		VectorsConfig:  qdrant.NewVectorsConfig(&qdrant.VectorParams{Size: 4, Distance: qdrant.Distance_Cosine}),
+	}); err != nil {
+		panic(err)
+	}
+	if _, err := c.Upsert(ctx, &qdrant.UpsertPoints{
+		CollectionName: collection,
+		Wait:           qdrant.PtrOf(true),
+		Points: []*qdrant.PointStruct{
+			{Id: qdrant.NewIDNum(1), Vectors: qdrant.NewVectors(0.1, 0.2, 0.3, 0.4), Payload: qdrant.NewValueMap(map[string]any{"title": "go"})},
+			{Id: qdrant.NewIDNum(2), Vectors: qdrant.NewVectors(0.2, 0.3, 0.4, 0.5), Payload: qdrant.NewValueMap(map[string]any{"title": "rust"})},
+			{Id: qdrant.NewIDNum(3), Vectors: qdrant.NewVectors(0.9, 0.8, 0.7, 0.6), Payload: qdrant.NewValueMap(map[string]any{"title": "java"})},
+		},
+	}); err != nil {
+		panic(err)
+	}
+	if _, err := c.Query(ctx, &qdrant.QueryPoints{
+		CollectionName: collection,
+		Query:          qdrant.NewQuery(0.1, 0.2, 0.3, 0.4),
+		Limit:          qdrant.PtrOf(uint64(2)),
+	}); err != nil {
+		panic(err)
+	}
+	if _, err := c.Query(ctx, &qdrant.QueryPoints{
+		CollectionName: collection,
+		Filter:         &qdrant.Filter{Must: []*qdrant.Condition{qdrant.NewMatch("title", "go")}},
+	}); err != nil {
+		panic(err)
+	}
+	if _, err := c.Delete(ctx, &qdrant.DeletePoints{
+		CollectionName: collection,
+		Wait:           qdrant.PtrOf(true),
+		Points:         qdrant.NewPointsSelector(qdrant.NewIDNum(1)),
+	}); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		// the collection management calls only have gRPC spans
+		var vectorTraces []tracetest.SpanStubs
+		for _, stub := range stubs {
+			if verifier.GetAttribute(stub[0].Attributes, "db.system.name").AsString() == "qdrant" {
+				vectorTraces = append(vectorTraces, stub)
+			}
+		}
+		verifier.Assert(len(vectorTraces) == 4, "Expect 4 qdrant traces, got %d", len(vectorTraces))
+		verifyVectorSpan(vectorTraces[0][0], "upsert", 0, 4, -1)
+		verifyVectorSpan(vectorTraces[1][0], "search", 2, 4, 2)
+		verifyVectorSpan(vectorTraces[2][0], "query", 0, 0, 1)
+		verifyVectorSpan(vectorTraces[3][0], "delete", 0, 0, -1)
+		for _, stub := range vectorTraces {
+			verifier.Assert(len(stub) > 1 && stub[1].SpanKind == trace.SpanKindClient, "Expect a nested gRPC client span")
+			verifier.Assert(stub[1].Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect the gRPC span to be a child of the qdrant span")
+		}
+	}, 4)
+}
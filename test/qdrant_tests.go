@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const qdrant_dependency_name = "github.com/qdrant/go-client"
+const qdrant_module_name = "qdrant"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("qdrant-vector-test", qdrant_module_name, "v1.12.0", "", "1.23", "", TestQdrantVector),
+		NewLatestDepthTestCase("qdrant-vector-latestdepth-test", qdrant_dependency_name, qdrant_module_name, "v1.12.0", "", "1.23", "", TestQdrantVector),
+		NewMuzzleTestCase("qdrant-muzzle-test", qdrant_dependency_name, qdrant_module_name, "v1.12.0", "", "1.23", "", []string{"go", "build", "test_qdrant_vector.go"}))
+}
+
+func TestQdrantVector(t *testing.T, env ...string) {
+	_, qdrantPort := initQdrantContainer()
+	UseApp("qdrant/v1.12.0")
+	RunGoBuild(t, "go", "build", "test_qdrant_vector.go")
+	env = append(env, "QDRANT_PORT="+qdrantPort.Port())
+	RunApp(t, "test_qdrant_vector", env...)
+}
+
+func initQdrantContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "qdrant/qdrant:v1.12.0",
+		ExposedPorts: []string{"6334/tcp"},
+		WaitingFor:   wait.ForListeningPort("6334/tcp"),
+	}
+	qdrantC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := qdrantC.MappedPort(context.Background(), "6334")
+	if err != nil {
+		panic(err)
+	}
+	return qdrantC, port
+}
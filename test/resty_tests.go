@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const resty_dependency_name = "github.com/go-resty/resty/v2"
+const resty_module_name = "resty"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_resty_retry", resty_module_name, "v2.7.0", "", "1.23", "", TestRestyRetry),
+		NewLatestDepthTestCase("test_resty_retry", resty_dependency_name, resty_module_name, "v2.7.0", "", "1.23", "", TestRestyRetry))
+}
+
+func TestRestyRetry(t *testing.T, env ...string) {
+	UseApp("resty/v2.7.0")
+	RunGoBuild(t, "go", "build", "test_resty_retry.go", "base.go")
+	RunApp(t, "test_resty_retry", env...)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	server := newFlakyServer(2)
+	defer server.Close()
+	client := retryablehttp.NewClient()
+	client.RetryMax = 3
+	client.RetryWaitMin = 10 * time.Millisecond
+	client.RetryWaitMax = 20 * time.Millisecond
+	client.Logger = nil
+	resp, err := client.Get(server.URL + "/retry")
+	if err != nil {
+		panic(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		panic("unexpected status " + resp.Status)
+	}
+	client.Backoff = retryablehttp.LinearJitterBackoff
+	resp, err = client.Get(server.URL + "/linear")
+	if err != nil {
+		panic(err)
+	}
+	_ = resp.Body.Close()
+	// falls back to LinearJitterBackoff, the wait is recorded once
+	client.Backoff = retryablehttp.RateLimitLinearJitterBackoff
+	resp, err = client.Get(server.URL + "/ratelimit")
+	if err != nil {
+		panic(err)
+	}
+	_ = resp.Body.Close()
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyRetriedRequest(stubs[0], server.URL+"/retry", 3)
+		verifyRetriedRequest(stubs[1], server.URL+"/linear", 3)
+		verifyRetriedRequest(stubs[2], server.URL+"/ratelimit", 3)
+	}, 3)
+}
+
+func verifyRetriedRequest(stubs tracetest.SpanStubs, url string, attempts int) {
+	logical := stubs[0]
+	verifier.Assert(logical.SpanKind == trace.SpanKindInternal, "Expect to be internal span, got %d", logical.SpanKind)
+	verifier.Assert(logical.Name == "GET", "Expect span name to be GET, got %s", logical.Name)
+	verifier.Assert(verifier.GetAttribute(logical.Attributes, "url.full").AsString() == url, "Expect url.full to be %s", url)
+	verifier.Assert(verifier.GetAttribute(logical.Attributes, "http.response.status_code").AsInt64() == http.StatusOK, "Expect status code to be 200")
+	verifier.Assert(len(logical.Events) == attempts-1, "Expect %d backoff events, got %d", attempts-1, len(logical.Events))
+	for i, event := range logical.Events {
+		verifier.Assert(event.Name == "http.request.backoff", "Expect backoff event, got %s", event.Name)
+		resendCount := verifier.GetAttribute(event.Attributes, "http.request.resend_count").AsInt64()
+		verifier.Assert(resendCount == int64(i+1), "Expect backoff before resend %d, got %d", i+1, resendCount)
+		duration := verifier.GetAttribute(event.Attributes, "http.request.backoff.duration").AsFloat64()
+		verifier.Assert(duration > 0, "Expect backoff duration to be positive, got %f", duration)
+	}
+	resendCount := int64(0)
+	for _, stub := range stubs[1:] {
+		if stub.SpanKind != trace.SpanKindClient {
+			continue
+		}
+		verifier.Assert(stub.Parent.SpanID() == logical.SpanContext.SpanID(), "Expect attempt to be the child of the logical request")
+		// the first attempt has no resend count
+		actual := verifier.GetAttribute(stub.Attributes, "http.request.resend_count").AsInt64()
+		verifier.Assert(actual == resendCount, "Expect resend count to be %d, got %d", resendCount, actual)
+		resendCount++
+	}
+	verifier.Assert(resendCount == int64(attempts), "Expect %d attempts, got %d", attempts, resendCount)
+}
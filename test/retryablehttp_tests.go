@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const retryablehttp_dependency_name = "github.com/hashicorp/go-retryablehttp"
+const retryablehttp_module_name = "retryablehttp"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_retryablehttp_retry", retryablehttp_module_name, "v0.7.8", "", "1.23", "", TestRetryableHttpRetry),
+		NewLatestDepthTestCase("test_retryablehttp_retry", retryablehttp_dependency_name, retryablehttp_module_name, "v0.7.8", "", "1.23", "", TestRetryableHttpRetry))
+}
+
+func TestRetryableHttpRetry(t *testing.T, env ...string) {
+	UseApp("retryablehttp/v0.7.8")
+	RunGoBuild(t, "go", "build", "test_retryablehttp_retry.go", "base.go")
+	RunApp(t, "test_retryablehttp_retry", env...)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+
+	"github.com/IBM/sarama"
+)
+
+const topicName = "my-topic"
+
+// reporter reports the errors of the mock broker outside of go test.
+type reporter struct{}
+
+func (reporter) Error(args ...interface{}) {
+	log.Println(args...)
+}
+
+func (reporter) Errorf(format string, args ...interface{}) {
+	log.Printf(format, args...)
+}
+
+func (reporter) Fatal(args ...interface{}) {
+	log.Fatal(args...)
+}
+
+func (reporter) Fatalf(format string, args ...interface{}) {
+	log.Fatalf(format, args...)
+}
+
+func (reporter) Helper() {}
+
+func newConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.Retry.Max = 0
+	config.Consumer.Return.Errors = true
+	config.Consumer.Offsets.AutoCommit.Enable = false
+	return config
+}
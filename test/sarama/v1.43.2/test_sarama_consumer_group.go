@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	groupName   = "my-group"
+	traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceparent = "00-" + traceID + "-00f067aa0ba902b7-01"
+	producerID  = "00f067aa0ba902b7"
+	numMessages = 2
+)
+
+type handler struct {
+	cancel context.CancelFunc
+	// ready is when the handler starts waiting for the messages
+	ready time.Time
+	// headers are the traceparent headers of the messages seen by the handler
+	headers []string
+}
+
+func (h *handler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *handler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *handler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	// the message waits for the handler meanwhile
+	time.Sleep(200 * time.Millisecond)
+	h.ready = time.Now()
+	for msg := range claim.Messages() {
+		carrier := propagation.MapCarrier{}
+		for _, header := range msg.Headers {
+			carrier[string(header.Key)] = string(header.Value)
+		}
+		h.headers = append(h.headers, carrier["traceparent"])
+		// the handler continues the trace of the process span from the message
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+		_, span := otel.Tracer("handler").Start(ctx, "handle")
+		time.Sleep(50 * time.Millisecond)
+		span.End()
+		session.MarkMessage(msg, "")
+		if len(h.headers) == numMessages {
+			h.cancel()
+			break
+		}
+	}
+	return nil
+}
+
+// newFetchResponse returns a fetch response whose messages carry the context
+// of an upstream producer.
+func newFetchResponse() *sarama.FetchResponse {
+	response := &sarama.FetchResponse{Version: 8}
+	for i := 0; i < numMessages; i++ {
+		response.AddRecord(topicName, 0, nil, sarama.StringEncoder("hello world"), int64(i))
+	}
+	block := response.GetBlock(topicName, 0)
+	block.HighWaterMarkOffset = numMessages
+	for _, record := range block.RecordsSet[0].RecordBatch.Records {
+		record.Headers = []*sarama.RecordHeader{
+			{Key: []byte("traceparent"), Value: []byte(traceparent)},
+		}
+	}
+	return response
+}
+
+func main() {
+	broker := sarama.NewMockBroker(reporter{}, 0)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(reporter{}).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topicName, 0, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(reporter{}).
+			SetOffset(topicName, 0, sarama.OffsetOldest, 0).
+			SetOffset(topicName, 0, sarama.OffsetNewest, numMessages),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(reporter{}).
+			SetCoordinator(sarama.CoordinatorGroup, groupName, broker),
+		"HeartbeatRequest": sarama.NewMockHeartbeatResponse(reporter{}),
+		"JoinGroupRequest": sarama.NewMockJoinGroupResponse(reporter{}).
+			SetGroupProtocol(sarama.RangeBalanceStrategyName),
+		"SyncGroupRequest": sarama.NewMockSyncGroupResponse(reporter{}).SetMemberAssignment(
+			&sarama.ConsumerGroupMemberAssignment{
+				Version: 0,
+				Topics:  map[string][]int32{topicName: {0}},
+			}),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(reporter{}).
+			SetOffset(groupName, topicName, 0, 0, "", sarama.ErrNoError).
+			SetError(sarama.ErrNoError),
+		"LeaveGroupRequest": sarama.NewMockLeaveGroupResponse(reporter{}),
+		"FetchRequest": sarama.NewMockSequence(
+			sarama.NewMockWrapper(newFetchResponse()),
+			sarama.NewMockFetchResponse(reporter{}, numMessages),
+		),
+	})
+
+	group, err := sarama.NewConsumerGroup([]string{broker.Addr()}, groupName, newConfig())
+	if err != nil {
+		panic(err)
+	}
+	defer group.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &handler{cancel: cancel}
+	if err = group.Consume(ctx, []string{topicName}, h); err != nil {
+		panic(err)
+	}
+	verifier.Assert(len(h.headers) == numMessages, "Except the handler to take %d messages, got %d", numMessages, len(h.headers))
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		receive := stubs[0][0]
+		verifier.VerifyMQConsumeAttributes(receive, "", "", "", "receive", topicName, "kafka")
+		verifier.Assert(len(receive.Links) == numMessages, "Except receive span to have %d links, got %d", numMessages, len(receive.Links))
+		verifier.Assert(receive.Links[0].SpanContext.TraceID().String() == traceID, "Except receive span to link to the producer")
+		verifier.Assert(len(stubs[1]) == 2*numMessages, "Except a process and a handle span per message, got %d spans", len(stubs[1]))
+		ready := h.ready
+		for i := 0; i < numMessages; i++ {
+			process, handle := stubs[1][2*i], stubs[1][2*i+1]
+			verifier.VerifyMQConsumeAttributes(process, "", "", "", "process", topicName, "kafka")
+			verifier.Assert(process.SpanContext.TraceID().String() == traceID, "Except process span to continue the producer trace")
+			verifier.Assert(process.Parent.SpanID().String() == producerID, "Except process span to be a child of the producer")
+			verifier.Assert(!process.StartTime.Before(ready), "Except process span to start when the handler takes the message")
+			group := verifier.GetAttribute(process.Attributes, "messaging.consumer.group.name").AsString()
+			verifier.Assert(group == groupName, "Except messaging.consumer.group.name to be %s, got %s", groupName, group)
+			verifier.Assert(handle.Name == "handle", "Except the handle span, got %s", handle.Name)
+			verifier.Assert(handle.Parent.SpanID() == process.SpanContext.SpanID(), "Except handle span to be a child of the process span")
+			verifier.Assert(!process.EndTime.Before(handle.EndTime), "Except process span to end after the message is handled")
+			verifier.Assert(h.headers[i] != traceparent, "Except the message to carry the context of the process span")
+			ready = process.EndTime
+		}
+	}, 2)
+}
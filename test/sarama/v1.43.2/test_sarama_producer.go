@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func hasTraceparent(msg *sarama.ProducerMessage) bool {
+	for _, header := range msg.Headers {
+		if string(header.Key) == "traceparent" {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	broker := sarama.NewMockBroker(reporter{}, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(reporter{}).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topicName, 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(reporter{}),
+	})
+
+	syncProducer, err := sarama.NewSyncProducer([]string{broker.Addr()}, newConfig())
+	if err != nil {
+		panic(err)
+	}
+	syncMsg := &sarama.ProducerMessage{Topic: topicName, Key: sarama.StringEncoder("sync"), Value: sarama.StringEncoder("hello world1")}
+	if _, _, err = syncProducer.SendMessage(syncMsg); err != nil {
+		panic(err)
+	}
+	if err = syncProducer.Close(); err != nil {
+		panic(err)
+	}
+
+	asyncProducer, err := sarama.NewAsyncProducer([]string{broker.Addr()}, newConfig())
+	if err != nil {
+		panic(err)
+	}
+	asyncMsg := &sarama.ProducerMessage{Topic: topicName, Key: sarama.StringEncoder("async"), Value: sarama.StringEncoder("hello world2")}
+	asyncProducer.Input() <- asyncMsg
+	select {
+	case <-asyncProducer.Successes():
+	case pErr := <-asyncProducer.Errors():
+		panic(pErr.Err)
+	}
+	if err = asyncProducer.Close(); err != nil {
+		panic(err)
+	}
+
+	if !hasTraceparent(syncMsg) || !hasTraceparent(asyncMsg) {
+		panic("traceparent header should be injected into the produced messages")
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", topicName, "kafka")
+		key := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.kafka.message.key").AsString()
+		verifier.Assert(key == "async", "Except messaging.kafka.message.key to be async, got %s", key)
+	}, 2)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const sarama_dependency_name = "github.com/IBM/sarama"
+const sarama_module_name = "sarama"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_sarama_producer", sarama_module_name, "v1.43.2", "", "1.21", "", TestSaramaProducer),
+		NewLatestDepthTestCase("test_sarama_producer", sarama_dependency_name, sarama_module_name, "v1.43.2", "", "1.21", "", TestSaramaProducer),
+		NewGeneralTestCase("test_sarama_consumer_group", sarama_module_name, "v1.43.2", "", "1.21", "", TestSaramaConsumerGroup))
+}
+
+func TestSaramaProducer(t *testing.T, env ...string) {
+	UseApp("sarama/v1.43.2")
+	RunGoBuild(t, "go", "build", "test_sarama_producer.go", "base.go")
+	RunApp(t, "test_sarama_producer", env...)
+}
+
+func TestSaramaConsumerGroup(t *testing.T, env ...string) {
+	UseApp("sarama/v1.43.2")
+	RunGoBuild(t, "go", "build", "test_sarama_consumer_group.go", "base.go")
+	RunApp(t, "test_sarama_consumer_group", env...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"net"
+
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// pipeServerTransport is an in-memory server transport, every client dials
+// a new pipe.
+type pipeServerTransport struct {
+	conns chan net.Conn
+}
+
+func newPipeServerTransport() *pipeServerTransport {
+	return &pipeServerTransport{conns: make(chan net.Conn)}
+}
+
+func (t *pipeServerTransport) Listen() error {
+	return nil
+}
+
+func (t *pipeServerTransport) Accept() (thrift.TTransport, error) {
+	conn, ok := <-t.conns
+	if !ok {
+		return nil, errors.New("pipe server transport closed")
+	}
+	return thrift.NewTSocketFromConnConf(conn, nil), nil
+}
+
+func (t *pipeServerTransport) Close() error {
+	return nil
+}
+
+func (t *pipeServerTransport) Interrupt() error {
+	return nil
+}
+
+func (t *pipeServerTransport) dial() thrift.TTransport {
+	client, server := net.Pipe()
+	t.conns <- server
+	return thrift.NewTSocketFromConnConf(client, nil)
+}
+
+func setupThrift(transport *pipeServerTransport) {
+	conf := &thrift.TConfiguration{}
+	server := thrift.NewTSimpleServer4(NewEchoProcessor(echoHandler{}), transport,
+		thrift.NewTTransportFactory(), thrift.NewTHeaderProtocolFactoryConf(conf))
+	if err := server.Serve(); err != nil {
+		panic(err)
+	}
+}
+
+func newEchoClient(transport *pipeServerTransport) *EchoClient {
+	protocol := thrift.NewTHeaderProtocolConf(transport.dial(), &thrift.TConfiguration{})
+	return NewEchoClient(thrift.NewTStandardClient(protocol, protocol))
+}
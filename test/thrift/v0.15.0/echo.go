@@ -0,0 +1,201 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// the code below is what the thrift compiler generates for
+//
+//	service Echo {
+//	    string echo(1: string msg)
+//	}
+
+type EchoHandler interface {
+	Echo(ctx context.Context, msg string) (string, error)
+}
+
+type EchoEchoArgs struct {
+	Msg string
+}
+
+func (p *EchoEchoArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	return readStringStruct(ctx, iprot, 1, &p.Msg)
+}
+
+func (p *EchoEchoArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	return writeStringStruct(ctx, oprot, "echo_args", "msg", 1, p.Msg)
+}
+
+type EchoEchoResult struct {
+	Success string
+}
+
+func (p *EchoEchoResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	return readStringStruct(ctx, iprot, 0, &p.Success)
+}
+
+func (p *EchoEchoResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	return writeStringStruct(ctx, oprot, "echo_result", "success", 0, p.Success)
+}
+
+func readStringStruct(ctx context.Context, iprot thrift.TProtocol, id int16, value *string) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return err
+	}
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return err
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if fieldId == id && fieldTypeId == thrift.STRING {
+			if *value, err = iprot.ReadString(ctx); err != nil {
+				return err
+			}
+		} else if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	return iprot.ReadStructEnd(ctx)
+}
+
+func writeStringStruct(ctx context.Context, oprot thrift.TProtocol, name, field string, id int16, value string) error {
+	if err := oprot.WriteStructBegin(ctx, name); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldBegin(ctx, field, thrift.STRING, id); err != nil {
+		return err
+	}
+	if err := oprot.WriteString(ctx, value); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return err
+	}
+	return oprot.WriteStructEnd(ctx)
+}
+
+type EchoClient struct {
+	c thrift.TClient
+}
+
+func NewEchoClient(c thrift.TClient) *EchoClient {
+	return &EchoClient{c: c}
+}
+
+func (p *EchoClient) Echo(ctx context.Context, msg string) (string, error) {
+	args := EchoEchoArgs{Msg: msg}
+	var result EchoEchoResult
+	if _, err := p.c.Call(ctx, "echo", &args, &result); err != nil {
+		return "", err
+	}
+	return result.Success, nil
+}
+
+type EchoProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+}
+
+func NewEchoProcessor(handler EchoHandler) *EchoProcessor {
+	p := &EchoProcessor{processorMap: make(map[string]thrift.TProcessorFunction)}
+	p.processorMap["echo"] = &echoProcessorEcho{handler: handler}
+	return p
+}
+
+func (p *EchoProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *EchoProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func (p *EchoProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (bool, thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin(ctx)
+	if err != nil {
+		return false, thrift.WrapTException(err)
+	}
+	if processor, ok := p.processorMap[name]; ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(ctx, thrift.STRUCT)
+	iprot.ReadMessageEnd(ctx)
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	writeException(ctx, oprot, name, seqId, x)
+	return false, x
+}
+
+type echoProcessorEcho struct {
+	handler EchoHandler
+}
+
+func (p *echoProcessorEcho) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (bool, thrift.TException) {
+	args := EchoEchoArgs{}
+	if err := args.Read(ctx, iprot); err != nil {
+		iprot.ReadMessageEnd(ctx)
+		writeException(ctx, oprot, "echo", seqId, thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error()))
+		return false, thrift.WrapTException(err)
+	}
+	iprot.ReadMessageEnd(ctx)
+	result := EchoEchoResult{}
+	var err error
+	if result.Success, err = p.handler.Echo(ctx, args.Msg); err != nil {
+		writeException(ctx, oprot, "echo", seqId, thrift.NewTApplicationException(thrift.INTERNAL_ERROR, err.Error()))
+		return true, thrift.WrapTException(err)
+	}
+	if err := oprot.WriteMessageBegin(ctx, "echo", thrift.REPLY, seqId); err != nil {
+		return false, thrift.WrapTException(err)
+	}
+	if err := result.Write(ctx, oprot); err != nil {
+		return false, thrift.WrapTException(err)
+	}
+	if err := oprot.WriteMessageEnd(ctx); err != nil {
+		return false, thrift.WrapTException(err)
+	}
+	if err := oprot.Flush(ctx); err != nil {
+		return false, thrift.WrapTException(err)
+	}
+	return true, nil
+}
+
+func writeException(ctx context.Context, oprot thrift.TProtocol, name string, seqId int32, x thrift.TApplicationException) {
+	oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
+	x.Write(ctx, oprot)
+	oprot.WriteMessageEnd(ctx)
+	oprot.Flush(ctx)
+}
+
+type echoHandler struct {
+}
+
+func (h echoHandler) Echo(ctx context.Context, msg string) (string, error) {
+	if msg == "" {
+		return "", errors.New("nothing to echo")
+	}
+	return msg, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	transport := newPipeServerTransport()
+	go setupThrift(transport)
+	time.Sleep(time.Second)
+	client := newEchoClient(transport)
+	if _, err := client.Echo(context.Background(), "thrift"); err != nil {
+		panic(err)
+	}
+	if _, err := client.Echo(context.Background(), ""); err == nil {
+		panic("expect the echo of nothing to fail")
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, stub := range stubs {
+			verifier.VerifyRpcClientAttributes(stub[0], "Echo/echo", "apache_thrift", "Echo", "echo")
+			verifier.VerifyRpcServerAttributes(stub[1], "Echo/echo", "apache_thrift", "Echo", "echo")
+			// the server runs in its own goroutine, the context comes from the THeader headers
+			verifier.Assert(stub[1].Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect the server span to be a child of the client span")
+		}
+		verifier.Assert(stubs[0][0].Status.Code != codes.Error, "Expect the client span to succeed")
+		verifier.Assert(stubs[1][0].Status.Code == codes.Error, "Expect the client span to fail")
+		verifier.Assert(stubs[1][1].Status.Code == codes.Error, "Expect the server span to fail")
+	}, 2)
+}
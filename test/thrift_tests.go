@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const thrift_dependency_name = "github.com/apache/thrift"
+const thrift_module_name = "thrift"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("test_thrift", thrift_module_name, "v0.15.0", "", "1.23", "", TestThrift),
+		NewLatestDepthTestCase("test_thrift", thrift_dependency_name, thrift_module_name, "v0.15.0", "", "1.23", "", TestThrift))
+}
+
+func TestThrift(t *testing.T, env ...string) {
+	UseApp("thrift/v0.15.0")
+	RunGoBuild(t, "go", "build", "test_thrift.go", "base.go", "echo.go")
+	RunApp(t, "test_thrift", env...)
+}
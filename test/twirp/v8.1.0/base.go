@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+)
+
+const haberdasherServiceName = "twirp.example.haberdasher.Haberdasher"
+
+type haberdasher struct{}
+
+// MakeHat fails with a twirp error for the sizes out of range, and with a
+// plain error for the size 42 which twirp reports as an internal error
+func (haberdasher) MakeHat(ctx context.Context, size *Size) (*Hat, error) {
+	switch {
+	case size.Inches <= 0:
+		return nil, twirp.InvalidArgumentError("inches", "I can't make a hat that small!")
+	case size.Inches > 100:
+		return nil, twirp.NotFoundError("no hat that big")
+	case size.Inches == 42:
+		return nil, errors.New("out of fabric")
+	}
+	return &Hat{Inches: size.Inches, Color: "red"}, nil
+}
+
+func setupTwirp() {
+	if err := http.ListenAndServe("127.0.0.1:8080", NewHaberdasherServer(haberdasher{})); err != nil {
+		panic(err)
+	}
+}
+
+func makeHat(ctx context.Context, inches int32) error {
+	client := NewHaberdasherProtobufClient("http://127.0.0.1:8080", &http.Client{})
+	_, err := client.MakeHat(ctx, &Size{Inches: inches})
+	return err
+}
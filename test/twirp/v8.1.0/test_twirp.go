@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	go setupTwirp()
+	time.Sleep(3 * time.Second)
+	if err := makeHat(context.Background(), 12); err != nil {
+		panic(err)
+	}
+	// the codes of the twirp errors are the error types of both spans
+	errorCodes := []twirp.ErrorCode{twirp.InvalidArgument, twirp.NotFound, twirp.Internal}
+	for i, inches := range []int32{0, 101, 42} {
+		err := makeHat(context.Background(), inches)
+		if twerr, ok := err.(twirp.Error); !ok || twerr.Code() != errorCodes[i] {
+			panic(err)
+		}
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		// every twirp call is carried by a pair of net/http spans
+		verifier.Assert(len(stubs[0]) == 4, "Expect 4 spans, got %d", len(stubs[0]))
+		verifier.VerifyRpcClientAttributes(stubs[0][0], haberdasherServiceName+"/MakeHat", "twirp", haberdasherServiceName, "MakeHat")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the http client span to be a child of the twirp client span")
+		verifier.VerifyRpcServerAttributes(stubs[0][3], haberdasherServiceName+"/MakeHat", "twirp", haberdasherServiceName, "MakeHat")
+		verifier.Assert(stubs[0][3].Parent.SpanID() == stubs[0][2].SpanContext.SpanID(), "Expect the twirp server span to be a child of the http server span")
+		for _, span := range []tracetest.SpanStub{stubs[0][0], stubs[0][3]} {
+			verifier.Assert(span.Status.Code != codes.Error, "Expect %s not to fail", span.Name)
+			verifier.Assert(verifier.GetAttribute(span.Attributes, "error.type").AsString() == "", "Expect no error type on %s", span.Name)
+		}
+
+		for i, code := range errorCodes {
+			trace := stubs[i+1]
+			verifier.Assert(len(trace) == 4, "Expect 4 spans, got %d", len(trace))
+			for _, span := range []tracetest.SpanStub{trace[0], trace[3]} {
+				verifier.Assert(verifier.GetAttribute(span.Attributes, "rpc.system").AsString() == "twirp", "Expect rpc system to be twirp, got %s", verifier.GetAttribute(span.Attributes, "rpc.system").AsString())
+				errorType := verifier.GetAttribute(span.Attributes, "error.type").AsString()
+				verifier.Assert(errorType == string(code), "Expect error type to be %s, got %s", code, errorType)
+				verifier.Assert(span.Status.Code == codes.Error, "Expect %s to fail", span.Name)
+			}
+		}
+	}, 4)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const twirp_dependency_name = "github.com/twitchtv/twirp"
+const twirp_module_name = "twirp"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("twirp-test", twirp_module_name, "v8.1.0", "", "1.24", "", TestTwirp),
+		NewLatestDepthTestCase("twirp-latest-depth", twirp_dependency_name, twirp_module_name, "v8.1.0", "", "1.24", "", TestTwirp),
+	)
+}
+
+func TestTwirp(t *testing.T, env ...string) {
+	UseApp("twirp/v8.1.0")
+	RunGoBuild(t, "go", "build", "test_twirp.go", "base.go", "haberdasher.pb.go", "haberdasher.twirp.go")
+	RunApp(t, "test_twirp", env...)
+}
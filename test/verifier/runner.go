@@ -105,6 +105,8 @@ func filterMetricByName(data metricdata.ResourceMetrics, name string) (metricdat
 			index++
 		}
 	}
+	// drop the scopes left behind by the compaction above
+	data.ScopeMetrics = data.ScopeMetrics[:index]
 	return data, nil
 }
 
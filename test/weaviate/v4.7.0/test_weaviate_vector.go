@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const articleID = "36ddd591-2dee-4e7e-a3cc-eb86d30a4303"
+
+func verifyVectorSpan(span tracetest.SpanStub, operation string, topK, dimension, rows int64) {
+	verifier.VerifyDbAttributes(span, operation+" Article", "weaviate", "", "", operation, "Article", nil)
+	actualTopK := verifier.GetAttribute(span.Attributes, "db.vector.query.top_k").AsInt64()
+	verifier.Assert(actualTopK == topK, "Expect top_k to be %d, got %d", topK, actualTopK)
+	actualDimension := verifier.GetAttribute(span.Attributes, "db.vector.dimension").AsInt64()
+	verifier.Assert(actualDimension == dimension, "Expect vector dimension to be %d, got %d", dimension, actualDimension)
+	actualRows := verifier.GetAttribute(span.Attributes, "db.response.returned_rows")
+	if rows < 0 {
+		verifier.Assert(actualRows.Type() == 0, "Expect no returned rows, got %v", actualRows.Emit())
+	} else {
+		verifier.Assert(actualRows.AsInt64() == rows, "Expect returned rows to be %d, got %d", rows, actualRows.AsInt64())
+	}
+}
+
+func main() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v1/meta":
+			w.Write([]byte(`{"version":"1.18.2"}`))
+		case r.URL.Path == "/v1/objects" && r.Method == http.MethodPost:
+			w.Write([]byte(`{"class":"Article","id":"` + articleID + `"}`))
+		case r.URL.Path == "/v1/batch/objects" && r.Method == http.MethodPost:
+			w.Write([]byte(`[{"class":"Article","id":"` + articleID + `","result":{}}]`))
+		case r.URL.Path == "/v1/graphql":
+			w.Write([]byte(`{"data":{"Get":{"Article":[{"title":"first"},{"title":"second"}]}}}`))
+		case strings.HasPrefix(r.URL.Path, "/v1/objects/") && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := weaviate.New(weaviate.Config{Host: strings.TrimPrefix(server.URL, "http://"), Scheme: "http"})
+	ctx := context.Background()
+	vector := []float32{0.1, 0.2, 0.3, 0.4}
+
+	if _, err := client.Data().Creator().WithClassName("Article").WithID(articleID).
+		WithProperties(map[string]interface{}{"title": "first"}).WithVector(vector).Do(ctx); err != nil {
+		panic(err)
+	}
+	if _, err := client.Batch().ObjectsBatcher().WithObjects(&models.Object{
+		Class: "Article", Properties: map[string]interface{}{"title": "second"}, Vector: vector,
+	}).Do(ctx); err != nil {
+		panic(err)
+	}
+	if _, err := client.GraphQL().Get().WithClassName("Article").WithFields(graphql.Field{Name: "title"}).
+		WithNearVector(client.GraphQL().NearVectorArgBuilder().WithVector(vector)).WithLimit(2).Do(ctx); err != nil {
+		panic(err)
+	}
+	if err := client.Data().Deleter().WithClassName("Article").WithID(articleID).Do(ctx); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		// the version lookup of the client is not a vector operation
+		var vectorTraces []tracetest.SpanStubs
+		for _, stub := range stubs {
+			if verifier.GetAttribute(stub[0].Attributes, "db.system.name").AsString() == "weaviate" {
+				vectorTraces = append(vectorTraces, stub)
+			}
+		}
+		verifier.Assert(len(vectorTraces) == 4, "Expect 4 weaviate traces, got %d", len(vectorTraces))
+		verifyVectorSpan(vectorTraces[0][0], "insert", 0, 4, -1)
+		verifyVectorSpan(vectorTraces[1][0], "upsert", 0, 4, -1)
+		verifyVectorSpan(vectorTraces[2][0], "search", 2, 4, 2)
+		verifyVectorSpan(vectorTraces[3][0], "delete", 0, 0, -1)
+		for _, stub := range vectorTraces {
+			// the REST call is sent inside the weaviate operation
+			verifier.Assert(len(stub) > 1 && stub[1].SpanKind == trace.SpanKindClient, "Expect a nested http client span")
+			verifier.Assert(stub[1].Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect the http span to be a child of the weaviate span")
+		}
+	}, 5)
+}
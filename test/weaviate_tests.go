@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const weaviate_dependency_name = "github.com/weaviate/weaviate-go-client/v4"
+const weaviate_module_name = "weaviate"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("weaviate-vector-test", weaviate_module_name, "v4.7.0", "", "1.23", "", TestWeaviateVector),
+		NewLatestDepthTestCase("weaviate-vector-latestdepth-test", weaviate_dependency_name, weaviate_module_name, "v4.7.0", "", "1.23", "", TestWeaviateVector),
+		NewMuzzleTestCase("weaviate-muzzle-test", weaviate_dependency_name, weaviate_module_name, "v4.7.0", "", "1.23", "", []string{"go", "build", "test_weaviate_vector.go"}))
+}
+
+func TestWeaviateVector(t *testing.T, env ...string) {
+	UseApp("weaviate/v4.7.0")
+	RunGoBuild(t, "go", "build", "test_weaviate_vector.go")
+	RunApp(t, "test_weaviate_vector", env...)
+}
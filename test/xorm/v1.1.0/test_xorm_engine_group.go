@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+
+	"github.com/alibaba/loongsuite-go-agent/test/verifier"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	_ "modernc.org/sqlite"
+	"xorm.io/xorm"
+)
+
+type User struct {
+	Id   int64
+	Name string
+	Age  int
+}
+
+func createAndInsert(engine *xorm.Engine) {
+	session := engine.NewSession()
+	defer session.Close()
+	if err := session.CreateTable(&User{}); err != nil {
+		log.Printf("%v", err)
+	}
+	if _, err := engine.Insert(&User{Name: "opentelemetry", Age: 18}); err != nil {
+		log.Printf("%v", err)
+	}
+}
+
+func main() {
+	// NewEngineWithParams and NewEngineGroup create their engines with
+	// NewEngine, each statement must still be traced once
+	engine, err := xorm.NewEngineWithParams("sqlite", "file:xorm_params?mode=memory&cache=shared", map[string]string{})
+	if err != nil {
+		panic(err)
+	}
+	defer engine.Close()
+	createAndInsert(engine)
+	group, err := xorm.NewEngineGroup("sqlite", []string{"file:xorm_master?mode=memory&cache=shared", "file:xorm_slave?mode=memory&cache=shared"})
+	if err != nil {
+		panic(err)
+	}
+	defer group.Close()
+	createAndInsert(group.Master())
+	// a group of engines already hooked
+	regrouped, err := xorm.NewEngineGroup(group.Master(), group.Slaves())
+	if err != nil {
+		panic(err)
+	}
+	if _, err := regrouped.Insert(&User{Name: "loongsuite", Age: 1}); err != nil {
+		log.Printf("%v", err)
+	}
+	expected := []string{"CREATE TABLE", "INSERT", "CREATE TABLE", "INSERT", "INSERT"}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for i, operation := range expected {
+			span := stubs[i][0]
+			verifier.Assert(span.SpanKind == trace.SpanKindInternal, "Expect to be internal span, got %d", span.SpanKind)
+			verifier.Assert(span.Name == operation+" user", "Expect span name to be %s user, got %s", operation, span.Name)
+			verifier.Assert(len(stubs[i]) == 2, "Expect a single xorm span wrapping a database/sql span, got %d spans", len(stubs[i]))
+			verifier.Assert(stubs[i][1].SpanKind == trace.SpanKindClient, "Expect to be client span, got %d", stubs[i][1].SpanKind)
+			verifier.Assert(stubs[i][1].Parent.SpanID() == span.SpanContext.SpanID(), "Expect the database/sql span to be a child of the xorm span")
+		}
+	}, len(expected))
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"db.client.request.duration": func(mrs metricdata.ResourceMetrics) {
+			verifyRequestDurationCount(mrs, len(expected))
+		},
+	})
+}
+
+// only the database/sql spans record the duration, the xorm operations do not
+func verifyRequestDurationCount(mrs metricdata.ResourceMetrics, expected int) {
+	count := uint64(0)
+	for _, sm := range mrs.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "db.client.request.duration" {
+				continue
+			}
+			histogram, ok := m.Data.(metricdata.Histogram[float64])
+			verifier.Assert(ok, "Expect db.client.request.duration to be a histogram")
+			for _, point := range histogram.DataPoints {
+				count += point.Count
+			}
+		}
+	}
+	verifier.Assert(count == uint64(expected), "Expect %d db.client.request.duration records, got %d", expected, count)
+}
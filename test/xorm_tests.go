@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const xorm_dependency_name = "xorm.io/xorm"
+const xorm_module_name = "xorm"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("xorm-crud-test", xorm_module_name, "v1.1.0", "", "1.24", "", TestXormCrud),
+		NewLatestDepthTestCase("xorm-crud-latest-depth", xorm_dependency_name, xorm_module_name, "v1.1.0", "", "1.24", "", TestXormCrud),
+		NewGeneralTestCase("xorm-engine-group-test", xorm_module_name, "v1.1.0", "", "1.24", "", TestXormEngineGroup),
+	)
+}
+
+func TestXormCrud(t *testing.T, env ...string) {
+	UseApp("xorm/v1.1.0")
+	RunGoBuild(t, "go", "build", "test_xorm_crud.go")
+	RunApp(t, "test_xorm_crud", env...)
+}
+
+func TestXormEngineGroup(t *testing.T, env ...string) {
+	UseApp("xorm/v1.1.0")
+	RunGoBuild(t, "go", "build", "test_xorm_engine_group.go")
+	RunApp(t, "test_xorm_engine_group", env...)
+}
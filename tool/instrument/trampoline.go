@@ -589,7 +589,8 @@ func (rp *RuleProcessor) implementCallContext(t *rules.InstFuncRule) {
 
 func setValue(field string, idx int, typ dst.Expr) *dst.CaseClause {
 	// *(c.Params[idx].(*int)) = val.(int)
-	// c.Params[idx] = val iff type is interface{}
+	// the slots hold the addresses of the arguments, those of type interface{}
+	// included, so the value is written through them rather than replacing them
 	se := ast.SelectorExpr(ast.Ident(trampolineCtxIdentifier), field)
 	ie := ast.IndexExpr(se, ast.IntLit(idx))
 	te := ast.TypeAssertExpr(ie, ast.DereferenceOf(typ))
@@ -597,9 +598,6 @@ func setValue(field string, idx int, typ dst.Expr) *dst.CaseClause {
 	de := ast.DereferenceOf(pe)
 	val := ast.Ident(trampolineValIdentifier)
 	assign := ast.AssignStmt(de, ast.TypeAssertExpr(val, typ))
-	if ast.IsInterfaceType(typ) {
-		assign = ast.AssignStmt(ie, val)
-	}
 	caseClause := ast.SwitchCase(
 		ast.Exprs(ast.IntLit(idx)),
 		ast.Stmts(assign),
@@ -609,16 +607,12 @@ func setValue(field string, idx int, typ dst.Expr) *dst.CaseClause {
 
 func getValue(field string, idx int, typ dst.Expr) *dst.CaseClause {
 	// return *(c.Params[idx].(*int))
-	// return c.Params[idx] iff type is interface{}
 	se := ast.SelectorExpr(ast.Ident(trampolineCtxIdentifier), field)
 	ie := ast.IndexExpr(se, ast.IntLit(idx))
 	te := ast.TypeAssertExpr(ie, ast.DereferenceOf(typ))
 	pe := ast.ParenExpr(te)
 	de := ast.DereferenceOf(pe)
 	ret := ast.ReturnStmt(ast.Exprs(de))
-	if ast.IsInterfaceType(typ) {
-		ret = ast.ReturnStmt(ast.Exprs(ie))
-	}
 	caseClause := ast.SwitchCase(
 		ast.Exprs(ast.IntLit(idx)),
 		ast.Stmts(ret),
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrument
+
+import (
+	"bytes"
+	goast "go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/loongsuite-go-agent/tool/ast"
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+)
+
+const callContextSource = `package p
+
+type CallContextImpl struct {
+	Params []interface{}
+}
+
+func (c *CallContextImpl) GetParam(idx int) interface{} {
+	switch idx {
+	}
+	return nil
+}
+
+func (c *CallContextImpl) SetParam(idx int, val interface{}) {
+	switch idx {
+	}
+}
+`
+
+// renderCallContext generates the clauses of GetParam and SetParam for the
+// given parameter types, and returns the type checked source.
+func renderCallContext(t *testing.T, typs ...dst.Expr) string {
+	file, err := ast.NewAstParser().ParseSource(callContextSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getParam := file.Decls[1].(*dst.FuncDecl).Body.List[0].(*dst.SwitchStmt).Body
+	setParam := file.Decls[2].(*dst.FuncDecl).Body.List[0].(*dst.SwitchStmt).Body
+	for idx, typ := range typs {
+		getParam.List = append(getParam.List, getParamClause(idx, typ))
+		setParam.List = append(setParam.List, setParamClause(idx, dst.Clone(typ).(dst.Expr)))
+	}
+	var buf bytes.Buffer
+	if err := decorator.NewRestorer().Fprint(&buf, file); err != nil {
+		t.Fatal(err)
+	}
+	source := buf.String()
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "p.go", source, 0)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, source)
+	}
+	if _, err := new(types.Config).Check("p", fset, []*goast.File{parsed}, nil); err != nil {
+		t.Fatalf("%v\n%s", err, source)
+	}
+	return source
+}
+
+func TestCallContextParamClauses(t *testing.T) {
+	source := renderCallContext(t, ast.Ident("int"), ast.InterfaceType(), ast.Ident("any"))
+	for _, expected := range []string{
+		"return *(c.Params[0].(*int))",
+		"*(c.Params[0].(*int)) = val.(int)",
+		// the params hold the addresses of the arguments for interface{} too,
+		// replacing them would not change the arguments of the target function
+		"return *(c.Params[1].(*interface{}))",
+		"*(c.Params[1].(*interface{})) = val.(interface{})",
+		"return *(c.Params[2].(*any))",
+		"*(c.Params[2].(*any)) = val.(any)",
+	} {
+		if !strings.Contains(source, expected) {
+			t.Errorf("expected %q in\n%s", expected, source)
+		}
+	}
+}
@@ -241,7 +241,9 @@ func findAvailableRules() []rules.InstRule {
 	return rules
 }
 
-var versionRegexp = regexp.MustCompile(`@v\d+\.\d+\.\d+(-.*?)?/`)
+// the modules without a go.mod have a major version above v1 marked as
+// +incompatible, e.g. @v8.1.0+incompatible
+var versionRegexp = regexp.MustCompile(`@v\d+\.\d+\.\d+(-.*?)?(\+incompatible)?/`)
 
 func extractVersion(path string) string {
 	// Unify the path to Unix style
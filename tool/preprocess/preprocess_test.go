@@ -153,6 +153,15 @@ func TestMatchVersion(t *testing.T) {
 			want:    false,
 			wantErr: false,
 		},
+		{
+			name: "incompatible version is in the range",
+			args: args{
+				version:     "v8.1.0+incompatible",
+				ruleVersion: "[8.1.0,)",
+			},
+			want:    true,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -167,3 +176,22 @@ func TestMatchVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractVersion(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/root/go/pkg/mod/github.com/gin-gonic/gin@v1.10.0/gin.go", "v1.10.0"},
+		{"/root/go/pkg/mod/github.com/redis/go-redis/v9@v9.0.0-rc.1/redis.go", "v9.0.0-rc.1"},
+		{"/root/go/pkg/mod/github.com/twitchtv/twirp@v8.1.0+incompatible/client_options.go", "v8.1.0+incompatible"},
+		{"/root/module/pkg/rules/twirp/twirp_setup.go", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := extractVersion(tt.path); got != tt.want {
+				t.Errorf("extractVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}